@@ -0,0 +1,140 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+// Condition types shared by ExperimentTemplate and Experiment
+const (
+	// ConditionThrottled is True while AWS FIS is throttling the controller's
+	// calls and the reconciler is backing off before retrying
+	ConditionThrottled = "Throttled"
+
+	// ConditionFailed is True when the last reconcile failed; with reason
+	// ValidationFailed it stays until the spec changes (generation bump)
+	ConditionFailed = "Failed"
+
+	// ConditionSuspended is True while an Experiment's spec.suspend is set
+	ConditionSuspended = "Suspended"
+
+	// ConditionFrozen is True while experiment starts are blocked by the
+	// controller's freeze-reason annotation
+	ConditionFrozen = "Frozen"
+
+	// ConditionReady is True while an ExperimentTemplate's AWS FIS template exists and matches its spec
+	ConditionReady = "Ready"
+
+	// ConditionProgressing is True while an ExperimentTemplate's AWS FIS template is being created or updated
+	ConditionProgressing = "Progressing"
+
+	// ConditionRunning is True while an Experiment's current AWS FIS experiment is active
+	ConditionRunning = "Running"
+
+	// ConditionSucceeded is True once an Experiment's current AWS FIS experiment has completed
+	ConditionSucceeded = "Succeeded"
+
+	// ConditionRoleReady is False while the controller cannot create the FIS IAM role for an ExperimentTemplate
+	ConditionRoleReady = "RoleReady"
+
+	// ConditionAccessEntryReady is False when the EKS access entry of an ExperimentTemplate's role could not be
+	// created, even though the template itself is Ready; experiments of the template will fail RBAC
+	ConditionAccessEntryReady = "AccessEntryReady"
+
+	// ConditionValidated is True when AWS FIS returned an ExperimentTemplate's template after its last
+	// create or update, confirming it was accepted and is retrievable
+	ConditionValidated = "Validated"
+)
+
+// Condition reasons
+const (
+	// ReasonThrottled is used when an AWS FIS call was throttled or hit a 5xx error
+	ReasonThrottled = "Throttled"
+
+	// ReasonSucceeded is used when the last AWS FIS call succeeded
+	ReasonSucceeded = "Succeeded"
+
+	// ReasonCreating is used while an AWS FIS experiment template is being created
+	ReasonCreating = "Creating"
+
+	// ReasonUpdating is used while an AWS FIS experiment template is being updated
+	ReasonUpdating = "Updating"
+
+	// ReasonReconcileFailed is used when a reconcile failed with an error that may succeed on retry
+	ReasonReconcileFailed = "ReconcileFailed"
+
+	// ReasonValidationFailed is used when the spec was rejected as invalid,
+	// either by the controller or by an AWS ValidationException
+	ReasonValidationFailed = "ValidationFailed"
+
+	// ReasonAccessEntryFailed is used when the EKS access entry of a template's role could not be created
+	ReasonAccessEntryFailed = "AccessEntryFailed"
+
+	// ReasonNotRetrievable is used when AWS FIS did not return a template after creating or updating it
+	ReasonNotRetrievable = "NotRetrievable"
+
+	// ReasonIAMAccessDenied is used when the controller's credentials may not manage the FIS IAM role
+	ReasonIAMAccessDenied = "IAMAccessDenied"
+
+	// ReasonDryRun is used when a template is only rendered because dry-run mode is enabled
+	ReasonDryRun = "DryRun"
+
+	// ReasonSuspended is used when an Experiment is suspended
+	ReasonSuspended = "Suspended"
+
+	// ReasonResumed is used when a suspended Experiment is resumed
+	ReasonResumed = "Resumed"
+
+	// ReasonFrozen is used when an experiment start is deferred by a freeze
+	ReasonFrozen = "Frozen"
+
+	// ReasonUnfrozen is used when a deferred experiment starts after a freeze is lifted
+	ReasonUnfrozen = "Unfrozen"
+
+	// ReasonConcurrencyLimited is used when an experiment start waits for the template's or the controller's concurrency limit
+	ReasonConcurrencyLimited = "ConcurrencyLimited"
+
+	// ReasonStopRequested is used when a running Experiment is stopped through spec.stop
+	ReasonStopRequested = "StopRequested"
+
+	// ReasonStartDeferred is used while the creation of an AWS FIS experiment template waits for its start-after time
+	ReasonStartDeferred = "StartDeferred"
+)
+
+// Event reasons for lifecycle transitions that have no matching condition
+const (
+	// ReasonCreated is used when an AWS FIS experiment template is created
+	ReasonCreated = "Created"
+
+	// ReasonUpdated is used when an AWS FIS experiment template is updated
+	ReasonUpdated = "Updated"
+
+	// ReasonDriftDetected is used when an AWS FIS experiment template was changed outside the controller
+	ReasonDriftDetected = "DriftDetected"
+
+	// ReasonStarted is used when an AWS FIS experiment is started
+	ReasonStarted = "Started"
+
+	// ReasonCompleted is used when an AWS FIS experiment completes
+	ReasonCompleted = "Completed"
+
+	// ReasonStopped is used when an AWS FIS experiment is stopped before completing
+	ReasonStopped = "Stopped"
+
+	// ReasonFailed is used when an AWS FIS experiment fails or cannot be started
+	ReasonFailed = "Failed"
+
+	// ReasonMissedStartingDeadline is used when a scheduled run is skipped because it missed its starting deadline
+	ReasonMissedStartingDeadline = "MissedStartingDeadline"
+)
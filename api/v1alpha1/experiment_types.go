@@ -33,14 +33,42 @@ type ExperimentSpec struct {
 	// Schedule defines when to run the experiment (cron expression)
 	// If not specified, the experiment runs once immediately (Job mode)
 	// Examples: "0 2 * * *" (daily at 2am), "*/30 * * * *" (every 30 minutes)
+	// An optional leading seconds field, a CRON_TZ= prefix and descriptors such as "@daily" or "@every 1h" are also accepted
 	// +optional
 	Schedule string `json:"schedule,omitempty"`
 
+	// TimeZone is the IANA time zone the Schedule is evaluated in (e.g. "America/New_York")
+	// If not specified, the controller's local time zone is used
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// StartingDeadlineSeconds is the deadline in seconds for starting a scheduled run that was missed
+	// Missed runs older than the deadline are skipped instead of being started late
+	// If not specified, missed runs are always started
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+
+	// ConcurrencyPolicy specifies how to treat a scheduled run that fires while the previous run is still active
+	// Valid values are:
+	// - "Allow" (default): starts the new run alongside the active one
+	// - "Forbid": skips the new run while the active one is running; it is not started later
+	// - "Replace": stops the active run and starts the new one
+	// +kubebuilder:default=Allow
+	// +optional
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
 	// Suspend tells the controller to suspend subsequent executions
 	// This does not apply to already started experiments
+	// Runs missed while suspended are skipped, the schedule resumes from the time of un-suspending
 	// +optional
 	Suspend *bool `json:"suspend,omitempty"`
 
+	// Stop aborts the current run if it is still active, without deleting the Experiment
+	// While set, no new runs are started; a run that already finished is left as is
+	// +optional
+	Stop *bool `json:"stop,omitempty"`
+
 	// SuccessfulExperimentsHistoryLimit is the number of successful finished experiments to retain
 	// Default is 3
 	// +kubebuilder:validation:Minimum=0
@@ -59,12 +87,52 @@ type ExperimentSpec struct {
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
 
+	// ExperimentOptions override the template's experiment options for runs started by this Experiment
+	// AWS FIS only accepts actionsMode when an experiment starts; emptyTargetResolutionMode stays a template setting
+	// +optional
+	ExperimentOptions *ExperimentRunOptions `json:"experimentOptions,omitempty"`
+
 	// ClientToken is an optional unique identifier for the experiment
-	// If not provided, one will be generated automatically
+	// If not provided, one is derived from the Experiment and run, so retried starts reuse it
+	// Scheduled and triggered runs combine it with the run's schedule time or trigger token
 	// +optional
 	ClientToken string `json:"clientToken,omitempty"`
 }
 
+// RunOrigin describes what started a run of an Experiment
+type RunOrigin string
+
+const (
+	// RunOriginScheduled is a run started by the cron schedule
+	RunOriginScheduled RunOrigin = "scheduled"
+
+	// RunOriginManual is a run started by the trigger annotation or a one-time Experiment
+	RunOriginManual RunOrigin = "manual"
+)
+
+// ExperimentRunOptions are the experiment options AWS FIS accepts when an experiment starts
+type ExperimentRunOptions struct {
+	// ActionsMode selects whether the actions run; skip-all resolves the targets without injecting faults
+	// +kubebuilder:validation:Enum=run-all;skip-all
+	// +optional
+	ActionsMode string `json:"actionsMode,omitempty"`
+}
+
+// ConcurrencyPolicy describes how a scheduled run is handled while a previous run is still active
+// +kubebuilder:validation:Enum=Allow;Forbid;Replace
+type ConcurrencyPolicy string
+
+const (
+	// AllowConcurrent allows scheduled runs to overlap
+	AllowConcurrent ConcurrencyPolicy = "Allow"
+
+	// ForbidConcurrent skips a scheduled run while the previous run is still active
+	ForbidConcurrent ConcurrencyPolicy = "Forbid"
+
+	// ReplaceConcurrent stops the active run before starting the new one
+	ReplaceConcurrent ConcurrencyPolicy = "Replace"
+)
+
 // ExperimentTemplateRef references an experiment template by ID or Name
 type ExperimentTemplateRef struct {
 	// ID is the AWS FIS experiment template ID (e.g., "EXT1234567890abcdef")
@@ -84,6 +152,10 @@ type ExperimentStatus struct {
 	// +optional
 	ExperimentID string `json:"experimentId,omitempty"`
 
+	// ConsoleURL is the AWS FIS console link to the experiment
+	// +optional
+	ConsoleURL string `json:"consoleURL,omitempty"`
+
 	// TemplateID is the resolved AWS FIS template ID
 	// +optional
 	TemplateID string `json:"templateId,omitempty"`
@@ -101,10 +173,22 @@ type ExperimentStatus struct {
 	// +optional
 	StartTime *metav1.Time `json:"startTime,omitempty"`
 
+	// StartedBy is the controller pod that started the current experiment
+	// +optional
+	StartedBy string `json:"startedBy,omitempty"`
+
 	// EndTime is when the experiment ended
 	// +optional
 	EndTime *metav1.Time `json:"endTime,omitempty"`
 
+	// LastUpdateTime is when the experiment state was last synced from AWS FIS
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+
+	// EndTimePolls is the number of syncs since the experiment reached a terminal state without an end time
+	// +optional
+	EndTimePolls int32 `json:"endTimePolls,omitempty"`
+
 	// LastScheduleTime is the last time the experiment was scheduled (for scheduled experiments)
 	// +optional
 	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
@@ -113,6 +197,25 @@ type ExperimentStatus struct {
 	// +optional
 	NextScheduleTime *metav1.Time `json:"nextScheduleTime,omitempty"`
 
+	// LastMissedScheduleTime is the scheduled time of the last run skipped because it missed its starting deadline
+	// +optional
+	LastMissedScheduleTime *metav1.Time `json:"lastMissedScheduleTime,omitempty"`
+
+	// ExperimentHistory lists recent runs of a scheduled experiment, newest first
+	// It is capped at SuccessfulExperimentsHistoryLimit + FailedExperimentsHistoryLimit entries
+	// +optional
+	ExperimentHistory []ExperimentRef `json:"experimentHistory,omitempty"`
+
+	// LastTriggerToken is the last fis.dksshddl.dev/trigger annotation value that started a run
+	// +optional
+	LastTriggerToken string `json:"lastTriggerToken,omitempty"`
+
+	// LastRunOrigin tells whether the last run was started by the schedule or manually,
+	// through the trigger annotation or by creating a one-time Experiment
+	// +kubebuilder:validation:Enum=scheduled;manual
+	// +optional
+	LastRunOrigin RunOrigin `json:"lastRunOrigin,omitempty"`
+
 	// Active is the number of currently running experiments
 	// +optional
 	Active int32 `json:"active,omitempty"`
@@ -121,6 +224,28 @@ type ExperimentStatus struct {
 	// +optional
 	TargetAccountConfigurationsCount int64 `json:"targetAccountConfigurationsCount,omitempty"`
 
+	// TargetResolution reports how many resources FIS resolved for each target
+	// +optional
+	TargetResolution []TargetResolution `json:"targetResolution,omitempty"`
+
+	// ResolvedTargetArns lists the ARNs of the resources FIS acted on, recorded once the experiment ends
+	// At most 100 ARNs are kept; ResolvedTargetArnsTruncated is set when FIS resolved more
+	// +optional
+	ResolvedTargetArns []string `json:"resolvedTargetArns,omitempty"`
+
+	// ResolvedTargetArnsTruncated is true when ResolvedTargetArns holds only part of the resolved resources
+	// +optional
+	ResolvedTargetArnsTruncated bool `json:"resolvedTargetArnsTruncated,omitempty"`
+
+	// Actions reports the state of each action of the experiment, sorted by name
+	// +optional
+	Actions []ActionStatus `json:"actions,omitempty"`
+
+	// ConsecutiveThrottles is the number of consecutive StartExperiment calls that were throttled
+	// It drives the requeue backoff and is reset after a successful call
+	// +optional
+	ConsecutiveThrottles int32 `json:"consecutiveThrottles,omitempty"`
+
 	// Conditions represent the current state of the Experiment resource.
 	// +listType=map
 	// +listMapKey=type
@@ -128,6 +253,65 @@ type ExperimentStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// ExperimentRef is a reference to a run of an AWS FIS experiment
+type ExperimentRef struct {
+	// ID is the AWS FIS experiment ID
+	ID string `json:"id"`
+
+	// State is the last observed state of the experiment
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// StartTime is when the experiment was created
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime is when the experiment ended, if known
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+
+	// ClientToken identifies the logical run; a retried start reuses it and replaces the entry
+	// +optional
+	ClientToken string `json:"clientToken,omitempty"`
+}
+
+// TargetResolution is the resolution outcome of a single experiment target
+type TargetResolution struct {
+	// Name is the target name from the experiment template
+	Name string `json:"name"`
+
+	// ResolvedCount is the number of resources FIS resolved for the target
+	// +optional
+	ResolvedCount int32 `json:"resolvedCount,omitempty"`
+
+	// Skipped is true when no resources were resolved and the target received no faults
+	// +optional
+	Skipped bool `json:"skipped,omitempty"`
+}
+
+// ActionStatus is the state of a single experiment action
+type ActionStatus struct {
+	// Name is the action name from the experiment template
+	Name string `json:"name"`
+
+	// State is the action state reported by AWS FIS
+	// Possible values: pending, initiating, running, completed, cancelled, stopping, stopped, failed, skipped
+	// +optional
+	State string `json:"state,omitempty"`
+
+	// Reason explains the action state, e.g. why it failed
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// StartTime is when the action started
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// EndTime is when the action ended
+	// +optional
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,shortName=fisexp
@@ -135,7 +319,9 @@ type ExperimentStatus struct {
 // +kubebuilder:printcolumn:name="Experiment ID",type=string,JSONPath=`.status.experimentId`
 // +kubebuilder:printcolumn:name="Template",type=string,JSONPath=`.spec.experimentTemplate.name`
 // +kubebuilder:printcolumn:name="Schedule",type=string,JSONPath=`.spec.schedule`
+// +kubebuilder:printcolumn:name="Time Zone",type=string,JSONPath=`.spec.timeZone`,priority=1
 // +kubebuilder:printcolumn:name="Last Schedule",type=date,JSONPath=`.status.lastScheduleTime`
+// +kubebuilder:printcolumn:name="Console",type=string,JSONPath=`.status.consoleURL`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Experiment is the Schema for the experiments API
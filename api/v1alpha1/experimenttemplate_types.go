@@ -17,6 +17,8 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"slices"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -31,6 +33,11 @@ type ExperimentTemplateSpec struct {
 	// +optional
 	RoleArn string `json:"roleArn,omitempty"`
 
+	// RoleArnFrom reads the IAM role ARN from a Secret key
+	// Takes precedence over every other way of providing the role ARN
+	// +optional
+	RoleArnFrom *SecretKeySelector `json:"roleArnFrom,omitempty"`
+
 	// AutoCreateRole enables automatic IAM role creation (Option 2: Opt-in)
 	// When true, the controller will create an IAM role with necessary permissions
 	// Default is false for security reasons - users should provide their own role
@@ -44,6 +51,37 @@ type ExperimentTemplateSpec struct {
 	// +optional
 	RoleName string `json:"roleName,omitempty"`
 
+	// ManageAccessEntry selects whether the controller creates and deletes the EKS access entry of the
+	// template's role, whether the role was provided or auto-created
+	// Defaults to the controller's --manage-access-entries setting
+	// +optional
+	ManageAccessEntry *bool `json:"manageAccessEntry,omitempty"`
+
+	// Parameters are substituted for ${name} placeholders in action parameter values
+	// Unknown placeholders are sent to AWS FIS unchanged
+	// +optional
+	Parameters map[string]string `json:"parameters,omitempty"`
+
+	// ServiceAccountRoleArn is the IAM role FIS pods assume through IRSA; it is set as the
+	// eks.amazonaws.com/role-arn annotation of the template's ServiceAccount
+	// Defaults to the controller's --service-account-role-arn setting
+	// +optional
+	ServiceAccountRoleArn string `json:"serviceAccountRoleArn,omitempty"`
+
+	// RBACMode selects the Kubernetes RBAC resources created for the template
+	// Namespaced creates a Role and RoleBinding in every target namespace; ClusterWide creates a single
+	// ClusterRole and ClusterRoleBinding. Both create a ServiceAccount in every target namespace
+	// +kubebuilder:validation:Enum=Namespaced;ClusterWide
+	// +kubebuilder:default=Namespaced
+	// +optional
+	RBACMode RBACMode `json:"rbacMode,omitempty"`
+
+	// RolePolicyArns are managed IAM policies attached to the auto-created role,
+	// in addition to the controller's inline policy unless the controller disables it
+	// Only applied when the role is created
+	// +optional
+	RolePolicyArns []string `json:"rolePolicyArns,omitempty"`
+
 	// Targets defines which pods to target for the experiment
 	// +kubebuilder:validation:MinItems=1
 	// +required
@@ -54,6 +92,12 @@ type ExperimentTemplateSpec struct {
 	// +required
 	Actions []ActionSpec `json:"actions"`
 
+	// ActionSequence lists action names to run one after another
+	// Each listed action starts after the one before it, in addition to its own StartAfter
+	// +listType=set
+	// +optional
+	ActionSequence []string `json:"actionSequence,omitempty"`
+
 	// StopConditions defines conditions that will stop the experiment
 	// +optional
 	StopConditions []StopCondition `json:"stopConditions,omitempty"`
@@ -62,6 +106,13 @@ type ExperimentTemplateSpec struct {
 	// +optional
 	ExperimentOptions *ExperimentOptions `json:"experimentOptions,omitempty"`
 
+	// TargetAccountConfigurations are the accounts a multi-account experiment runs in
+	// Required when ExperimentOptions.AccountTargeting is multi-account
+	// +listType=map
+	// +listMapKey=accountId
+	// +optional
+	TargetAccountConfigurations []TargetAccountConfiguration `json:"targetAccountConfigurations,omitempty"`
+
 	// LogConfiguration defines where to send experiment logs
 	// +optional
 	LogConfiguration *LogConfiguration `json:"logConfiguration,omitempty"`
@@ -73,47 +124,155 @@ type ExperimentTemplateSpec struct {
 	// Tags to apply to the FIS experiment template
 	// +optional
 	Tags []Tag `json:"tags,omitempty"`
+
+	// BlastRadius declares the impact of the experiment and must be at least the level its target selection requires
+	// Valid values are:
+	// - "low": at most 3 pods or 10% of the matching pods per target
+	// - "medium": at most 10 pods or 50% of the matching pods per target
+	// - "high": any selection, including ALL
+	// Not checked when empty
+	// +optional
+	BlastRadius BlastRadius `json:"blastRadius,omitempty"`
+
+	// MaxConcurrentExperiments caps how many experiments from this template may run at the same time
+	// An Experiment whose start would exceed it waits until a running one finishes; unlimited when unset
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConcurrentExperiments *int32 `json:"maxConcurrentExperiments,omitempty"`
+
+	// DryRun validates and converts the template without creating anything in AWS or the cluster
+	// The rendered AWS FIS CreateExperimentTemplate input is written to status.renderedTemplate
+	// +optional
+	DryRun bool `json:"dryRun,omitempty"`
 }
 
-// TargetSpec defines the target pods for the experiment
+// BlastRadius is the declared impact level of an experiment template
+// +kubebuilder:validation:Enum=low;medium;high
+type BlastRadius string
+
+const (
+	// BlastRadiusLow is for experiments that only touch a few pods
+	BlastRadiusLow BlastRadius = "low"
+
+	// BlastRadiusMedium is for experiments that touch up to half of the matching pods
+	BlastRadiusMedium BlastRadius = "medium"
+
+	// BlastRadiusHigh is for experiments that may touch all matching pods
+	BlastRadiusHigh BlastRadius = "high"
+)
+
+// RBACMode selects how the Kubernetes RBAC of an ExperimentTemplate is scoped
+type RBACMode string
+
+const (
+	// RBACModeNamespaced creates a Role and RoleBinding in every target namespace
+	RBACModeNamespaced RBACMode = "Namespaced"
+
+	// RBACModeClusterWide creates a single ClusterRole and ClusterRoleBinding
+	RBACModeClusterWide RBACMode = "ClusterWide"
+)
+
+// Target resource types supported by TargetSpec.ResourceType
+const (
+	// ResourceTypeEKSPod targets pods of the controller's EKS cluster
+	ResourceTypeEKSPod = "aws:eks:pod"
+
+	// ResourceTypeEC2Instance targets EC2 instances
+	ResourceTypeEC2Instance = "aws:ec2:instance"
+
+	// ResourceTypeEKSNodegroup targets EKS managed node groups
+	ResourceTypeEKSNodegroup = "aws:eks:nodegroup"
+
+	// ResourceTypeECSTask targets ECS tasks
+	ResourceTypeECSTask = "aws:ecs:task"
+)
+
+// TargetSpec defines the target resources for the experiment
 type TargetSpec struct {
 	// Name is a unique identifier for this target
 	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9-]+$`
 	// +required
 	Name string `json:"name"`
 
-	// Namespace where the target pods are located (required for cluster-scoped resource)
+	// ResourceType is the FIS resource type of the target
+	// Pod targets select pods by Namespace and LabelSelector; other types select AWS resources
+	// whose tags match LabelSelector, narrowed by Filters
+	// +kubebuilder:validation:Enum="aws:eks:pod";"aws:ec2:instance";"aws:eks:nodegroup";"aws:ecs:task"
+	// +kubebuilder:default="aws:eks:pod"
+	// +optional
+	ResourceType string `json:"resourceType,omitempty"`
+
+	// Namespace where the target pods are located (required for aws:eks:pod targets)
 	// +kubebuilder:validation:MinLength=1
-	// +required
-	Namespace string `json:"namespace"`
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
 
-	// LabelSelector to select target pods (key-value pairs)
-	// +required
-	LabelSelector map[string]string `json:"labelSelector"`
+	// LabelSelector to select target pods (key-value pairs), or the resource tags for non-pod targets
+	// Required for aws:eks:pod targets
+	// +optional
+	LabelSelector map[string]string `json:"labelSelector,omitempty"`
+
+	// ResourceArns selects non-pod targets by explicit ARN instead of by tags
+	// Mutually exclusive with LabelSelector; not supported for aws:eks:pod targets
+	// +optional
+	ResourceArns []string `json:"resourceArns,omitempty"`
+
+	// SelectionMode specifies how many matching pods to target: ALL, COUNT or PERCENT
+	// Takes precedence over Scope when set
+	// +kubebuilder:validation:Enum=ALL;COUNT;PERCENT
+	// +optional
+	SelectionMode string `json:"selectionMode,omitempty"`
+
+	// Count is the number of pods to target when SelectionMode is COUNT
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Count *int32 `json:"count,omitempty"`
+
+	// Percent is the percentage of pods to target when SelectionMode is PERCENT
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Percent *int32 `json:"percent,omitempty"`
+
+	// TargetContainerName specifies which container in the pod to target
+	// If not specified, the first container in the pod is targeted
+	// +optional
+	TargetContainerName string `json:"targetContainerName,omitempty"`
 
 	// Scope specifies how many pods to target.
 	// Examples: "ALL" (all matching pods), "3" (exactly 3 pods), "50%" (50% of pods)
+	// Deprecated: use SelectionMode with Count or Percent
 	// +kubebuilder:default=ALL
 	// +optional
 	Scope string `json:"scope,omitempty"`
 
 	// Container specifies which container in the pod to target
-	// If not specified, the first container in the pod is targeted
+	// Deprecated: use TargetContainerName; the defaulting webhook moves this value into TargetContainerName
 	// +optional
 	Container string `json:"container,omitempty"`
 
-	// Filters for additional target selection criteria
+	// PodPhases restricts aws:eks:pod targets to pods in these phases
+	// Translated into a Status.Phase filter; defaults to Running when no phase filter is given
+	// +kubebuilder:validation:items:Enum=Pending;Running;Succeeded;Failed;Unknown
+	// +listType=set
+	// +optional
+	PodPhases []string `json:"podPhases,omitempty"`
+
+	// Filters for additional target selection criteria, e.g. path "State.Name" with values ["running"]
+	// Each filter needs a non-empty path and at least one value; all filters must match
 	// +optional
 	Filters []TargetFilter `json:"filters,omitempty"`
 }
 
 // TargetFilter defines additional filtering criteria for target selection
 type TargetFilter struct {
-	// Path is the JSON path to filter on
+	// Path is the attribute path to filter on
+	// +kubebuilder:validation:MinLength=1
 	// +required
 	Path string `json:"path"`
 
 	// Values are the values to match
+	// +kubebuilder:validation:MinItems=1
 	// +required
 	Values []string `json:"values"`
 }
@@ -130,12 +289,16 @@ type ActionSpec struct {
 	Description string `json:"description,omitempty"`
 
 	// Type is the action type (pod-cpu-stress, pod-memory-stress, pod-io-stress, pod-network-latency, etc.)
-	// +kubebuilder:validation:Enum=pod-cpu-stress;pod-memory-stress;pod-io-stress;pod-network-latency;pod-network-packet-loss;pod-delete
+	// pod-* types act on aws:eks:pod targets, ec2-* types on aws:ec2:instance targets,
+	// nodegroup-terminate-instances on aws:eks:nodegroup targets and ecs-stop-task on aws:ecs:task targets
+	// +kubebuilder:validation:Enum=pod-cpu-stress;pod-memory-stress;pod-io-stress;pod-network-latency;pod-network-packet-loss;pod-network-bandwidth;pod-delete;ec2-stop-instances;ec2-reboot-instances;ec2-terminate-instances;nodegroup-terminate-instances;ecs-stop-task
 	// +required
 	Type string `json:"type"`
 
-	// Duration of the action (e.g., "5m", "10m", "1h")
-	// +kubebuilder:validation:Pattern=`^\d+[smh]$`
+	// Duration of the action (e.g., "30s", "5m", "1h", "1h30m")
+	// ec2-stop-instances restarts the instances after it; the other non-pod types act once and ignore it
+	// +kubebuilder:validation:Pattern=`^(\d+h)?(\d+m)?(\d+s)?$`
+	// +kubebuilder:validation:MinLength=2
 	// +required
 	Duration string `json:"duration"`
 
@@ -152,6 +315,28 @@ type ActionSpec struct {
 	StartAfter []string `json:"startAfter,omitempty"`
 }
 
+// SequencedActions returns a copy of the actions where each action named in ActionSequence
+// also starts after the action listed before it
+func (s *ExperimentTemplateSpec) SequencedActions() []ActionSpec {
+	actions := make([]ActionSpec, len(s.Actions))
+	for i := range s.Actions {
+		s.Actions[i].DeepCopyInto(&actions[i])
+	}
+
+	for i := 1; i < len(s.ActionSequence); i++ {
+		previous := s.ActionSequence[i-1]
+		for j := range actions {
+			if actions[j].Name != s.ActionSequence[i] {
+				continue
+			}
+			if !slices.Contains(actions[j].StartAfter, previous) {
+				actions[j].StartAfter = append(actions[j].StartAfter, previous)
+			}
+		}
+	}
+	return actions
+}
+
 // StopCondition defines a condition that will stop the experiment
 type StopCondition struct {
 	// Source is the source of the stop condition (e.g., "cloudwatch-alarm", "none")
@@ -164,6 +349,24 @@ type StopCondition struct {
 	Value string `json:"value,omitempty"`
 }
 
+// TargetAccountConfiguration defines an account that a multi-account experiment targets
+type TargetAccountConfiguration struct {
+	// AccountID is the AWS account ID of the target account
+	// +kubebuilder:validation:Pattern=`^[0-9]{12}$`
+	// +required
+	AccountID string `json:"accountId"`
+
+	// RoleArn is the IAM role FIS assumes in the target account
+	// +kubebuilder:validation:MinLength=20
+	// +required
+	RoleArn string `json:"roleArn"`
+
+	// Description of the target account configuration
+	// +kubebuilder:validation:MaxLength=512
+	// +optional
+	Description string `json:"description,omitempty"`
+}
+
 // ExperimentOptions defines experiment-level options
 type ExperimentOptions struct {
 	// AccountTargeting defines the account targeting mode
@@ -220,12 +423,12 @@ type S3Configuration struct {
 // ExperimentReportConfiguration defines experiment report settings
 type ExperimentReportConfiguration struct {
 	// PreExperimentDuration is the duration before the experiment to include in the report (e.g., "20m")
-	// +kubebuilder:validation:Pattern=`^\d+[smh]$`
+	// +kubebuilder:validation:Pattern=`^(\d+h)?(\d+m)?(\d+s)?$`
 	// +optional
 	PreExperimentDuration string `json:"preExperimentDuration,omitempty"`
 
 	// PostExperimentDuration is the duration after the experiment to include in the report (e.g., "20m")
-	// +kubebuilder:validation:Pattern=`^\d+[smh]$`
+	// +kubebuilder:validation:Pattern=`^(\d+h)?(\d+m)?(\d+s)?$`
 	// +optional
 	PostExperimentDuration string `json:"postExperimentDuration,omitempty"`
 
@@ -274,6 +477,25 @@ type Tag struct {
 	Value string `json:"value"`
 }
 
+// SecretKeySelector selects a key of a Secret
+// ExperimentTemplate is cluster-scoped, so the Secret namespace must be given explicitly
+type SecretKeySelector struct {
+	// Name of the Secret
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+
+	// Namespace of the Secret
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Namespace string `json:"namespace"`
+
+	// Key within the Secret data
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Key string `json:"key"`
+}
+
 // ExperimentTemplateStatus defines the observed state of ExperimentTemplate.
 type ExperimentTemplateStatus struct {
 	// TemplateID is the AWS FIS experiment template ID
@@ -294,6 +516,29 @@ type ExperimentTemplateStatus struct {
 	// +optional
 	Message string `json:"message,omitempty"`
 
+	// TemplateVersion counts the spec versions successfully applied to the AWS FIS template
+	// It is 1 after creation and incremented on every successful update
+	// +optional
+	TemplateVersion int64 `json:"templateVersion,omitempty"`
+
+	// FilterCount is the number of target filters applied to the AWS FIS template
+	// +optional
+	FilterCount int32 `json:"filterCount,omitempty"`
+
+	// RBACNamespaces lists the namespaces the controller provisioned RBAC resources in
+	// It is compared against the current target namespaces to remove RBAC from dropped namespaces
+	// +listType=set
+	// +optional
+	RBACNamespaces []string `json:"rbacNamespaces,omitempty"`
+
+	// RenderedTemplate is the AWS FIS CreateExperimentTemplate input rendered in dry-run mode, as JSON
+	// +optional
+	RenderedTemplate string `json:"renderedTemplate,omitempty"`
+
+	// LastForceSync is the value of the fis.dksshddl.dev/force-sync annotation last synced to AWS
+	// +optional
+	LastForceSync string `json:"lastForceSync,omitempty"`
+
 	// ObservedGeneration is the generation observed by the controller
 	// +optional
 	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
@@ -302,6 +547,16 @@ type ExperimentTemplateStatus struct {
 	// +optional
 	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
 
+	// ConsecutiveThrottles is the number of consecutive AWS FIS calls that were throttled
+	// It drives the requeue backoff and is reset after a successful call
+	// +optional
+	ConsecutiveThrottles int32 `json:"consecutiveThrottles,omitempty"`
+
+	// ResolvedConfig holds values AWS FIS stored for the template, read back after each create or update
+	// Only recorded when the controller runs with --record-resolved-config
+	// +optional
+	ResolvedConfig *ResolvedTemplateConfig `json:"resolvedConfig,omitempty"`
+
 	// Conditions represent the current state of the ExperimentTemplate resource.
 	// +listType=map
 	// +listMapKey=type
@@ -309,11 +564,28 @@ type ExperimentTemplateStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// ResolvedTemplateConfig is the configuration AWS FIS derived from a template spec, for spotting coerced values
+type ResolvedTemplateConfig struct {
+	// TargetSelectionModes maps each target name to the selection mode AWS FIS stored
+	// +optional
+	TargetSelectionModes map[string]string `json:"targetSelectionModes,omitempty"`
+
+	// ActionDurations maps each action name to the ISO 8601 duration AWS FIS stored
+	// +optional
+	ActionDurations map[string]string `json:"actionDurations,omitempty"`
+
+	// EmptyTargetResolutionMode is the empty target resolution mode AWS FIS stored
+	// +optional
+	EmptyTargetResolutionMode string `json:"emptyTargetResolutionMode,omitempty"`
+}
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,shortName=fistemplate
 // +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
 // +kubebuilder:printcolumn:name="Template ID",type=string,JSONPath=`.status.templateId`
+// +kubebuilder:printcolumn:name="Version",type=integer,JSONPath=`.status.templateVersion`,priority=1
+// +kubebuilder:printcolumn:name="Filters",type=integer,JSONPath=`.status.filterCount`,priority=1
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 
 // ExperimentTemplate is the Schema for the experimenttemplates API
@@ -52,6 +52,29 @@ func (in *ActionSpec) DeepCopy() *ActionSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActionStatus) DeepCopyInto(out *ActionStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActionStatus.
+func (in *ActionStatus) DeepCopy() *ActionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ActionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudWatchDashboard) DeepCopyInto(out *CloudWatchDashboard) {
 	*out = *in
@@ -156,6 +179,29 @@ func (in *ExperimentOptions) DeepCopy() *ExperimentOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentRef) DeepCopyInto(out *ExperimentRef) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentRef.
+func (in *ExperimentRef) DeepCopy() *ExperimentRef {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExperimentReportConfiguration) DeepCopyInto(out *ExperimentReportConfiguration) {
 	*out = *in
@@ -181,15 +227,40 @@ func (in *ExperimentReportConfiguration) DeepCopy() *ExperimentReportConfigurati
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExperimentRunOptions) DeepCopyInto(out *ExperimentRunOptions) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentRunOptions.
+func (in *ExperimentRunOptions) DeepCopy() *ExperimentRunOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ExperimentRunOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExperimentSpec) DeepCopyInto(out *ExperimentSpec) {
 	*out = *in
 	out.ExperimentTemplate = in.ExperimentTemplate
+	if in.StartingDeadlineSeconds != nil {
+		in, out := &in.StartingDeadlineSeconds, &out.StartingDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
 	if in.Suspend != nil {
 		in, out := &in.Suspend, &out.Suspend
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Stop != nil {
+		in, out := &in.Stop, &out.Stop
+		*out = new(bool)
+		**out = **in
+	}
 	if in.SuccessfulExperimentsHistoryLimit != nil {
 		in, out := &in.SuccessfulExperimentsHistoryLimit, &out.SuccessfulExperimentsHistoryLimit
 		*out = new(int32)
@@ -205,6 +276,11 @@ func (in *ExperimentSpec) DeepCopyInto(out *ExperimentSpec) {
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
+	if in.ExperimentOptions != nil {
+		in, out := &in.ExperimentOptions, &out.ExperimentOptions
+		*out = new(ExperimentRunOptions)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentSpec.
@@ -228,6 +304,10 @@ func (in *ExperimentStatus) DeepCopyInto(out *ExperimentStatus) {
 		in, out := &in.EndTime, &out.EndTime
 		*out = (*in).DeepCopy()
 	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
 	if in.LastScheduleTime != nil {
 		in, out := &in.LastScheduleTime, &out.LastScheduleTime
 		*out = (*in).DeepCopy()
@@ -236,6 +316,34 @@ func (in *ExperimentStatus) DeepCopyInto(out *ExperimentStatus) {
 		in, out := &in.NextScheduleTime, &out.NextScheduleTime
 		*out = (*in).DeepCopy()
 	}
+	if in.LastMissedScheduleTime != nil {
+		in, out := &in.LastMissedScheduleTime, &out.LastMissedScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ExperimentHistory != nil {
+		in, out := &in.ExperimentHistory, &out.ExperimentHistory
+		*out = make([]ExperimentRef, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TargetResolution != nil {
+		in, out := &in.TargetResolution, &out.TargetResolution
+		*out = make([]TargetResolution, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResolvedTargetArns != nil {
+		in, out := &in.ResolvedTargetArns, &out.ResolvedTargetArns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Actions != nil {
+		in, out := &in.Actions, &out.Actions
+		*out = make([]ActionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -332,6 +440,28 @@ func (in *ExperimentTemplateRef) DeepCopy() *ExperimentTemplateRef {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExperimentTemplateSpec) DeepCopyInto(out *ExperimentTemplateSpec) {
 	*out = *in
+	if in.RoleArnFrom != nil {
+		in, out := &in.RoleArnFrom, &out.RoleArnFrom
+		*out = new(SecretKeySelector)
+		**out = **in
+	}
+	if in.ManageAccessEntry != nil {
+		in, out := &in.ManageAccessEntry, &out.ManageAccessEntry
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.RolePolicyArns != nil {
+		in, out := &in.RolePolicyArns, &out.RolePolicyArns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Targets != nil {
 		in, out := &in.Targets, &out.Targets
 		*out = make([]TargetSpec, len(*in))
@@ -346,6 +476,11 @@ func (in *ExperimentTemplateSpec) DeepCopyInto(out *ExperimentTemplateSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ActionSequence != nil {
+		in, out := &in.ActionSequence, &out.ActionSequence
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.StopConditions != nil {
 		in, out := &in.StopConditions, &out.StopConditions
 		*out = make([]StopCondition, len(*in))
@@ -356,6 +491,11 @@ func (in *ExperimentTemplateSpec) DeepCopyInto(out *ExperimentTemplateSpec) {
 		*out = new(ExperimentOptions)
 		**out = **in
 	}
+	if in.TargetAccountConfigurations != nil {
+		in, out := &in.TargetAccountConfigurations, &out.TargetAccountConfigurations
+		*out = make([]TargetAccountConfiguration, len(*in))
+		copy(*out, *in)
+	}
 	if in.LogConfiguration != nil {
 		in, out := &in.LogConfiguration, &out.LogConfiguration
 		*out = new(LogConfiguration)
@@ -371,6 +511,11 @@ func (in *ExperimentTemplateSpec) DeepCopyInto(out *ExperimentTemplateSpec) {
 		*out = make([]Tag, len(*in))
 		copy(*out, *in)
 	}
+	if in.MaxConcurrentExperiments != nil {
+		in, out := &in.MaxConcurrentExperiments, &out.MaxConcurrentExperiments
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExperimentTemplateSpec.
@@ -386,10 +531,20 @@ func (in *ExperimentTemplateSpec) DeepCopy() *ExperimentTemplateSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExperimentTemplateStatus) DeepCopyInto(out *ExperimentTemplateStatus) {
 	*out = *in
+	if in.RBACNamespaces != nil {
+		in, out := &in.RBACNamespaces, &out.RBACNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.LastSyncTime != nil {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.ResolvedConfig != nil {
+		in, out := &in.ResolvedConfig, &out.ResolvedConfig
+		*out = new(ResolvedTemplateConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -474,6 +629,35 @@ func (in *ReportOutputs) DeepCopy() *ReportOutputs {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResolvedTemplateConfig) DeepCopyInto(out *ResolvedTemplateConfig) {
+	*out = *in
+	if in.TargetSelectionModes != nil {
+		in, out := &in.TargetSelectionModes, &out.TargetSelectionModes
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ActionDurations != nil {
+		in, out := &in.ActionDurations, &out.ActionDurations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResolvedTemplateConfig.
+func (in *ResolvedTemplateConfig) DeepCopy() *ResolvedTemplateConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ResolvedTemplateConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *S3Configuration) DeepCopyInto(out *S3Configuration) {
 	*out = *in
@@ -489,6 +673,21 @@ func (in *S3Configuration) DeepCopy() *S3Configuration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretKeySelector) DeepCopyInto(out *SecretKeySelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretKeySelector.
+func (in *SecretKeySelector) DeepCopy() *SecretKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StopCondition) DeepCopyInto(out *StopCondition) {
 	*out = *in
@@ -519,6 +718,21 @@ func (in *Tag) DeepCopy() *Tag {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetAccountConfiguration) DeepCopyInto(out *TargetAccountConfiguration) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetAccountConfiguration.
+func (in *TargetAccountConfiguration) DeepCopy() *TargetAccountConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetAccountConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetFilter) DeepCopyInto(out *TargetFilter) {
 	*out = *in
@@ -539,6 +753,21 @@ func (in *TargetFilter) DeepCopy() *TargetFilter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetResolution) DeepCopyInto(out *TargetResolution) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetResolution.
+func (in *TargetResolution) DeepCopy() *TargetResolution {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetResolution)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetSpec) DeepCopyInto(out *TargetSpec) {
 	*out = *in
@@ -549,6 +778,26 @@ func (in *TargetSpec) DeepCopyInto(out *TargetSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.ResourceArns != nil {
+		in, out := &in.ResourceArns, &out.ResourceArns
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PodPhases != nil {
+		in, out := &in.PodPhases, &out.PodPhases
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.Filters != nil {
 		in, out := &in.Filters, &out.Filters
 		*out = make([]TargetFilter, len(*in))
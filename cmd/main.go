@@ -19,13 +19,18 @@ package main
 import (
 	"crypto/tls"
 	"flag"
+	"fmt"
 	"os"
+	"strings"
+	"time"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -39,6 +44,10 @@ import (
 	awsfis "fis.dksshddl.dev/fis-controller/internal/aws"
 	"fis.dksshddl.dev/fis-controller/internal/controller/experiment"
 	"fis.dksshddl.dev/fis-controller/internal/controller/experimenttemplate"
+	"fis.dksshddl.dev/fis-controller/internal/metrics"
+	"fis.dksshddl.dev/fis-controller/internal/utils"
+	"fis.dksshddl.dev/fis-controller/internal/validation"
+	webhookv1alpha1 "fis.dksshddl.dev/fis-controller/internal/webhook/v1alpha1"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -74,10 +83,59 @@ func main() {
 	var secureMetrics bool
 	var enableHTTP2 bool
 	var clusterName string
+	var strictReportConfiguration bool
+	var strictTargetContainers bool
+	var serviceAccountNameTemplate string
+	var accessPolicyArn string
+	var cleanupStaleAccessEntries bool
+	var cleanupReplacedRoles bool
+	var manageAccessEntries bool
+	var requireStopConditionNamespaces string
+	var allowedMissingNamespaces string
+	var configMap string
+	var awsRetryMode string
+	var dryRun bool
+	var rolePolicyArns string
+	var inlineRolePolicy bool
+	var permissionsBoundary string
+	var roleNamePrefix string
+	var rolePath string
+	var maxConcurrentExperiments int
+	var driftCheckInterval time.Duration
+	var recordResolvedConfig bool
+	var rbacNamespace string
+	var serviceAccountRoleArn string
+	var roleArnSecretNamespace string
 	var tlsOpts []func(*tls.Config)
 	flag.StringVar(&metricsAddr, "metrics-bind-address", "0", "The address the metrics endpoint binds to. "+
 		"Use :8443 for HTTPS or :8080 for HTTP, or leave as 0 to disable the metrics service.")
 	flag.StringVar(&clusterName, "cluster-name", "", "The EKS cluster name for FIS experiments.")
+	flag.BoolVar(&strictReportConfiguration, "strict-report-configuration", false,
+		"If set, ExperimentTemplates whose report configuration has no data sources or outputs are rejected "+
+			"instead of only logging a warning.")
+	flag.BoolVar(&strictTargetContainers, "strict-target-containers", false,
+		"If set, ExperimentTemplates are rejected when the target container is missing from any pod matching the target.")
+	flag.StringVar(&serviceAccountNameTemplate, "service-account-name-template", utils.DefaultRBACNameTemplate,
+		"Go template for the per-ExperimentTemplate ServiceAccount, Role, RoleBinding and RBAC username. "+
+			"{{.TemplateName}} is replaced by the ExperimentTemplate name; names over 253 characters are truncated with a hash suffix.")
+	flag.IntVar(&maxConcurrentExperiments, "max-concurrent-experiments", 0,
+		"Maximum number of Experiments active across the cluster at once. Further starts are deferred. "+
+			"0 disables the limit.")
+	flag.DurationVar(&driftCheckInterval, "drift-check-interval", 10*time.Minute,
+		"How often each ExperimentTemplate is compared with its AWS FIS template and re-applied when changed "+
+			"outside the controller. 0 disables drift detection.")
+	flag.BoolVar(&recordResolvedConfig, "record-resolved-config", false,
+		"Record the target selection modes and action durations AWS FIS returns after creating or updating a template "+
+			"in status.resolvedConfig, to debug values AWS FIS coerced.")
+	flag.StringVar(&rbacNamespace, "rbac-namespace", "",
+		"Namespace holding the ServiceAccount of every ExperimentTemplate, bound through a ClusterRole and "+
+			"ClusterRoleBinding instead of per-target-namespace Roles. Empty uses the target namespaces.")
+	flag.StringVar(&serviceAccountRoleArn, "service-account-role-arn", "",
+		"IAM role ARN annotated on every ExperimentTemplate ServiceAccount (eks.amazonaws.com/role-arn) so FIS pods "+
+			"can assume it through IRSA. Templates can override it with spec.serviceAccountRoleArn.")
+	flag.StringVar(&roleArnSecretNamespace, "role-arn-secret-namespace", os.Getenv("POD_NAMESPACE"),
+		"The only namespace spec.roleArnFrom may read Secrets from. Defaults to the controller's namespace; "+
+			"empty rejects every spec.roleArnFrom.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
@@ -93,6 +151,46 @@ func main() {
 	flag.StringVar(&metricsCertKey, "metrics-cert-key", "tls.key", "The name of the metrics server key file.")
 	flag.BoolVar(&enableHTTP2, "enable-http2", false,
 		"If set, HTTP/2 will be enabled for the metrics and webhook servers")
+	flag.StringVar(&accessPolicyArn, "access-policy-arn", "",
+		"EKS access policy ARN to associate with each ExperimentTemplate's access entry, scoped to the template's "+
+			"target namespaces (e.g. arn:aws:eks::aws:cluster-access-policy/AmazonEKSEditPolicy). Empty disables the association.")
+	flag.BoolVar(&manageAccessEntries, "manage-access-entries", true,
+		"If set, the controller creates and deletes the EKS access entry of each ExperimentTemplate's role, "+
+			"for provided and auto-created roles alike. Templates can override it with spec.manageAccessEntry.")
+	flag.BoolVar(&cleanupStaleAccessEntries, "cleanup-stale-access-entries", true,
+		"If set, the EKS access entry of an ExperimentTemplate's previous role is deleted when its role ARN changes. "+
+			"Disable when roles are shared with access entries managed elsewhere.")
+	flag.BoolVar(&cleanupReplacedRoles, "cleanup-replaced-roles", true,
+		"If set, the IAM role the controller auto-created for an ExperimentTemplate is deleted when the template "+
+			"switches to a provided role ARN.")
+	flag.StringVar(&requireStopConditionNamespaces, "require-stop-condition-namespaces", "",
+		"Comma-separated namespaces where ExperimentTemplates targeting them must have a cloudwatch-alarm stop condition "+
+			"and no stop condition with source none.")
+	flag.StringVar(&allowedMissingNamespaces, "allowed-missing-namespaces", "",
+		"Comma-separated target namespaces that ExperimentTemplates may reference before they exist. "+
+			"Templates targeting any other missing namespace are rejected.")
+	flag.StringVar(&configMap, "config-map", "",
+		"The controller's config ConfigMap as <namespace>/<name>. While it carries the fis.dksshddl.dev/freeze-reason "+
+			"annotation no experiments are started. Empty disables the freeze check.")
+	flag.StringVar(&awsRetryMode, "aws-retry-mode", string(aws.RetryModeStandard),
+		"The AWS SDK retry mode, standard or adaptive. Adaptive adds client-side rate limiting under sustained throttling.")
+	flag.BoolVar(&dryRun, "dry-run", false,
+		"If set, ExperimentTemplates are only validated and rendered into status.renderedTemplate. "+
+			"No AWS FIS templates, IAM roles or RBAC resources are created.")
+	flag.StringVar(&rolePolicyArns, "role-policy-arns", "",
+		"Comma-separated managed IAM policy ARNs attached to every auto-created FIS role, "+
+			"in addition to the policies listed in the template's spec.rolePolicyArns.")
+	flag.BoolVar(&inlineRolePolicy, "inline-role-policy", true,
+		"If set, auto-created FIS roles get the controller's inline policy. "+
+			"Disable when the managed policies from --role-policy-arns grant everything FIS needs.")
+	flag.StringVar(&permissionsBoundary, "iam-permissions-boundary", os.Getenv("IAM_PERMISSIONS_BOUNDARY"),
+		"IAM policy ARN set as the permissions boundary of every auto-created FIS role. "+
+			"Defaults to the IAM_PERMISSIONS_BOUNDARY environment variable; empty creates roles without a boundary.")
+	flag.StringVar(&roleNamePrefix, "iam-role-name-prefix", "fis",
+		"Prefix of auto-created FIS role names, which are <prefix>-<template name>. "+
+			"Names over 64 characters are truncated with a hash suffix.")
+	flag.StringVar(&rolePath, "iam-role-path", "/",
+		"IAM path of auto-created FIS roles (e.g. /chaos/). Must begin and end with a slash.")
 	opts := zap.Options{
 		Development: true,
 	}
@@ -106,6 +204,28 @@ func main() {
 		setupLog.Error(nil, "--cluster-name flag is required")
 		os.Exit(1)
 	}
+	configMapKey, err := parseNamespacedName(configMap)
+	if err != nil {
+		setupLog.Error(err, "invalid --config-map flag")
+		os.Exit(1)
+	}
+	retryMode, err := aws.ParseRetryMode(awsRetryMode)
+	if err != nil {
+		setupLog.Error(err, "invalid --aws-retry-mode flag")
+		os.Exit(1)
+	}
+	if driftCheckInterval < 0 {
+		setupLog.Error(nil, "--drift-check-interval must not be negative")
+		os.Exit(1)
+	}
+	if maxConcurrentExperiments < 0 {
+		setupLog.Error(nil, "--max-concurrent-experiments must not be negative")
+		os.Exit(1)
+	}
+	if _, err := utils.ExperimentTemplateRBACName(serviceAccountNameTemplate, "example"); err != nil {
+		setupLog.Error(err, "invalid --service-account-name-template")
+		os.Exit(1)
+	}
 
 	// if the enable-http2 flag is false (the default), http/2 should be disabled
 	// due to its vulnerabilities. More specifically, disabling http/2 will
@@ -204,6 +324,7 @@ func main() {
 	fisClient, err := awsfis.NewFISClient(ctx, awsfis.FISConfig{
 		Region:     "", // Will auto-detect from environment
 		MaxRetries: 3,
+		RetryMode:  retryMode,
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to create FIS client")
@@ -212,7 +333,12 @@ func main() {
 
 	// Create IAM client using the same AWS config
 	setupLog.Info("creating AWS IAM client")
+	if !strings.HasPrefix(rolePath, "/") || !strings.HasSuffix(rolePath, "/") {
+		setupLog.Error(fmt.Errorf("invalid IAM role path %q", rolePath), "--iam-role-path must begin and end with a slash")
+		os.Exit(1)
+	}
 	iamClient := awsfis.NewIAMClient(fisClient.GetAWSConfig())
+	iamClient.RoleNaming = awsfis.RoleNaming{Prefix: roleNamePrefix, Path: rolePath}
 
 	// Create EKS client using the same AWS config
 	setupLog.Info("creating AWS EKS client")
@@ -231,26 +357,69 @@ func main() {
 		setupLog.Info("successfully resolved cluster ARN", "clusterARN", clusterARN)
 	}
 
+	templateValidator := &validation.TemplateValidator{
+		StrictReportConfiguration:      strictReportConfiguration,
+		Reader:                         mgr.GetAPIReader(),
+		RequireStopConditionNamespaces: splitCommaList(requireStopConditionNamespaces),
+		AllowedMissingNamespaces:       splitCommaList(allowedMissingNamespaces),
+		StrictTargetContainers:         strictTargetContainers,
+		Region:                         fisClient.GetAWSConfig().Region,
+		BucketRegions:                  &awsfis.BucketRegionResolver{},
+	}
 	if err := (&experimenttemplate.Reconciler{
-		Client:      mgr.GetClient(),
-		Scheme:      mgr.GetScheme(),
-		FISClient:   fisClient,
-		IAMClient:   iamClient,
-		EKSClient:   eksClient,
-		ClusterARN:  clusterARN,
-		ClusterName: clusterName,
+		Client:                     mgr.GetClient(),
+		Scheme:                     mgr.GetScheme(),
+		FISClient:                  fisClient,
+		IAMClient:                  iamClient,
+		EKSClient:                  eksClient,
+		ClusterARN:                 clusterARN,
+		ClusterName:                clusterName,
+		Validator:                  templateValidator,
+		ServiceAccountNameTemplate: serviceAccountNameTemplate,
+		AccessPolicyArn:            accessPolicyArn,
+		SkipAccessEntries:          !manageAccessEntries,
+		CleanupStaleAccessEntries:  cleanupStaleAccessEntries,
+		CleanupReplacedRoles:       cleanupReplacedRoles,
+		DryRun:                     dryRun,
+		RolePolicyArns:             splitCommaList(rolePolicyArns),
+		SkipInlineRolePolicy:       !inlineRolePolicy,
+		PermissionsBoundary:        permissionsBoundary,
+		DriftCheckInterval:         driftCheckInterval,
+		RecordResolvedConfig:       recordResolvedConfig,
+		RBACNamespace:              rbacNamespace,
+		ServiceAccountRoleArn:      serviceAccountRoleArn,
+		RoleArnSecretNamespace:     roleArnSecretNamespace,
+		Inventory:                  &metrics.Inventory{},
+		APIReader:                  mgr.GetAPIReader(),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ExperimentTemplate")
 		os.Exit(1)
 	}
 	if err := (&experiment.Reconciler{
-		Client:    mgr.GetClient(),
-		Scheme:    mgr.GetScheme(),
-		FISClient: fisClient,
+		Client:                   mgr.GetClient(),
+		Scheme:                   mgr.GetScheme(),
+		FISClient:                fisClient,
+		ConfigMap:                configMapKey,
+		APIReader:                mgr.GetAPIReader(),
+		Identity:                 controllerIdentity(),
+		MaxConcurrentExperiments: int32(maxConcurrentExperiments),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Experiment")
 		os.Exit(1)
 	}
+	// nolint:goconst
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err := webhookv1alpha1.SetupExperimentTemplateWebhookWithManager(mgr, templateValidator); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ExperimentTemplate")
+			os.Exit(1)
+		}
+		// Read templates from the API server, an Experiment is often applied right after its template
+		experimentValidator := &validation.ExperimentValidator{Reader: mgr.GetAPIReader()}
+		if err := webhookv1alpha1.SetupExperimentWebhookWithManager(mgr, experimentValidator); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "Experiment")
+			os.Exit(1)
+		}
+	}
 	// +kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -268,3 +437,39 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// controllerIdentity returns the controller pod name from the downward-API POD_NAME variable,
+// falling back to the hostname, which is the pod name unless the pod overrides it
+func controllerIdentity() string {
+	if name := os.Getenv("POD_NAME"); name != "" {
+		return name
+	}
+	name, err := os.Hostname()
+	if err != nil {
+		return ""
+	}
+	return name
+}
+
+// splitCommaList parses a comma-separated list such as namespaces or ARNs, ignoring blanks
+func splitCommaList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// parseNamespacedName parses a <namespace>/<name> reference, an empty value yields an empty name
+func parseNamespacedName(value string) (types.NamespacedName, error) {
+	if value == "" {
+		return types.NamespacedName{}, nil
+	}
+	namespace, name, ok := strings.Cut(value, "/")
+	if !ok || namespace == "" || name == "" {
+		return types.NamespacedName{}, fmt.Errorf("expected <namespace>/<name>, got %q", value)
+	}
+	return types.NamespacedName{Namespace: namespace, Name: name}, nil
+}
@@ -18,15 +18,39 @@ package aws
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/smithy-go"
 )
 
+// EKSAPI is the subset of the AWS EKS API used by EKSClient
+// It is satisfied by *eks.Client and allows tests to substitute a fake
+type EKSAPI interface {
+	DescribeCluster(ctx context.Context, params *eks.DescribeClusterInput, optFns ...func(*eks.Options)) (*eks.DescribeClusterOutput, error)
+	CreateAccessEntry(ctx context.Context, params *eks.CreateAccessEntryInput, optFns ...func(*eks.Options)) (*eks.CreateAccessEntryOutput, error)
+	DescribeAccessEntry(ctx context.Context, params *eks.DescribeAccessEntryInput, optFns ...func(*eks.Options)) (*eks.DescribeAccessEntryOutput, error)
+	DeleteAccessEntry(ctx context.Context, params *eks.DeleteAccessEntryInput, optFns ...func(*eks.Options)) (*eks.DeleteAccessEntryOutput, error)
+	AssociateAccessPolicy(ctx context.Context, params *eks.AssociateAccessPolicyInput, optFns ...func(*eks.Options)) (*eks.AssociateAccessPolicyOutput, error)
+	TagResource(ctx context.Context, params *eks.TagResourceInput, optFns ...func(*eks.Options)) (*eks.TagResourceOutput, error)
+}
+
+// AccessPolicyAssociation describes an EKS access policy to associate with an access entry
+type AccessPolicyAssociation struct {
+	// PolicyArn is the access policy ARN, e.g. arn:aws:eks::aws:cluster-access-policy/AmazonEKSEditPolicy
+	PolicyArn string
+
+	// Namespaces scopes the policy to these namespaces; an empty list grants it cluster-wide
+	Namespaces []string
+}
+
 // EKSClient wraps AWS EKS client
 type EKSClient struct {
-	client *eks.Client
+	client EKSAPI
 }
 
 // NewEKSClient creates a new EKS client using the provided AWS config
@@ -36,6 +60,13 @@ func NewEKSClient(awsConfig aws.Config) *EKSClient {
 	}
 }
 
+// NewEKSClientFromAPI creates an EKS client backed by the given API implementation
+func NewEKSClientFromAPI(api EKSAPI) *EKSClient {
+	return &EKSClient{
+		client: api,
+	}
+}
+
 // GetClusterARN returns the EKS cluster ARN using EKS DescribeCluster API
 func (c *EKSClient) GetClusterARN(ctx context.Context, clusterName string) (string, error) {
 	output, err := c.client.DescribeCluster(ctx, &eks.DescribeClusterInput{
@@ -60,10 +91,7 @@ func (c *EKSClient) CreateAccessEntry(ctx context.Context, clusterName, principa
 		ClusterName:  aws.String(clusterName),
 		PrincipalArn: aws.String(principalArn),
 		Username:     aws.String(username),
-		Tags: map[string]string{
-			"ManagedBy":              "aws-fis-controller",
-			"kubernetes.io/role-arn": principalArn,
-		},
+		Tags:         accessEntryTags(principalArn),
 	}
 
 	_, err := c.client.CreateAccessEntry(ctx, input)
@@ -89,41 +117,115 @@ func (c *EKSClient) DeleteAccessEntry(ctx context.Context, clusterName, principa
 	return nil
 }
 
-// AccessEntryExists checks if an access entry exists for the given IAM role
-func (c *EKSClient) AccessEntryExists(ctx context.Context, clusterName, principalArn string) (bool, error) {
+// AssociateAccessPolicy associates an access policy with the access entry of the given IAM role
+// Associating a policy that is already associated updates its scope
+func (c *EKSClient) AssociateAccessPolicy(ctx context.Context, clusterName, principalArn string, policy AccessPolicyAssociation) error {
+	scope := &ekstypes.AccessScope{Type: ekstypes.AccessScopeTypeCluster}
+	if len(policy.Namespaces) > 0 {
+		scope = &ekstypes.AccessScope{
+			Type:       ekstypes.AccessScopeTypeNamespace,
+			Namespaces: policy.Namespaces,
+		}
+	}
+
+	_, err := c.client.AssociateAccessPolicy(ctx, &eks.AssociateAccessPolicyInput{
+		ClusterName:  aws.String(clusterName),
+		PrincipalArn: aws.String(principalArn),
+		PolicyArn:    aws.String(policy.PolicyArn),
+		AccessScope:  scope,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to associate access policy %s: %w", policy.PolicyArn, err)
+	}
+
+	return nil
+}
+
+// TagResource adds or overwrites tags on an EKS resource such as an access entry
+func (c *EKSClient) TagResource(ctx context.Context, resourceArn string, tags map[string]string) error {
+	_, err := c.client.TagResource(ctx, &eks.TagResourceInput{
+		ResourceArn: aws.String(resourceArn),
+		Tags:        tags,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to tag resource %s: %w", resourceArn, err)
+	}
+
+	return nil
+}
+
+// GetAccessEntry returns the access entry for the given IAM role, or nil if it does not exist
+func (c *EKSClient) GetAccessEntry(ctx context.Context, clusterName, principalArn string) (*ekstypes.AccessEntry, error) {
 	input := &eks.DescribeAccessEntryInput{
 		ClusterName:  aws.String(clusterName),
 		PrincipalArn: aws.String(principalArn),
 	}
 
-	_, err := c.client.DescribeAccessEntry(ctx, input)
+	output, err := c.client.DescribeAccessEntry(ctx, input)
 	if err != nil {
 		// Check if it's a ResourceNotFoundException
 		if isResourceNotFoundError(err) {
-			return false, nil
+			return nil, nil
 		}
-		return false, fmt.Errorf("failed to describe access entry: %w", err)
+		return nil, fmt.Errorf("failed to describe access entry: %w", err)
+	}
+
+	return output.AccessEntry, nil
+}
+
+// AccessEntryExists checks if an access entry exists for the given IAM role
+func (c *EKSClient) AccessEntryExists(ctx context.Context, clusterName, principalArn string) (bool, error) {
+	entry, err := c.GetAccessEntry(ctx, clusterName, principalArn)
+	if err != nil {
+		return false, err
 	}
+	return entry != nil, nil
+}
 
-	return true, nil
+// accessEntryTags returns the tags the controller sets on the access entries it manages
+func accessEntryTags(principalArn string) map[string]string {
+	return map[string]string{
+		"ManagedBy":              "aws-fis-controller",
+		"kubernetes.io/role-arn": principalArn,
+	}
+}
+
+// driftedTags returns the expected tags that are missing from or differ in actual
+func driftedTags(expected, actual map[string]string) map[string]string {
+	drifted := make(map[string]string)
+	for k, v := range expected {
+		if actual[k] != v {
+			drifted[k] = v
+		}
+	}
+	return drifted
 }
 
 // EnsureAccessEntry ensures an access entry exists for the given IAM role
-// If it doesn't exist, it creates one with the specified username
-func EnsureAccessEntry(ctx context.Context, eksClient *EKSClient, clusterName, principalArn, username string) error {
-	exists, err := eksClient.AccessEntryExists(ctx, clusterName, principalArn)
+// If it doesn't exist, it creates one with the specified username; if it does, drifted tags are restored
+// Any given access policies are (re-)associated with the entry afterwards
+func EnsureAccessEntry(ctx context.Context, eksClient *EKSClient, clusterName, principalArn, username string, policies ...AccessPolicyAssociation) error {
+	entry, err := eksClient.GetAccessEntry(ctx, clusterName, principalArn)
 	if err != nil {
 		return fmt.Errorf("failed to check if access entry exists: %w", err)
 	}
 
-	if exists {
-		// Access entry already exists
-		return nil
+	if entry == nil {
+		// Create access entry
+		if err := eksClient.CreateAccessEntry(ctx, clusterName, principalArn, username); err != nil {
+			return fmt.Errorf("failed to create access entry: %w", err)
+		}
+	} else if drifted := driftedTags(accessEntryTags(principalArn), entry.Tags); len(drifted) > 0 && entry.AccessEntryArn != nil {
+		// TagResource only adds or overwrites, tags set by others are left alone
+		if err := eksClient.TagResource(ctx, aws.ToString(entry.AccessEntryArn), drifted); err != nil {
+			return fmt.Errorf("failed to reconcile access entry tags: %w", err)
+		}
 	}
 
-	// Create access entry
-	if err := eksClient.CreateAccessEntry(ctx, clusterName, principalArn, username); err != nil {
-		return fmt.Errorf("failed to create access entry: %w", err)
+	for _, policy := range policies {
+		if err := eksClient.AssociateAccessPolicy(ctx, clusterName, principalArn, policy); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -141,37 +243,34 @@ func DeleteAccessEntryIfExists(ctx context.Context, eksClient *EKSClient, cluste
 		return nil
 	}
 
-	// Delete access entry
-	if err := eksClient.DeleteAccessEntry(ctx, clusterName, principalArn); err != nil {
+	// Delete access entry, it may have been removed since the existence check
+	if err := eksClient.DeleteAccessEntry(ctx, clusterName, principalArn); err != nil && !isResourceNotFoundError(err) {
 		return fmt.Errorf("failed to delete access entry: %w", err)
 	}
 
 	return nil
 }
 
-// isResourceNotFoundError checks if the error is a ResourceNotFoundException
+// isResourceNotFoundError reports whether err is an EKS ResourceNotFoundException or NotFoundException
 func isResourceNotFoundError(err error) bool {
 	if err == nil {
 		return false
 	}
-	// Check error message for ResourceNotFoundException
-	return contains(err.Error(), "ResourceNotFoundException") ||
-		contains(err.Error(), "No access entry found") ||
-		contains(err.Error(), "not found")
-}
 
-// contains checks if a string contains a substring
-func contains(s, substr string) bool {
-	return len(s) >= len(substr) && (s == substr || len(s) > len(substr) &&
-		(s[:len(substr)] == substr || s[len(s)-len(substr):] == substr ||
-			containsMiddle(s, substr)))
-}
+	var resourceNotFound *ekstypes.ResourceNotFoundException
+	if errors.As(err, &resourceNotFound) {
+		return true
+	}
+	var notFound *ekstypes.NotFoundException
+	if errors.As(err, &notFound) {
+		return true
+	}
 
-func containsMiddle(s, substr string) bool {
-	for i := 0; i <= len(s)-len(substr); i++ {
-		if s[i:i+len(substr)] == substr {
-			return true
-		}
+	// Fallback for errors that lost their concrete type but kept the API error code
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		code := apiErr.ErrorCode()
+		return code == "ResourceNotFoundException" || code == "NotFoundException"
 	}
-	return false
+	return strings.Contains(err.Error(), "ResourceNotFoundException")
 }
@@ -0,0 +1,210 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/smithy-go"
+
+	"fis.dksshddl.dev/fis-controller/internal/aws/fake"
+)
+
+func TestIsResourceNotFoundError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "typed resource not found", err: &ekstypes.ResourceNotFoundException{Message: aws.String("No access entry found")}, want: true},
+		{name: "wrapped typed resource not found", err: fmt.Errorf("failed to describe access entry: %w", &ekstypes.ResourceNotFoundException{}), want: true},
+		{name: "typed not found", err: &ekstypes.NotFoundException{}, want: true},
+		{name: "generic api error code", err: &smithy.GenericAPIError{Code: "ResourceNotFoundException"}, want: true},
+		{name: "flattened error message", err: errors.New("operation error EKS: DescribeAccessEntry, ResourceNotFoundException: No access entry found"), want: true},
+		{name: "unrelated error mentioning found", err: errors.New("role arn:aws:iam::123456789012:role/found-it is not found in cache"), want: false},
+		{name: "unrelated api error mentioning found", err: &smithy.GenericAPIError{Code: "AccessDeniedException", Message: "principal not found in trust policy"}, want: false},
+		{name: "resource in use", err: &ekstypes.ResourceInUseException{}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isResourceNotFoundError(tt.err); got != tt.want {
+				t.Errorf("isResourceNotFoundError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnsureAccessEntryForwardsUsername(t *testing.T) {
+	eksAPI := &fake.EKS{}
+	client := NewEKSClientFromAPI(eksAPI)
+	roleArn := "arn:aws:iam::123456789012:role/fis-cpu-stress"
+
+	if err := EnsureAccessEntry(context.Background(), client, "test-cluster", roleArn, "fis-cpu-stress"); err != nil {
+		t.Fatalf("EnsureAccessEntry failed: %v", err)
+	}
+
+	if len(eksAPI.CreateAccessEntryInputs) != 1 {
+		t.Fatalf("Expected 1 CreateAccessEntry call, got: %d", len(eksAPI.CreateAccessEntryInputs))
+	}
+	input := eksAPI.CreateAccessEntryInputs[0]
+	if got := aws.ToString(input.Username); got != "fis-cpu-stress" {
+		t.Errorf("Expected username 'fis-cpu-stress', got: %s", got)
+	}
+	if got := aws.ToString(input.PrincipalArn); got != roleArn {
+		t.Errorf("Expected principal %s, got: %s", roleArn, got)
+	}
+
+	// An existing entry is left alone
+	if err := EnsureAccessEntry(context.Background(), client, "test-cluster", roleArn, "fis-cpu-stress"); err != nil {
+		t.Fatalf("EnsureAccessEntry failed: %v", err)
+	}
+	if len(eksAPI.CreateAccessEntryInputs) != 1 {
+		t.Errorf("Expected no additional CreateAccessEntry call, got: %d", len(eksAPI.CreateAccessEntryInputs))
+	}
+}
+
+func TestDeleteAccessEntryIfExists(t *testing.T) {
+	roleArn := "arn:aws:iam::123456789012:role/fis-cpu-stress"
+	eksAPI := &fake.EKS{AccessEntries: map[string]ekstypes.AccessEntry{roleArn: {PrincipalArn: aws.String(roleArn)}}}
+	client := NewEKSClientFromAPI(eksAPI)
+
+	for i := 0; i < 2; i++ {
+		if err := DeleteAccessEntryIfExists(context.Background(), client, "test-cluster", roleArn); err != nil {
+			t.Fatalf("DeleteAccessEntryIfExists failed: %v", err)
+		}
+	}
+	if len(eksAPI.DeleteAccessEntryInputs) != 1 {
+		t.Errorf("Expected 1 DeleteAccessEntry call, got: %d", len(eksAPI.DeleteAccessEntryInputs))
+	}
+}
+
+func TestEnsureAccessEntryAssociatesAccessPolicy(t *testing.T) {
+	eksAPI := &fake.EKS{}
+	client := NewEKSClientFromAPI(eksAPI)
+	roleArn := "arn:aws:iam::123456789012:role/fis-cpu-stress"
+	policy := AccessPolicyAssociation{
+		PolicyArn:  "arn:aws:eks::aws:cluster-access-policy/AmazonEKSEditPolicy",
+		Namespaces: []string{"default", "payments"},
+	}
+
+	if err := EnsureAccessEntry(context.Background(), client, "test-cluster", roleArn, "fis-cpu-stress", policy); err != nil {
+		t.Fatalf("EnsureAccessEntry failed: %v", err)
+	}
+
+	if len(eksAPI.AssociateAccessPolicyInputs) != 1 {
+		t.Fatalf("Expected 1 AssociateAccessPolicy call, got: %d", len(eksAPI.AssociateAccessPolicyInputs))
+	}
+	input := eksAPI.AssociateAccessPolicyInputs[0]
+	if got := aws.ToString(input.PolicyArn); got != policy.PolicyArn {
+		t.Errorf("Expected policy %s, got: %s", policy.PolicyArn, got)
+	}
+	if input.AccessScope.Type != ekstypes.AccessScopeTypeNamespace {
+		t.Errorf("Expected namespace scope, got: %s", input.AccessScope.Type)
+	}
+	if len(input.AccessScope.Namespaces) != 2 || input.AccessScope.Namespaces[1] != "payments" {
+		t.Errorf("Expected namespaces [default payments], got: %v", input.AccessScope.Namespaces)
+	}
+}
+
+func TestEnsureAccessEntryClusterScopedPolicy(t *testing.T) {
+	eksAPI := &fake.EKS{}
+	client := NewEKSClientFromAPI(eksAPI)
+	roleArn := "arn:aws:iam::123456789012:role/fis-cpu-stress"
+
+	policy := AccessPolicyAssociation{PolicyArn: "arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy"}
+	if err := EnsureAccessEntry(context.Background(), client, "test-cluster", roleArn, "fis-cpu-stress", policy); err != nil {
+		t.Fatalf("EnsureAccessEntry failed: %v", err)
+	}
+
+	if got := eksAPI.AssociateAccessPolicyInputs[0].AccessScope.Type; got != ekstypes.AccessScopeTypeCluster {
+		t.Errorf("Expected cluster scope without namespaces, got: %s", got)
+	}
+}
+
+func TestEnsureAccessEntryWithoutPolicies(t *testing.T) {
+	eksAPI := &fake.EKS{}
+	client := NewEKSClientFromAPI(eksAPI)
+
+	if err := EnsureAccessEntry(context.Background(), client, "test-cluster", "arn:aws:iam::123456789012:role/fis", "fis"); err != nil {
+		t.Fatalf("EnsureAccessEntry failed: %v", err)
+	}
+	if len(eksAPI.AssociateAccessPolicyInputs) != 0 {
+		t.Errorf("Expected no AssociateAccessPolicy calls by default, got: %d", len(eksAPI.AssociateAccessPolicyInputs))
+	}
+}
+
+func TestEnsureAccessEntryReconcilesDriftedTags(t *testing.T) {
+	roleArn := "arn:aws:iam::123456789012:role/fis-cpu-stress"
+	entryArn := fake.AccessEntryArn("test-cluster", roleArn)
+	eksAPI := &fake.EKS{
+		AccessEntries: map[string]ekstypes.AccessEntry{
+			roleArn: {
+				AccessEntryArn: aws.String(entryArn),
+				PrincipalArn:   aws.String(roleArn),
+				Tags: map[string]string{
+					"kubernetes.io/role-arn": "arn:aws:iam::123456789012:role/old",
+					"team":                   "platform",
+				},
+			},
+		},
+	}
+	client := NewEKSClientFromAPI(eksAPI)
+
+	if err := EnsureAccessEntry(context.Background(), client, "test-cluster", roleArn, "fis-cpu-stress"); err != nil {
+		t.Fatalf("EnsureAccessEntry failed: %v", err)
+	}
+
+	if len(eksAPI.CreateAccessEntryInputs) != 0 {
+		t.Errorf("Expected no CreateAccessEntry calls, got: %d", len(eksAPI.CreateAccessEntryInputs))
+	}
+	if len(eksAPI.TagResourceInputs) != 1 {
+		t.Fatalf("Expected 1 TagResource call, got: %d", len(eksAPI.TagResourceInputs))
+	}
+	input := eksAPI.TagResourceInputs[0]
+	if got := aws.ToString(input.ResourceArn); got != entryArn {
+		t.Errorf("Expected resource %s, got: %s", entryArn, got)
+	}
+	if _, ok := input.Tags["team"]; ok {
+		t.Errorf("Expected only drifted tags to be sent, got: %v", input.Tags)
+	}
+
+	tags := eksAPI.AccessEntries[roleArn].Tags
+	if tags["ManagedBy"] != "aws-fis-controller" {
+		t.Errorf("Expected ManagedBy tag to be restored, got: %v", tags)
+	}
+	if tags["kubernetes.io/role-arn"] != roleArn {
+		t.Errorf("Expected role-arn tag %s, got: %s", roleArn, tags["kubernetes.io/role-arn"])
+	}
+	if tags["team"] != "platform" {
+		t.Errorf("Expected unrelated tags to be kept, got: %v", tags)
+	}
+
+	// Tags already in sync are not rewritten
+	if err := EnsureAccessEntry(context.Background(), client, "test-cluster", roleArn, "fis-cpu-stress"); err != nil {
+		t.Fatalf("EnsureAccessEntry failed: %v", err)
+	}
+	if len(eksAPI.TagResourceInputs) != 1 {
+		t.Errorf("Expected no further TagResource calls, got: %d", len(eksAPI.TagResourceInputs))
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/fis/types"
+	"github.com/aws/smithy-go"
+)
+
+const (
+	// throttleBaseDelay is the requeue delay after the first throttled call
+	throttleBaseDelay = 15 * time.Second
+
+	// throttleMaxDelay caps the exponential throttle backoff
+	throttleMaxDelay = 10 * time.Minute
+)
+
+// IsRetryableFISError reports whether err is a transient FIS failure
+// (throttling or a 5xx response) that is worth retrying later
+func IsRetryableFISError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	// Validation errors never succeed on retry
+	if IsFISValidationError(err) {
+		return false
+	}
+
+	if retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err) == aws.TrueTernary {
+		return true
+	}
+
+	var respErr *awshttp.ResponseError
+	if errors.As(err, &respErr) && respErr.HTTPStatusCode() >= 500 {
+		return true
+	}
+
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) && apiErr.ErrorFault() == smithy.FaultServer {
+		return true
+	}
+
+	return false
+}
+
+// IsFISValidationError reports whether err is a FIS ValidationException,
+// i.e. AWS rejected the request itself and retrying will not help
+func IsFISValidationError(err error) bool {
+	var validationErr *types.ValidationException
+	return errors.As(err, &validationErr)
+}
+
+// IsFISNotFoundError reports whether err is a FIS ResourceNotFoundException
+func IsFISNotFoundError(err error) bool {
+	var notFound *types.ResourceNotFoundException
+	return errors.As(err, &notFound)
+}
+
+// IsAccessDeniedError reports whether AWS rejected a call because the controller's
+// credentials lack the permission for it
+func IsAccessDeniedError(err error) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.ErrorCode() {
+	case "AccessDenied", "AccessDeniedException", "UnauthorizedOperation":
+		return true
+	default:
+		return false
+	}
+}
+
+// ThrottleBackoff returns the requeue delay for the given number of
+// consecutive throttled calls, doubling from 15s up to 10m
+func ThrottleBackoff(attempts int32) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	delay := throttleBaseDelay
+	for i := int32(1); i < attempts; i++ {
+		delay *= 2
+		if delay >= throttleMaxDelay {
+			return throttleMaxDelay
+		}
+	}
+	return delay
+}
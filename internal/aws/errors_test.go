@@ -0,0 +1,117 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awshttp "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	"github.com/aws/aws-sdk-go-v2/service/fis/types"
+	"github.com/aws/smithy-go"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+func newResponseError(statusCode int) error {
+	return &awshttp.ResponseError{
+		ResponseError: &smithyhttp.ResponseError{
+			Response: &smithyhttp.Response{Response: &http.Response{StatusCode: statusCode}},
+			Err:      errors.New("response error"),
+		},
+	}
+}
+
+func TestIsRetryableFISError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "throttling", err: &smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"}, want: true},
+		{name: "wrapped throttling", err: fmt.Errorf("failed to start experiment: %w", &smithy.GenericAPIError{Code: "ThrottlingException"}), want: true},
+		{name: "too many requests", err: &smithy.GenericAPIError{Code: "TooManyRequestsException"}, want: true},
+		{name: "server fault", err: &smithy.GenericAPIError{Code: "InternalFailure", Fault: smithy.FaultServer}, want: true},
+		{name: "5xx response", err: newResponseError(http.StatusServiceUnavailable), want: true},
+		{name: "4xx response", err: newResponseError(http.StatusBadRequest), want: false},
+		{name: "validation", err: &types.ValidationException{Message: aws.String("invalid target")}, want: false},
+		{name: "wrapped validation", err: fmt.Errorf("failed to create experiment template: %w", &types.ValidationException{}), want: false},
+		{name: "not found", err: &types.ResourceNotFoundException{}, want: false},
+		{name: "plain error", err: errors.New("boom"), want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsRetryableFISError(tt.err); got != tt.want {
+				t.Errorf("IsRetryableFISError(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsFISValidationError(t *testing.T) {
+	if !IsFISValidationError(fmt.Errorf("wrapped: %w", &types.ValidationException{})) {
+		t.Error("Expected wrapped ValidationException to be a validation error")
+	}
+	if IsFISValidationError(&smithy.GenericAPIError{Code: "ThrottlingException"}) {
+		t.Error("Expected ThrottlingException not to be a validation error")
+	}
+}
+
+func TestIsFISNotFoundError(t *testing.T) {
+	if !IsFISNotFoundError(fmt.Errorf("failed to delete experiment template: %w", &types.ResourceNotFoundException{})) {
+		t.Error("Expected wrapped ResourceNotFoundException to be a not found error")
+	}
+	if IsFISNotFoundError(&types.ValidationException{}) {
+		t.Error("Expected ValidationException not to be a not found error")
+	}
+}
+
+func TestIsAccessDeniedError(t *testing.T) {
+	if !IsAccessDeniedError(fmt.Errorf("failed to create IAM role: %w", &smithy.GenericAPIError{Code: "AccessDenied"})) {
+		t.Error("Expected wrapped AccessDenied to be an access denied error")
+	}
+	if IsAccessDeniedError(&smithy.GenericAPIError{Code: "ThrottlingException"}) {
+		t.Error("Expected ThrottlingException not to be an access denied error")
+	}
+	if IsAccessDeniedError(errors.New("boom")) {
+		t.Error("Expected a plain error not to be an access denied error")
+	}
+}
+
+func TestThrottleBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int32
+		want     time.Duration
+	}{
+		{attempts: 0, want: 15 * time.Second},
+		{attempts: 1, want: 15 * time.Second},
+		{attempts: 2, want: 30 * time.Second},
+		{attempts: 3, want: time.Minute},
+		{attempts: 10, want: 10 * time.Minute},
+	}
+
+	for _, tt := range tests {
+		if got := ThrottleBackoff(tt.attempts); got != tt.want {
+			t.Errorf("ThrottleBackoff(%d) = %s, want %s", tt.attempts, got, tt.want)
+		}
+	}
+}
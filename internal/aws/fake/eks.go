@@ -0,0 +1,177 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+)
+
+// EKS is a fake AWS EKS API that keeps access entries in memory and records every input it receives
+// Each *Func hook, when set, replaces the default behavior
+type EKS struct {
+	mu sync.Mutex
+
+	// AccessEntries holds the existing access entries keyed by principal ARN
+	AccessEntries map[string]ekstypes.AccessEntry
+
+	DescribeClusterFunc     func(*eks.DescribeClusterInput) (*eks.DescribeClusterOutput, error)
+	CreateAccessEntryFunc   func(*eks.CreateAccessEntryInput) (*eks.CreateAccessEntryOutput, error)
+	DescribeAccessEntryFunc func(*eks.DescribeAccessEntryInput) (*eks.DescribeAccessEntryOutput, error)
+	DeleteAccessEntryFunc   func(*eks.DeleteAccessEntryInput) (*eks.DeleteAccessEntryOutput, error)
+
+	AssociateAccessPolicyFunc func(*eks.AssociateAccessPolicyInput) (*eks.AssociateAccessPolicyOutput, error)
+	TagResourceFunc           func(*eks.TagResourceInput) (*eks.TagResourceOutput, error)
+
+	CreateAccessEntryInputs   []*eks.CreateAccessEntryInput
+	DescribeAccessEntryInputs []*eks.DescribeAccessEntryInput
+	DeleteAccessEntryInputs   []*eks.DeleteAccessEntryInput
+
+	AssociateAccessPolicyInputs []*eks.AssociateAccessPolicyInput
+	TagResourceInputs           []*eks.TagResourceInput
+}
+
+// DescribeCluster returns a cluster with an ARN derived from the requested name
+func (f *EKS) DescribeCluster(_ context.Context, params *eks.DescribeClusterInput, _ ...func(*eks.Options)) (*eks.DescribeClusterOutput, error) {
+	if f.DescribeClusterFunc != nil {
+		return f.DescribeClusterFunc(params)
+	}
+	return &eks.DescribeClusterOutput{
+		Cluster: &ekstypes.Cluster{
+			Name: params.Name,
+			Arn:  aws.String("arn:aws:eks:ap-northeast-2:123456789012:cluster/" + aws.ToString(params.Name)),
+		},
+	}, nil
+}
+
+// CreateAccessEntry records the input and stores the access entry
+func (f *EKS) CreateAccessEntry(_ context.Context, params *eks.CreateAccessEntryInput, _ ...func(*eks.Options)) (*eks.CreateAccessEntryOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.CreateAccessEntryInputs = append(f.CreateAccessEntryInputs, params)
+
+	if f.CreateAccessEntryFunc != nil {
+		return f.CreateAccessEntryFunc(params)
+	}
+	principalArn := aws.ToString(params.PrincipalArn)
+	if _, ok := f.AccessEntries[principalArn]; ok {
+		return nil, &ekstypes.ResourceInUseException{Message: aws.String("The specified access entry resource is already in use on this cluster.")}
+	}
+	if f.AccessEntries == nil {
+		f.AccessEntries = make(map[string]ekstypes.AccessEntry)
+	}
+	entry := ekstypes.AccessEntry{
+		AccessEntryArn: aws.String(AccessEntryArn(aws.ToString(params.ClusterName), principalArn)),
+		ClusterName:    params.ClusterName,
+		PrincipalArn:   params.PrincipalArn,
+		Username:       params.Username,
+		Tags:           params.Tags,
+	}
+	f.AccessEntries[principalArn] = entry
+	return &eks.CreateAccessEntryOutput{AccessEntry: &entry}, nil
+}
+
+// DescribeAccessEntry records the input and returns the stored access entry or ResourceNotFoundException
+func (f *EKS) DescribeAccessEntry(_ context.Context, params *eks.DescribeAccessEntryInput, _ ...func(*eks.Options)) (*eks.DescribeAccessEntryOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.DescribeAccessEntryInputs = append(f.DescribeAccessEntryInputs, params)
+
+	if f.DescribeAccessEntryFunc != nil {
+		return f.DescribeAccessEntryFunc(params)
+	}
+	entry, ok := f.AccessEntries[aws.ToString(params.PrincipalArn)]
+	if !ok {
+		return nil, &ekstypes.ResourceNotFoundException{Message: aws.String("No access entry found")}
+	}
+	return &eks.DescribeAccessEntryOutput{AccessEntry: &entry}, nil
+}
+
+// DeleteAccessEntry records the input and removes the stored access entry or returns ResourceNotFoundException
+func (f *EKS) DeleteAccessEntry(_ context.Context, params *eks.DeleteAccessEntryInput, _ ...func(*eks.Options)) (*eks.DeleteAccessEntryOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.DeleteAccessEntryInputs = append(f.DeleteAccessEntryInputs, params)
+
+	if f.DeleteAccessEntryFunc != nil {
+		return f.DeleteAccessEntryFunc(params)
+	}
+	principalArn := aws.ToString(params.PrincipalArn)
+	if _, ok := f.AccessEntries[principalArn]; !ok {
+		return nil, &ekstypes.ResourceNotFoundException{Message: aws.String("No access entry found")}
+	}
+	delete(f.AccessEntries, principalArn)
+	return &eks.DeleteAccessEntryOutput{}, nil
+}
+
+// AssociateAccessPolicy records the input and succeeds when the access entry exists
+func (f *EKS) AssociateAccessPolicy(_ context.Context, params *eks.AssociateAccessPolicyInput, _ ...func(*eks.Options)) (*eks.AssociateAccessPolicyOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.AssociateAccessPolicyInputs = append(f.AssociateAccessPolicyInputs, params)
+
+	if f.AssociateAccessPolicyFunc != nil {
+		return f.AssociateAccessPolicyFunc(params)
+	}
+	if _, ok := f.AccessEntries[aws.ToString(params.PrincipalArn)]; !ok {
+		return nil, &ekstypes.ResourceNotFoundException{Message: aws.String("No access entry found")}
+	}
+	return &eks.AssociateAccessPolicyOutput{
+		ClusterName:  params.ClusterName,
+		PrincipalArn: params.PrincipalArn,
+		AssociatedAccessPolicy: &ekstypes.AssociatedAccessPolicy{
+			PolicyArn:   params.PolicyArn,
+			AccessScope: params.AccessScope,
+		},
+	}, nil
+}
+
+// TagResource records the input and merges the tags into the access entry with the given ARN
+func (f *EKS) TagResource(_ context.Context, params *eks.TagResourceInput, _ ...func(*eks.Options)) (*eks.TagResourceOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.TagResourceInputs = append(f.TagResourceInputs, params)
+
+	if f.TagResourceFunc != nil {
+		return f.TagResourceFunc(params)
+	}
+	for principalArn, entry := range f.AccessEntries {
+		if aws.ToString(entry.AccessEntryArn) != aws.ToString(params.ResourceArn) {
+			continue
+		}
+		tags := make(map[string]string, len(entry.Tags)+len(params.Tags))
+		for k, v := range entry.Tags {
+			tags[k] = v
+		}
+		for k, v := range params.Tags {
+			tags[k] = v
+		}
+		entry.Tags = tags
+		f.AccessEntries[principalArn] = entry
+		return &eks.TagResourceOutput{}, nil
+	}
+	return nil, &ekstypes.NotFoundException{Message: aws.String("No resource found")}
+}
+
+// AccessEntryArn returns the fake ARN of the access entry for a principal in a cluster
+func AccessEntryArn(clusterName, principalArn string) string {
+	return "arn:aws:eks:ap-northeast-2:123456789012:access-entry/" + clusterName + "/" + principalArn
+}
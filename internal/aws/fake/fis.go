@@ -0,0 +1,324 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides in-memory fakes of the AWS APIs used by the controller, for tests
+package fake
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fis"
+	"github.com/aws/aws-sdk-go-v2/service/fis/types"
+)
+
+// FIS is a fake AWS FIS API that records every input it receives
+// Each *Func hook, when set, replaces the default successful response
+type FIS struct {
+	mu sync.Mutex
+
+	// TargetAccountConfigurations holds the target account configurations keyed by template ID, then account ID
+	TargetAccountConfigurations map[string]map[string]types.TargetAccountConfigurationSummary
+
+	CreateExperimentTemplateFunc func(*fis.CreateExperimentTemplateInput) (*fis.CreateExperimentTemplateOutput, error)
+	UpdateExperimentTemplateFunc func(*fis.UpdateExperimentTemplateInput) (*fis.UpdateExperimentTemplateOutput, error)
+	DeleteExperimentTemplateFunc func(*fis.DeleteExperimentTemplateInput) (*fis.DeleteExperimentTemplateOutput, error)
+	GetExperimentTemplateFunc    func(*fis.GetExperimentTemplateInput) (*fis.GetExperimentTemplateOutput, error)
+	StartExperimentFunc          func(*fis.StartExperimentInput) (*fis.StartExperimentOutput, error)
+	GetExperimentFunc            func(*fis.GetExperimentInput) (*fis.GetExperimentOutput, error)
+	StopExperimentFunc           func(*fis.StopExperimentInput) (*fis.StopExperimentOutput, error)
+	ListExperimentsFunc          func(*fis.ListExperimentsInput) (*fis.ListExperimentsOutput, error)
+
+	ListExperimentResolvedTargetsFunc func(*fis.ListExperimentResolvedTargetsInput) (*fis.ListExperimentResolvedTargetsOutput, error)
+
+	CreateTargetAccountConfigurationFunc func(*fis.CreateTargetAccountConfigurationInput) (*fis.CreateTargetAccountConfigurationOutput, error)
+	UpdateTargetAccountConfigurationFunc func(*fis.UpdateTargetAccountConfigurationInput) (*fis.UpdateTargetAccountConfigurationOutput, error)
+	DeleteTargetAccountConfigurationFunc func(*fis.DeleteTargetAccountConfigurationInput) (*fis.DeleteTargetAccountConfigurationOutput, error)
+
+	TagResourceFunc   func(*fis.TagResourceInput) (*fis.TagResourceOutput, error)
+	UntagResourceFunc func(*fis.UntagResourceInput) (*fis.UntagResourceOutput, error)
+
+	CreateExperimentTemplateInputs []*fis.CreateExperimentTemplateInput
+	UpdateExperimentTemplateInputs []*fis.UpdateExperimentTemplateInput
+	DeleteExperimentTemplateInputs []*fis.DeleteExperimentTemplateInput
+	GetExperimentTemplateInputs    []*fis.GetExperimentTemplateInput
+	StartExperimentInputs          []*fis.StartExperimentInput
+	GetExperimentInputs            []*fis.GetExperimentInput
+	StopExperimentInputs           []*fis.StopExperimentInput
+	ListExperimentsInputs          []*fis.ListExperimentsInput
+
+	ListExperimentResolvedTargetsInputs []*fis.ListExperimentResolvedTargetsInput
+
+	CreateTargetAccountConfigurationInputs []*fis.CreateTargetAccountConfigurationInput
+	UpdateTargetAccountConfigurationInputs []*fis.UpdateTargetAccountConfigurationInput
+	DeleteTargetAccountConfigurationInputs []*fis.DeleteTargetAccountConfigurationInput
+	ListTargetAccountConfigurationsInputs  []*fis.ListTargetAccountConfigurationsInput
+
+	TagResourceInputs   []*fis.TagResourceInput
+	UntagResourceInputs []*fis.UntagResourceInput
+}
+
+// CreateExperimentTemplate records the input and returns a template with a generated ID
+func (f *FIS) CreateExperimentTemplate(_ context.Context, params *fis.CreateExperimentTemplateInput, _ ...func(*fis.Options)) (*fis.CreateExperimentTemplateOutput, error) {
+	f.mu.Lock()
+	f.CreateExperimentTemplateInputs = append(f.CreateExperimentTemplateInputs, params)
+	count := len(f.CreateExperimentTemplateInputs)
+	f.mu.Unlock()
+
+	if f.CreateExperimentTemplateFunc != nil {
+		return f.CreateExperimentTemplateFunc(params)
+	}
+	return &fis.CreateExperimentTemplateOutput{
+		ExperimentTemplate: &types.ExperimentTemplate{Id: aws.String(fmt.Sprintf("EXTfake%d", count))},
+	}, nil
+}
+
+// UpdateExperimentTemplate records the input and returns the updated template ID
+func (f *FIS) UpdateExperimentTemplate(_ context.Context, params *fis.UpdateExperimentTemplateInput, _ ...func(*fis.Options)) (*fis.UpdateExperimentTemplateOutput, error) {
+	f.mu.Lock()
+	f.UpdateExperimentTemplateInputs = append(f.UpdateExperimentTemplateInputs, params)
+	f.mu.Unlock()
+
+	if f.UpdateExperimentTemplateFunc != nil {
+		return f.UpdateExperimentTemplateFunc(params)
+	}
+	return &fis.UpdateExperimentTemplateOutput{
+		ExperimentTemplate: &types.ExperimentTemplate{Id: params.Id},
+	}, nil
+}
+
+// DeleteExperimentTemplate records the input and succeeds
+func (f *FIS) DeleteExperimentTemplate(_ context.Context, params *fis.DeleteExperimentTemplateInput, _ ...func(*fis.Options)) (*fis.DeleteExperimentTemplateOutput, error) {
+	f.mu.Lock()
+	f.DeleteExperimentTemplateInputs = append(f.DeleteExperimentTemplateInputs, params)
+	f.mu.Unlock()
+
+	if f.DeleteExperimentTemplateFunc != nil {
+		return f.DeleteExperimentTemplateFunc(params)
+	}
+	return &fis.DeleteExperimentTemplateOutput{}, nil
+}
+
+// GetExperimentTemplate records the input and returns an empty template with the requested ID
+func (f *FIS) GetExperimentTemplate(_ context.Context, params *fis.GetExperimentTemplateInput, _ ...func(*fis.Options)) (*fis.GetExperimentTemplateOutput, error) {
+	f.mu.Lock()
+	f.GetExperimentTemplateInputs = append(f.GetExperimentTemplateInputs, params)
+	f.mu.Unlock()
+
+	if f.GetExperimentTemplateFunc != nil {
+		return f.GetExperimentTemplateFunc(params)
+	}
+	return &fis.GetExperimentTemplateOutput{
+		ExperimentTemplate: &types.ExperimentTemplate{Id: params.Id},
+	}, nil
+}
+
+// StartExperiment records the input and returns an initiating experiment with a generated ID
+func (f *FIS) StartExperiment(_ context.Context, params *fis.StartExperimentInput, _ ...func(*fis.Options)) (*fis.StartExperimentOutput, error) {
+	f.mu.Lock()
+	f.StartExperimentInputs = append(f.StartExperimentInputs, params)
+	count := len(f.StartExperimentInputs)
+	f.mu.Unlock()
+
+	if f.StartExperimentFunc != nil {
+		return f.StartExperimentFunc(params)
+	}
+	return &fis.StartExperimentOutput{
+		Experiment: &types.Experiment{
+			Id:                   aws.String(fmt.Sprintf("EXPfake%d", count)),
+			ExperimentTemplateId: params.ExperimentTemplateId,
+			State:                &types.ExperimentState{Status: types.ExperimentStatusInitiating},
+			Tags:                 params.Tags,
+		},
+	}, nil
+}
+
+// GetExperiment records the input and returns a running experiment with the requested ID
+func (f *FIS) GetExperiment(_ context.Context, params *fis.GetExperimentInput, _ ...func(*fis.Options)) (*fis.GetExperimentOutput, error) {
+	f.mu.Lock()
+	f.GetExperimentInputs = append(f.GetExperimentInputs, params)
+	f.mu.Unlock()
+
+	if f.GetExperimentFunc != nil {
+		return f.GetExperimentFunc(params)
+	}
+	return &fis.GetExperimentOutput{
+		Experiment: &types.Experiment{
+			Id:    params.Id,
+			State: &types.ExperimentState{Status: types.ExperimentStatusRunning},
+		},
+	}, nil
+}
+
+// StopExperiment records the input and returns a stopping experiment
+func (f *FIS) StopExperiment(_ context.Context, params *fis.StopExperimentInput, _ ...func(*fis.Options)) (*fis.StopExperimentOutput, error) {
+	f.mu.Lock()
+	f.StopExperimentInputs = append(f.StopExperimentInputs, params)
+	f.mu.Unlock()
+
+	if f.StopExperimentFunc != nil {
+		return f.StopExperimentFunc(params)
+	}
+	return &fis.StopExperimentOutput{
+		Experiment: &types.Experiment{
+			Id:    params.Id,
+			State: &types.ExperimentState{Status: types.ExperimentStatusStopping},
+		},
+	}, nil
+}
+
+// ListExperiments records the input and returns no experiments
+func (f *FIS) ListExperiments(_ context.Context, params *fis.ListExperimentsInput, _ ...func(*fis.Options)) (*fis.ListExperimentsOutput, error) {
+	f.mu.Lock()
+	f.ListExperimentsInputs = append(f.ListExperimentsInputs, params)
+	f.mu.Unlock()
+
+	if f.ListExperimentsFunc != nil {
+		return f.ListExperimentsFunc(params)
+	}
+	return &fis.ListExperimentsOutput{}, nil
+}
+
+// ListExperimentResolvedTargets records the input and returns no resolved targets
+func (f *FIS) ListExperimentResolvedTargets(_ context.Context, params *fis.ListExperimentResolvedTargetsInput, _ ...func(*fis.Options)) (*fis.ListExperimentResolvedTargetsOutput, error) {
+	f.mu.Lock()
+	f.ListExperimentResolvedTargetsInputs = append(f.ListExperimentResolvedTargetsInputs, params)
+	f.mu.Unlock()
+
+	if f.ListExperimentResolvedTargetsFunc != nil {
+		return f.ListExperimentResolvedTargetsFunc(params)
+	}
+	return &fis.ListExperimentResolvedTargetsOutput{}, nil
+}
+
+// CreateTargetAccountConfiguration records the input and stores the configuration
+func (f *FIS) CreateTargetAccountConfiguration(_ context.Context, params *fis.CreateTargetAccountConfigurationInput, _ ...func(*fis.Options)) (*fis.CreateTargetAccountConfigurationOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.CreateTargetAccountConfigurationInputs = append(f.CreateTargetAccountConfigurationInputs, params)
+
+	if f.CreateTargetAccountConfigurationFunc != nil {
+		return f.CreateTargetAccountConfigurationFunc(params)
+	}
+	templateID, accountID := aws.ToString(params.ExperimentTemplateId), aws.ToString(params.AccountId)
+	if _, ok := f.TargetAccountConfigurations[templateID][accountID]; ok {
+		return nil, &types.ConflictException{Message: aws.String("target account configuration already exists")}
+	}
+	if f.TargetAccountConfigurations == nil {
+		f.TargetAccountConfigurations = make(map[string]map[string]types.TargetAccountConfigurationSummary)
+	}
+	if f.TargetAccountConfigurations[templateID] == nil {
+		f.TargetAccountConfigurations[templateID] = make(map[string]types.TargetAccountConfigurationSummary)
+	}
+	f.TargetAccountConfigurations[templateID][accountID] = types.TargetAccountConfigurationSummary{
+		AccountId:   params.AccountId,
+		RoleArn:     params.RoleArn,
+		Description: params.Description,
+	}
+	return &fis.CreateTargetAccountConfigurationOutput{
+		TargetAccountConfiguration: &types.TargetAccountConfiguration{
+			AccountId:   params.AccountId,
+			RoleArn:     params.RoleArn,
+			Description: params.Description,
+		},
+	}, nil
+}
+
+// UpdateTargetAccountConfiguration records the input and updates the stored configuration or returns ResourceNotFoundException
+func (f *FIS) UpdateTargetAccountConfiguration(_ context.Context, params *fis.UpdateTargetAccountConfigurationInput, _ ...func(*fis.Options)) (*fis.UpdateTargetAccountConfigurationOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.UpdateTargetAccountConfigurationInputs = append(f.UpdateTargetAccountConfigurationInputs, params)
+
+	if f.UpdateTargetAccountConfigurationFunc != nil {
+		return f.UpdateTargetAccountConfigurationFunc(params)
+	}
+	templateID, accountID := aws.ToString(params.ExperimentTemplateId), aws.ToString(params.AccountId)
+	config, ok := f.TargetAccountConfigurations[templateID][accountID]
+	if !ok {
+		return nil, &types.ResourceNotFoundException{Message: aws.String("target account configuration not found")}
+	}
+	if params.RoleArn != nil {
+		config.RoleArn = params.RoleArn
+	}
+	if params.Description != nil {
+		config.Description = params.Description
+	}
+	f.TargetAccountConfigurations[templateID][accountID] = config
+	return &fis.UpdateTargetAccountConfigurationOutput{
+		TargetAccountConfiguration: &types.TargetAccountConfiguration{
+			AccountId:   config.AccountId,
+			RoleArn:     config.RoleArn,
+			Description: config.Description,
+		},
+	}, nil
+}
+
+// DeleteTargetAccountConfiguration records the input and removes the stored configuration or returns ResourceNotFoundException
+func (f *FIS) DeleteTargetAccountConfiguration(_ context.Context, params *fis.DeleteTargetAccountConfigurationInput, _ ...func(*fis.Options)) (*fis.DeleteTargetAccountConfigurationOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.DeleteTargetAccountConfigurationInputs = append(f.DeleteTargetAccountConfigurationInputs, params)
+
+	if f.DeleteTargetAccountConfigurationFunc != nil {
+		return f.DeleteTargetAccountConfigurationFunc(params)
+	}
+	templateID, accountID := aws.ToString(params.ExperimentTemplateId), aws.ToString(params.AccountId)
+	if _, ok := f.TargetAccountConfigurations[templateID][accountID]; !ok {
+		return nil, &types.ResourceNotFoundException{Message: aws.String("target account configuration not found")}
+	}
+	delete(f.TargetAccountConfigurations[templateID], accountID)
+	return &fis.DeleteTargetAccountConfigurationOutput{}, nil
+}
+
+// ListTargetAccountConfigurations records the input and returns the stored configurations of the template in one page
+func (f *FIS) ListTargetAccountConfigurations(_ context.Context, params *fis.ListTargetAccountConfigurationsInput, _ ...func(*fis.Options)) (*fis.ListTargetAccountConfigurationsOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.ListTargetAccountConfigurationsInputs = append(f.ListTargetAccountConfigurationsInputs, params)
+
+	output := &fis.ListTargetAccountConfigurationsOutput{}
+	for _, config := range f.TargetAccountConfigurations[aws.ToString(params.ExperimentTemplateId)] {
+		output.TargetAccountConfigurations = append(output.TargetAccountConfigurations, config)
+	}
+	return output, nil
+}
+
+// TagResource records the input and succeeds
+func (f *FIS) TagResource(_ context.Context, params *fis.TagResourceInput, _ ...func(*fis.Options)) (*fis.TagResourceOutput, error) {
+	f.mu.Lock()
+	f.TagResourceInputs = append(f.TagResourceInputs, params)
+	f.mu.Unlock()
+
+	if f.TagResourceFunc != nil {
+		return f.TagResourceFunc(params)
+	}
+	return &fis.TagResourceOutput{}, nil
+}
+
+// UntagResource records the input and succeeds
+func (f *FIS) UntagResource(_ context.Context, params *fis.UntagResourceInput, _ ...func(*fis.Options)) (*fis.UntagResourceOutput, error) {
+	f.mu.Lock()
+	f.UntagResourceInputs = append(f.UntagResourceInputs, params)
+	f.mu.Unlock()
+
+	if f.UntagResourceFunc != nil {
+		return f.UntagResourceFunc(params)
+	}
+	return &fis.UntagResourceOutput{}, nil
+}
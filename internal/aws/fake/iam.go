@@ -0,0 +1,194 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// IAM is a fake AWS IAM API that keeps roles in memory and records every input it receives
+// Each *Func hook, when set, replaces the default behavior
+type IAM struct {
+	mu sync.Mutex
+
+	// Roles holds the existing roles keyed by role name
+	Roles map[string]iamtypes.Role
+
+	// AttachedPolicies holds the managed policy ARNs attached to each role, keyed by role name
+	AttachedPolicies map[string][]string
+
+	GetRoleFunc    func(*iam.GetRoleInput) (*iam.GetRoleOutput, error)
+	CreateRoleFunc func(*iam.CreateRoleInput) (*iam.CreateRoleOutput, error)
+	DeleteRoleFunc func(*iam.DeleteRoleInput) (*iam.DeleteRoleOutput, error)
+
+	PutRolePolicyFunc func(*iam.PutRolePolicyInput) (*iam.PutRolePolicyOutput, error)
+
+	AttachRolePolicyFunc func(*iam.AttachRolePolicyInput) (*iam.AttachRolePolicyOutput, error)
+	DetachRolePolicyFunc func(*iam.DetachRolePolicyInput) (*iam.DetachRolePolicyOutput, error)
+
+	GetRoleInputs       []*iam.GetRoleInput
+	CreateRoleInputs    []*iam.CreateRoleInput
+	DeleteRoleInputs    []*iam.DeleteRoleInput
+	PutRolePolicyInputs []*iam.PutRolePolicyInput
+
+	AttachRolePolicyInputs []*iam.AttachRolePolicyInput
+	DetachRolePolicyInputs []*iam.DetachRolePolicyInput
+}
+
+// RoleArn returns the ARN the fake assigns to a role at the root path
+func RoleArn(roleName string) string {
+	return RolePathArn("/", roleName)
+}
+
+// RolePathArn returns the ARN the fake assigns to a role under an IAM path such as /chaos/
+func RolePathArn(path, roleName string) string {
+	if path == "" {
+		path = "/"
+	}
+	return "arn:aws:iam::123456789012:role" + path + roleName
+}
+
+// GetRole records the input and returns the stored role, or NoSuchEntityException
+func (f *IAM) GetRole(_ context.Context, params *iam.GetRoleInput, _ ...func(*iam.Options)) (*iam.GetRoleOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.GetRoleInputs = append(f.GetRoleInputs, params)
+
+	if f.GetRoleFunc != nil {
+		return f.GetRoleFunc(params)
+	}
+	role, ok := f.Roles[aws.ToString(params.RoleName)]
+	if !ok {
+		return nil, &iamtypes.NoSuchEntityException{Message: aws.String("role not found")}
+	}
+	return &iam.GetRoleOutput{Role: &role}, nil
+}
+
+// CreateRole records the input and stores the role
+func (f *IAM) CreateRole(_ context.Context, params *iam.CreateRoleInput, _ ...func(*iam.Options)) (*iam.CreateRoleOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.CreateRoleInputs = append(f.CreateRoleInputs, params)
+
+	if f.CreateRoleFunc != nil {
+		return f.CreateRoleFunc(params)
+	}
+	role := iamtypes.Role{
+		RoleName: params.RoleName,
+		Path:     params.Path,
+		Arn:      aws.String(RolePathArn(aws.ToString(params.Path), aws.ToString(params.RoleName))),
+		Tags:     params.Tags,
+	}
+	if f.Roles == nil {
+		f.Roles = make(map[string]iamtypes.Role)
+	}
+	f.Roles[aws.ToString(params.RoleName)] = role
+	return &iam.CreateRoleOutput{Role: &role}, nil
+}
+
+// DeleteRole records the input and removes the role, or returns DeleteConflictException while policies are attached
+func (f *IAM) DeleteRole(_ context.Context, params *iam.DeleteRoleInput, _ ...func(*iam.Options)) (*iam.DeleteRoleOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.DeleteRoleInputs = append(f.DeleteRoleInputs, params)
+
+	if f.DeleteRoleFunc != nil {
+		return f.DeleteRoleFunc(params)
+	}
+	if len(f.AttachedPolicies[aws.ToString(params.RoleName)]) > 0 {
+		return nil, &iamtypes.DeleteConflictException{Message: aws.String("role has attached policies")}
+	}
+	delete(f.Roles, aws.ToString(params.RoleName))
+	return &iam.DeleteRoleOutput{}, nil
+}
+
+// PutRolePolicy records the input and succeeds
+func (f *IAM) PutRolePolicy(_ context.Context, params *iam.PutRolePolicyInput, _ ...func(*iam.Options)) (*iam.PutRolePolicyOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.PutRolePolicyInputs = append(f.PutRolePolicyInputs, params)
+
+	if f.PutRolePolicyFunc != nil {
+		return f.PutRolePolicyFunc(params)
+	}
+	return &iam.PutRolePolicyOutput{}, nil
+}
+
+// ListRolePolicies returns no inline policies
+func (f *IAM) ListRolePolicies(_ context.Context, _ *iam.ListRolePoliciesInput, _ ...func(*iam.Options)) (*iam.ListRolePoliciesOutput, error) {
+	return &iam.ListRolePoliciesOutput{}, nil
+}
+
+// DeleteRolePolicy succeeds
+func (f *IAM) DeleteRolePolicy(_ context.Context, _ *iam.DeleteRolePolicyInput, _ ...func(*iam.Options)) (*iam.DeleteRolePolicyOutput, error) {
+	return &iam.DeleteRolePolicyOutput{}, nil
+}
+
+// AttachRolePolicy records the input and stores the attachment
+func (f *IAM) AttachRolePolicy(_ context.Context, params *iam.AttachRolePolicyInput, _ ...func(*iam.Options)) (*iam.AttachRolePolicyOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.AttachRolePolicyInputs = append(f.AttachRolePolicyInputs, params)
+
+	if f.AttachRolePolicyFunc != nil {
+		return f.AttachRolePolicyFunc(params)
+	}
+	roleName := aws.ToString(params.RoleName)
+	if f.AttachedPolicies == nil {
+		f.AttachedPolicies = make(map[string][]string)
+	}
+	if !slices.Contains(f.AttachedPolicies[roleName], aws.ToString(params.PolicyArn)) {
+		f.AttachedPolicies[roleName] = append(f.AttachedPolicies[roleName], aws.ToString(params.PolicyArn))
+	}
+	return &iam.AttachRolePolicyOutput{}, nil
+}
+
+// DetachRolePolicy records the input and removes the attachment, or returns NoSuchEntityException
+func (f *IAM) DetachRolePolicy(_ context.Context, params *iam.DetachRolePolicyInput, _ ...func(*iam.Options)) (*iam.DetachRolePolicyOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.DetachRolePolicyInputs = append(f.DetachRolePolicyInputs, params)
+
+	if f.DetachRolePolicyFunc != nil {
+		return f.DetachRolePolicyFunc(params)
+	}
+	roleName := aws.ToString(params.RoleName)
+	index := slices.Index(f.AttachedPolicies[roleName], aws.ToString(params.PolicyArn))
+	if index < 0 {
+		return nil, &iamtypes.NoSuchEntityException{Message: aws.String("policy not attached")}
+	}
+	f.AttachedPolicies[roleName] = slices.Delete(f.AttachedPolicies[roleName], index, index+1)
+	return &iam.DetachRolePolicyOutput{}, nil
+}
+
+// ListAttachedRolePolicies returns the stored attachments of a role in a single page
+func (f *IAM) ListAttachedRolePolicies(_ context.Context, params *iam.ListAttachedRolePoliciesInput, _ ...func(*iam.Options)) (*iam.ListAttachedRolePoliciesOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	output := &iam.ListAttachedRolePoliciesOutput{}
+	for _, policyArn := range f.AttachedPolicies[aws.ToString(params.RoleName)] {
+		output.AttachedPolicies = append(output.AttachedPolicies, iamtypes.AttachedPolicy{PolicyArn: aws.String(policyArn)})
+	}
+	return output, nil
+}
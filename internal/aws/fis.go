@@ -18,8 +18,11 @@ package aws
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
@@ -33,9 +36,29 @@ import (
 	fisv1alpha1 "fis.dksshddl.dev/fis-controller/api/v1alpha1"
 )
 
+// FISAPI is the subset of the AWS FIS API used by FISClient
+// It is satisfied by *fis.Client and allows tests to substitute a fake
+type FISAPI interface {
+	CreateExperimentTemplate(ctx context.Context, params *fis.CreateExperimentTemplateInput, optFns ...func(*fis.Options)) (*fis.CreateExperimentTemplateOutput, error)
+	UpdateExperimentTemplate(ctx context.Context, params *fis.UpdateExperimentTemplateInput, optFns ...func(*fis.Options)) (*fis.UpdateExperimentTemplateOutput, error)
+	DeleteExperimentTemplate(ctx context.Context, params *fis.DeleteExperimentTemplateInput, optFns ...func(*fis.Options)) (*fis.DeleteExperimentTemplateOutput, error)
+	GetExperimentTemplate(ctx context.Context, params *fis.GetExperimentTemplateInput, optFns ...func(*fis.Options)) (*fis.GetExperimentTemplateOutput, error)
+	StartExperiment(ctx context.Context, params *fis.StartExperimentInput, optFns ...func(*fis.Options)) (*fis.StartExperimentOutput, error)
+	GetExperiment(ctx context.Context, params *fis.GetExperimentInput, optFns ...func(*fis.Options)) (*fis.GetExperimentOutput, error)
+	StopExperiment(ctx context.Context, params *fis.StopExperimentInput, optFns ...func(*fis.Options)) (*fis.StopExperimentOutput, error)
+	ListExperiments(ctx context.Context, params *fis.ListExperimentsInput, optFns ...func(*fis.Options)) (*fis.ListExperimentsOutput, error)
+	ListExperimentResolvedTargets(ctx context.Context, params *fis.ListExperimentResolvedTargetsInput, optFns ...func(*fis.Options)) (*fis.ListExperimentResolvedTargetsOutput, error)
+	CreateTargetAccountConfiguration(ctx context.Context, params *fis.CreateTargetAccountConfigurationInput, optFns ...func(*fis.Options)) (*fis.CreateTargetAccountConfigurationOutput, error)
+	UpdateTargetAccountConfiguration(ctx context.Context, params *fis.UpdateTargetAccountConfigurationInput, optFns ...func(*fis.Options)) (*fis.UpdateTargetAccountConfigurationOutput, error)
+	DeleteTargetAccountConfiguration(ctx context.Context, params *fis.DeleteTargetAccountConfigurationInput, optFns ...func(*fis.Options)) (*fis.DeleteTargetAccountConfigurationOutput, error)
+	ListTargetAccountConfigurations(ctx context.Context, params *fis.ListTargetAccountConfigurationsInput, optFns ...func(*fis.Options)) (*fis.ListTargetAccountConfigurationsOutput, error)
+	TagResource(ctx context.Context, params *fis.TagResourceInput, optFns ...func(*fis.Options)) (*fis.TagResourceOutput, error)
+	UntagResource(ctx context.Context, params *fis.UntagResourceInput, optFns ...func(*fis.Options)) (*fis.UntagResourceOutput, error)
+}
+
 // FISClient wraps AWS FIS client
 type FISClient struct {
-	client    *fis.Client
+	client    FISAPI
 	awsConfig aws.Config
 }
 
@@ -43,6 +66,9 @@ type FISClient struct {
 type FISConfig struct {
 	Region     string
 	MaxRetries int
+	// RetryMode selects the SDK retryer, standard (default) or adaptive
+	// Adaptive adds client-side rate limiting, which behaves better under sustained throttling
+	RetryMode aws.RetryMode
 }
 
 // NewFISClient creates a new FIS client
@@ -73,14 +99,15 @@ func NewFISClient(ctx context.Context, cfg FISConfig) (*FISClient, error) {
 		maxRetries = 3
 	}
 
+	retryer, err := newRetryer(cfg.RetryMode, maxRetries)
+	if err != nil {
+		return nil, err
+	}
+
 	// Load AWS config
 	awsConfig, err := config.LoadDefaultConfig(ctx,
 		config.WithRegion(region),
-		config.WithRetryer(func() aws.Retryer {
-			return retry.NewStandard(func(o *retry.StandardOptions) {
-				o.MaxAttempts = maxRetries
-			})
-		}),
+		config.WithRetryer(retryer),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load AWS config: %w", err)
@@ -92,10 +119,71 @@ func NewFISClient(ctx context.Context, cfg FISConfig) (*FISClient, error) {
 	}, nil
 }
 
+// newRetryer returns the retryer constructor for the given retry mode, standard when empty
+func newRetryer(mode aws.RetryMode, maxAttempts int) (func() aws.Retryer, error) {
+	standardOptions := func(o *retry.StandardOptions) {
+		o.MaxAttempts = maxAttempts
+	}
+
+	switch mode {
+	case "", aws.RetryModeStandard:
+		return func() aws.Retryer {
+			return retry.NewStandard(standardOptions)
+		}, nil
+	case aws.RetryModeAdaptive:
+		return func() aws.Retryer {
+			return retry.NewAdaptiveMode(func(o *retry.AdaptiveModeOptions) {
+				o.StandardOptions = append(o.StandardOptions, standardOptions)
+			})
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported AWS retry mode %q, must be %s or %s", mode, aws.RetryModeStandard, aws.RetryModeAdaptive)
+	}
+}
+
+// NewFISClientFromAPI creates a FIS client backed by the given API implementation
+func NewFISClientFromAPI(api FISAPI, awsConfig aws.Config) *FISClient {
+	return &FISClient{
+		client:    api,
+		awsConfig: awsConfig,
+	}
+}
+
 // CreateExperimentTemplate creates an AWS FIS experiment template from CRD spec
 func (c *FISClient) CreateExperimentTemplate(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, roleArn, clusterIdentifier, serviceAccount string) (string, error) {
+	input, err := c.buildCreateInput(template, roleArn, clusterIdentifier, serviceAccount)
+	if err != nil {
+		return "", err
+	}
+	input.ClientToken = aws.String(uuid.New().String())
+
+	// Create the experiment template
+	output, err := c.client.CreateExperimentTemplate(ctx, input)
+	if err != nil {
+		return "", fmt.Errorf("failed to create experiment template: %w", err)
+	}
+
+	return aws.ToString(output.ExperimentTemplate.Id), nil
+}
+
+// RenderExperimentTemplate returns the CreateExperimentTemplate input for the CRD spec as indented JSON
+// without calling AWS, so the request can be reviewed before it is sent
+func (c *FISClient) RenderExperimentTemplate(template *fisv1alpha1.ExperimentTemplate, roleArn, clusterIdentifier, serviceAccount string) (string, error) {
+	input, err := c.buildCreateInput(template, roleArn, clusterIdentifier, serviceAccount)
+	if err != nil {
+		return "", err
+	}
+
+	rendered, err := json.MarshalIndent(input, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to render experiment template: %w", err)
+	}
+	return string(rendered), nil
+}
+
+// buildCreateInput converts the CRD spec into the input for a new AWS FIS experiment template
+func (c *FISClient) buildCreateInput(template *fisv1alpha1.ExperimentTemplate, roleArn, clusterIdentifier, serviceAccount string) (*fis.CreateExperimentTemplateInput, error) {
 	input := &fis.CreateExperimentTemplateInput{
-		ClientToken: aws.String(uuid.New().String()),
 		Description: aws.String(template.Spec.Description),
 		RoleArn:     aws.String(roleArn),
 	}
@@ -103,14 +191,14 @@ func (c *FISClient) CreateExperimentTemplate(ctx context.Context, template *fisv
 	// Convert targets
 	targets, err := c.convertTargets(template.Spec.Targets, clusterIdentifier)
 	if err != nil {
-		return "", fmt.Errorf("failed to convert targets: %w", err)
+		return nil, fmt.Errorf("failed to convert targets: %w", err)
 	}
 	input.Targets = targets
 
 	// Convert actions
-	actions, err := c.convertActions(template.Spec.Actions, serviceAccount)
+	actions, err := c.convertActions(template.Spec.SequencedActions(), serviceAccount, template.Spec.Parameters)
 	if err != nil {
-		return "", fmt.Errorf("failed to convert actions: %w", err)
+		return nil, fmt.Errorf("failed to convert actions: %w", err)
 	}
 	input.Actions = actions
 
@@ -131,7 +219,11 @@ func (c *FISClient) CreateExperimentTemplate(ctx context.Context, template *fisv
 
 	// Convert experiment report configuration
 	if template.Spec.ExperimentReportConfiguration != nil {
-		input.ExperimentReportConfiguration = c.convertExperimentReportConfiguration(template.Spec.ExperimentReportConfiguration)
+		reportConfig, err := c.convertExperimentReportConfiguration(template.Spec.ExperimentReportConfiguration)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert experiment report configuration: %w", err)
+		}
+		input.ExperimentReportConfiguration = reportConfig
 	}
 
 	// Convert tags and add management tags
@@ -147,17 +239,27 @@ func (c *FISClient) CreateExperimentTemplate(ctx context.Context, template *fisv
 
 	input.Tags = tags
 
-	// Create the experiment template
-	output, err := c.client.CreateExperimentTemplate(ctx, input)
+	return input, nil
+}
+
+// UpdateExperimentTemplate updates an AWS FIS experiment template
+func (c *FISClient) UpdateExperimentTemplate(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, templateID, roleArn, clusterIdentifier, serviceAccount string) error {
+	input, err := c.buildUpdateInput(template, templateID, roleArn, clusterIdentifier, serviceAccount)
 	if err != nil {
-		return "", fmt.Errorf("failed to create experiment template: %w", err)
+		return err
 	}
 
-	return aws.ToString(output.ExperimentTemplate.Id), nil
+	// Update the experiment template
+	_, err = c.client.UpdateExperimentTemplate(ctx, input)
+	if err != nil {
+		return fmt.Errorf("failed to update experiment template: %w", err)
+	}
+
+	return nil
 }
 
-// UpdateExperimentTemplate updates an AWS FIS experiment template
-func (c *FISClient) UpdateExperimentTemplate(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, templateID, roleArn, clusterIdentifier, serviceAccount string) error {
+// buildUpdateInput converts the CRD spec into the desired state of an existing AWS FIS experiment template
+func (c *FISClient) buildUpdateInput(template *fisv1alpha1.ExperimentTemplate, templateID, roleArn, clusterIdentifier, serviceAccount string) (*fis.UpdateExperimentTemplateInput, error) {
 	input := &fis.UpdateExperimentTemplateInput{
 		Id:          aws.String(templateID),
 		Description: aws.String(template.Spec.Description),
@@ -167,14 +269,14 @@ func (c *FISClient) UpdateExperimentTemplate(ctx context.Context, template *fisv
 	// Convert targets for update
 	targets, err := c.convertTargetsForUpdate(template.Spec.Targets, clusterIdentifier)
 	if err != nil {
-		return fmt.Errorf("failed to convert targets: %w", err)
+		return nil, fmt.Errorf("failed to convert targets: %w", err)
 	}
 	input.Targets = targets
 
 	// Convert actions for update
-	actions, err := c.convertActionsForUpdate(template.Spec.Actions, serviceAccount)
+	actions, err := c.convertActionsForUpdate(template.Spec.SequencedActions(), serviceAccount, template.Spec.Parameters)
 	if err != nil {
-		return fmt.Errorf("failed to convert actions: %w", err)
+		return nil, fmt.Errorf("failed to convert actions: %w", err)
 	}
 	input.Actions = actions
 
@@ -193,13 +295,16 @@ func (c *FISClient) UpdateExperimentTemplate(ctx context.Context, template *fisv
 		input.LogConfiguration = c.convertLogConfigurationForUpdate(template.Spec.LogConfiguration)
 	}
 
-	// Update the experiment template
-	_, err = c.client.UpdateExperimentTemplate(ctx, input)
-	if err != nil {
-		return fmt.Errorf("failed to update experiment template: %w", err)
+	// Convert experiment report configuration for update
+	if template.Spec.ExperimentReportConfiguration != nil {
+		reportConfig, err := c.convertExperimentReportConfigurationForUpdate(template.Spec.ExperimentReportConfiguration)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert experiment report configuration: %w", err)
+		}
+		input.ExperimentReportConfiguration = reportConfig
 	}
 
-	return nil
+	return input, nil
 }
 
 // DeleteExperimentTemplate deletes an AWS FIS experiment template
@@ -230,8 +335,73 @@ func (c *FISClient) GetExperimentTemplate(ctx context.Context, templateID string
 	return output.ExperimentTemplate, nil
 }
 
+// ResolvedConfig returns the values AWS FIS stored for a template that the controller derives from the spec
+// It returns nil for a nil template
+func ResolvedConfig(template *types.ExperimentTemplate) *fisv1alpha1.ResolvedTemplateConfig {
+	if template == nil {
+		return nil
+	}
+	resolved := &fisv1alpha1.ResolvedTemplateConfig{}
+	for name, target := range template.Targets {
+		if resolved.TargetSelectionModes == nil {
+			resolved.TargetSelectionModes = make(map[string]string, len(template.Targets))
+		}
+		resolved.TargetSelectionModes[name] = aws.ToString(target.SelectionMode)
+	}
+	for name, action := range template.Actions {
+		if duration, ok := action.Parameters["duration"]; ok {
+			if resolved.ActionDurations == nil {
+				resolved.ActionDurations = make(map[string]string, len(template.Actions))
+			}
+			resolved.ActionDurations[name] = duration
+		}
+	}
+	if template.ExperimentOptions != nil {
+		resolved.EmptyTargetResolutionMode = string(template.ExperimentOptions.EmptyTargetResolutionMode)
+	}
+	return resolved
+}
+
+// templateConsistencyDelays are the waits between GetExperimentTemplate attempts while a newly
+// created template is not yet visible, about 7.5s in total
+var templateConsistencyDelays = []time.Duration{500 * time.Millisecond, time.Second, 2 * time.Second, 4 * time.Second}
+
+// WaitForExperimentTemplate gets a newly created experiment template, retrying with backoff while
+// FIS still reports it as not found due to eventual consistency
+// Other errors are returned immediately
+func (c *FISClient) WaitForExperimentTemplate(ctx context.Context, templateID string) (*types.ExperimentTemplate, error) {
+	for attempt := 0; ; attempt++ {
+		template, err := c.GetExperimentTemplate(ctx, templateID)
+		if err == nil || !IsFISNotFoundError(err) || attempt >= len(templateConsistencyDelays) {
+			return template, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to wait for experiment template %s: %w", templateID, ctx.Err())
+		case <-time.After(templateConsistencyDelays[attempt]):
+		}
+	}
+}
+
+// ClientTokenTag is the experiment tag carrying the client token a run was started with,
+// so retries of the same logical run can be recognized when listing experiments
+const ClientTokenTag = "fis.dksshddl.dev/client-token"
+
+// StartedByTag is the experiment tag naming the controller pod that started the run
+const StartedByTag = "fis.dksshddl.dev/started-by"
+
+// ExperimentNameTag and ExperimentNamespaceTag name the Experiment a run was started for
+const (
+	ExperimentNameTag      = "kubernetes.io/name"
+	ExperimentNamespaceTag = "kubernetes.io/namespace"
+)
+
 // StartExperiment starts an AWS FIS experiment from a template
-func (c *FISClient) StartExperiment(ctx context.Context, experiment *fisv1alpha1.Experiment) (string, error) {
+// An empty clientToken falls back to the spec's client token, or a random one
+// A non-empty startedBy is recorded in the StartedByTag
+// The experiment is tagged with templateTags merged with the spec tags, which win on conflicting keys
+func (c *FISClient) StartExperiment(ctx context.Context, experiment *fisv1alpha1.Experiment, templateTags []fisv1alpha1.Tag, clientToken, startedBy string) (string, error) {
 	// Use the resolved template ID from status
 	templateID := experiment.Status.TemplateID
 	if templateID == "" {
@@ -243,26 +413,24 @@ func (c *FISClient) StartExperiment(ctx context.Context, experiment *fisv1alpha1
 	}
 
 	// Set client token if provided, otherwise generate one
-	if experiment.Spec.ClientToken != "" {
+	switch {
+	case clientToken != "":
+		input.ClientToken = aws.String(clientToken)
+	case experiment.Spec.ClientToken != "":
 		input.ClientToken = aws.String(experiment.Spec.ClientToken)
-	} else {
+	default:
 		input.ClientToken = aws.String(uuid.New().String())
 	}
 
-	// Convert tags
-	if len(experiment.Spec.Tags) > 0 {
-		tags := c.convertTags(experiment.Spec.Tags)
-		// Add management tags
-		tags["ManagedBy"] = "aws-fis-controller"
-		tags["kubernetes.io/name"] = experiment.Name
-		tags["kubernetes.io/namespace"] = experiment.Namespace
-		input.Tags = tags
-	} else {
-		// Add management tags even if no user tags
-		input.Tags = map[string]string{
-			"ManagedBy":               "aws-fis-controller",
-			"kubernetes.io/name":      experiment.Name,
-			"kubernetes.io/namespace": experiment.Namespace,
+	input.Tags = c.experimentTags(experiment, templateTags)
+	input.Tags[ClientTokenTag] = aws.ToString(input.ClientToken)
+	if startedBy != "" {
+		input.Tags[StartedByTag] = startedBy
+	}
+
+	if opts := experiment.Spec.ExperimentOptions; opts != nil && opts.ActionsMode != "" {
+		input.ExperimentOptions = &types.StartExperimentExperimentOptionsInput{
+			ActionsMode: types.ActionsMode(opts.ActionsMode),
 		}
 	}
 
@@ -275,6 +443,60 @@ func (c *FISClient) StartExperiment(ctx context.Context, experiment *fisv1alpha1
 	return aws.ToString(output.Experiment.Id), nil
 }
 
+// experimentTags returns the template tags overridden by the spec tags of an experiment, together with the management tags
+func (c *FISClient) experimentTags(experiment *fisv1alpha1.Experiment, templateTags []fisv1alpha1.Tag) map[string]string {
+	tags := c.convertTags(templateTags)
+	for key, value := range c.convertTags(experiment.Spec.Tags) {
+		tags[key] = value
+	}
+	tags["ManagedBy"] = "aws-fis-controller"
+	tags[ExperimentNameTag] = experiment.Name
+	tags[ExperimentNamespaceTag] = experiment.Namespace
+	return tags
+}
+
+// SyncExperimentTags applies changes of the spec tags to a running AWS FIS experiment
+// Tags missing from the spec and templateTags are removed, except the run tags set at start and AWS reserved tags
+func (c *FISClient) SyncExperimentTags(ctx context.Context, experiment *fisv1alpha1.Experiment, templateTags []fisv1alpha1.Tag, awsExperiment *types.Experiment) error {
+	if awsExperiment.Arn == nil {
+		return fmt.Errorf("experiment %s has no ARN", aws.ToString(awsExperiment.Id))
+	}
+
+	desired := c.experimentTags(experiment, templateTags)
+	changed := make(map[string]string)
+	for key, value := range desired {
+		if current, ok := awsExperiment.Tags[key]; !ok || current != value {
+			changed[key] = value
+		}
+	}
+	var removed []string
+	for key := range awsExperiment.Tags {
+		if _, ok := desired[key]; ok || key == ClientTokenTag || key == StartedByTag || strings.HasPrefix(key, "aws:") {
+			continue
+		}
+		removed = append(removed, key)
+	}
+	sort.Strings(removed)
+
+	if len(changed) > 0 {
+		if _, err := c.client.TagResource(ctx, &fis.TagResourceInput{
+			ResourceArn: awsExperiment.Arn,
+			Tags:        changed,
+		}); err != nil {
+			return fmt.Errorf("failed to tag experiment: %w", err)
+		}
+	}
+	if len(removed) > 0 {
+		if _, err := c.client.UntagResource(ctx, &fis.UntagResourceInput{
+			ResourceArn: awsExperiment.Arn,
+			TagKeys:     removed,
+		}); err != nil {
+			return fmt.Errorf("failed to untag experiment: %w", err)
+		}
+	}
+	return nil
+}
+
 // GetExperiment gets the current state of an AWS FIS experiment
 func (c *FISClient) GetExperiment(ctx context.Context, experimentID string) (*types.Experiment, error) {
 	input := &fis.GetExperimentInput{
@@ -289,6 +511,70 @@ func (c *FISClient) GetExperiment(ctx context.Context, experimentID string) (*ty
 	return output.Experiment, nil
 }
 
+// CountResolvedTargets returns the number of resources FIS resolved for each target of an experiment
+func (c *FISClient) CountResolvedTargets(ctx context.Context, experimentID string) (map[string]int32, error) {
+	counts := make(map[string]int32)
+	var nextToken *string
+
+	for {
+		output, err := c.client.ListExperimentResolvedTargets(ctx, &fis.ListExperimentResolvedTargetsInput{
+			ExperimentId: aws.String(experimentID),
+			NextToken:    nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list resolved targets: %w", err)
+		}
+
+		for _, target := range output.ResolvedTargets {
+			counts[aws.ToString(target.TargetName)]++
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	return counts, nil
+}
+
+// resolvedTargetArnKey is the target information key holding the ARN of a resolved resource
+const resolvedTargetArnKey = "arn"
+
+// ListResolvedTargetArns returns the ARNs of up to limit resources FIS resolved for an experiment
+// truncated is true when FIS resolved more resources than were returned
+func (c *FISClient) ListResolvedTargetArns(ctx context.Context, experimentID string, limit int) (arns []string, truncated bool, err error) {
+	var nextToken *string
+
+	for {
+		output, err := c.client.ListExperimentResolvedTargets(ctx, &fis.ListExperimentResolvedTargetsInput{
+			ExperimentId: aws.String(experimentID),
+			NextToken:    nextToken,
+		})
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to list resolved targets: %w", err)
+		}
+
+		for _, target := range output.ResolvedTargets {
+			arn := target.TargetInformation[resolvedTargetArnKey]
+			if arn == "" {
+				continue
+			}
+			if len(arns) >= limit {
+				return arns, true, nil
+			}
+			arns = append(arns, arn)
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		nextToken = output.NextToken
+	}
+
+	return arns, false, nil
+}
+
 // StopExperiment stops a running AWS FIS experiment
 func (c *FISClient) StopExperiment(ctx context.Context, experimentID string) error {
 	input := &fis.StopExperimentInput{
@@ -305,14 +591,21 @@ func (c *FISClient) StopExperiment(ctx context.Context, experimentID string) err
 
 // ExperimentSummary contains summary information about an experiment
 type ExperimentSummary struct {
-	ID         string
-	TemplateID string
-	State      string
-	StartTime  *time.Time
-	EndTime    *time.Time
+	ID          string
+	TemplateID  string
+	State       string
+	StartTime   *time.Time
+	EndTime     *time.Time
+	ClientToken string
+
+	// ExperimentName and ExperimentNamespace identify the Experiment the run was started for, empty when untagged
+	ExperimentName      string
+	ExperimentNamespace string
 }
 
 // ListExperimentsByTemplate lists all experiments for a given template ID
+// Experiments of other templates are dropped client-side, so history cleanup never touches them
+// even if the request filter is ignored
 func (c *FISClient) ListExperimentsByTemplate(ctx context.Context, templateID string) ([]ExperimentSummary, error) {
 	var experiments []ExperimentSummary
 	var nextToken *string
@@ -329,11 +622,17 @@ func (c *FISClient) ListExperimentsByTemplate(ctx context.Context, templateID st
 		}
 
 		for _, exp := range output.Experiments {
+			if aws.ToString(exp.ExperimentTemplateId) != templateID {
+				continue
+			}
 			summary := ExperimentSummary{
-				ID:         aws.ToString(exp.Id),
-				TemplateID: aws.ToString(exp.ExperimentTemplateId),
-				State:      string(exp.State.Status),
-				StartTime:  exp.CreationTime,
+				ID:                  aws.ToString(exp.Id),
+				TemplateID:          aws.ToString(exp.ExperimentTemplateId),
+				State:               string(exp.State.Status),
+				StartTime:           exp.CreationTime,
+				ClientToken:         exp.Tags[ClientTokenTag],
+				ExperimentName:      exp.Tags[ExperimentNameTag],
+				ExperimentNamespace: exp.Tags[ExperimentNamespaceTag],
 			}
 			experiments = append(experiments, summary)
 		}
@@ -359,3 +658,15 @@ func (c *FISClient) DeleteExperiment(ctx context.Context, experimentID string) e
 func (c *FISClient) GetAWSConfig() aws.Config {
 	return c.awsConfig
 }
+
+// ExperimentConsoleURL returns the AWS FIS console link to an experiment in the given region
+func ExperimentConsoleURL(region, experimentID string) string {
+	domain := "console.aws.amazon.com"
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		domain = "console.amazonaws.cn"
+	case strings.HasPrefix(region, "us-gov-"):
+		domain = "console.amazonaws-us-gov.com"
+	}
+	return fmt.Sprintf("https://%s.%s/fis/home?region=%s#ExperimentDetails:ExperimentId=%s", region, domain, region, experimentID)
+}
@@ -17,22 +17,48 @@ limitations under the License.
 package aws
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/fis/types"
 
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
 	fisv1alpha1 "fis.dksshddl.dev/fis-controller/api/v1alpha1"
 )
 
+var converterLog = logf.Log.WithName("fis-converter")
+
+var (
+	// errEmptyTargets is returned when a template has no targets, which AWS FIS rejects
+	errEmptyTargets = errors.New("experiment template needs at least one target")
+	// errEmptyActions is returned when a template has no actions, which AWS FIS rejects
+	errEmptyActions = errors.New("experiment template needs at least one action")
+)
+
+// parameterPlaceholder matches a ${name} placeholder in an action parameter value
+var parameterPlaceholder = regexp.MustCompile(`\$\{([A-Za-z0-9_.-]+)\}`)
+
+const (
+	// podPhaseFilterPath is the FIS filter path of the phase of a target pod
+	podPhaseFilterPath = "Status.Phase"
+	// defaultPodPhase is the pod phase targeted when a pod target sets no phase
+	defaultPodPhase = "Running"
+)
+
 // ============================================================================
 // Internal data structures for common conversion logic
 // ============================================================================
 
 type targetData struct {
+	resourceType  string
 	selectionMode string
 	params        map[string]string
+	resourceTags  map[string]string
+	resourceArns  []string
 	filters       []types.ExperimentTemplateTargetInputFilter
 }
 
@@ -48,53 +74,110 @@ type actionData struct {
 // Common conversion logic (shared between Create and Update)
 // ============================================================================
 
-func (c *FISClient) buildTargetData(target fisv1alpha1.TargetSpec, clusterIdentifier string) targetData {
-	params := map[string]string{
+func (c *FISClient) buildTargetData(target fisv1alpha1.TargetSpec, clusterIdentifier string) (targetData, error) {
+	if len(target.ResourceArns) > 0 && len(target.LabelSelector) > 0 {
+		return targetData{}, fmt.Errorf("target %q: resourceArns and labelSelector are mutually exclusive", target.Name)
+	}
+
+	selectionMode, err := buildSelectionMode(target)
+	if err != nil {
+		return targetData{}, fmt.Errorf("target %q: %w", target.Name, err)
+	}
+
+	filters, err := convertFilters(target.Filters)
+	if err != nil {
+		return targetData{}, fmt.Errorf("target %q: %w", target.Name, err)
+	}
+	if targetResourceType(target) == fisv1alpha1.ResourceTypeEKSPod {
+		filters = withPodPhaseFilter(filters, target.PodPhases)
+	}
+
+	data := targetData{
+		resourceType:  targetResourceType(target),
+		selectionMode: selectionMode,
+		filters:       filters,
+	}
+
+	// Targets listed by ARN need no selector at all; FIS resolves pods only through the cluster selector
+	if len(target.ResourceArns) > 0 {
+		if data.resourceType == fisv1alpha1.ResourceTypeEKSPod {
+			return targetData{}, fmt.Errorf("target %q: resourceArns is not supported for %s targets", target.Name, fisv1alpha1.ResourceTypeEKSPod)
+		}
+		data.resourceArns = target.ResourceArns
+		return data, nil
+	}
+
+	// Non-pod targets are selected by AWS resource tags instead of the in-cluster pod selector
+	if data.resourceType != fisv1alpha1.ResourceTypeEKSPod {
+		if len(target.LabelSelector) > 0 {
+			data.resourceTags = target.LabelSelector
+		}
+		return data, nil
+	}
+
+	data.params = map[string]string{
 		"clusterIdentifier": clusterIdentifier,
 		"namespace":         defaultString(target.Namespace, "default"),
 		"selectorType":      "labelSelector",
 		"selectorValue":     buildLabelSelector(target.LabelSelector),
 	}
 
-	if target.Container != "" {
-		params["targetContainerName"] = target.Container
+	if container := defaultString(target.TargetContainerName, target.Container); container != "" {
+		data.params["targetContainerName"] = container
 	}
 
-	var filters []types.ExperimentTemplateTargetInputFilter
-	for _, f := range target.Filters {
-		filters = append(filters, types.ExperimentTemplateTargetInputFilter{
-			Path:   aws.String(f.Path),
-			Values: f.Values,
-		})
+	return data, nil
+}
+
+func (c *FISClient) buildActionData(action fisv1alpha1.ActionSpec, serviceAccount string, templateParams map[string]string) (actionData, error) {
+	duration, err := c.convertDuration(action.Duration)
+	if err != nil {
+		return actionData{}, fmt.Errorf("action %q: %w", action.Name, err)
 	}
 
-	return targetData{
-		selectionMode: parseScope(target.Scope),
-		params:        params,
-		filters:       filters,
+	if missing := MissingActionParameters(action.Type, action.Parameters); len(missing) > 0 {
+		return actionData{}, fmt.Errorf("action %q: %s requires parameters: %s", action.Name, action.Type, strings.Join(missing, ", "))
 	}
-}
 
-func (c *FISClient) buildActionData(action fisv1alpha1.ActionSpec, serviceAccount string) actionData {
-	params := map[string]string{
-		"duration": c.convertDuration(action.Duration),
+	params := map[string]string{}
+	if key := actionDurationParameter(action.Type); key != "" {
+		params[key] = duration
+	}
+	for k, v := range actionParameterDefaults[action.Type] {
+		params[k] = v
 	}
 
-	if serviceAccount != "" {
+	// Only pod actions run in the cluster under the template's ServiceAccount
+	resourceType := ActionResourceType(action.Type)
+	if serviceAccount != "" && resourceType == fisv1alpha1.ResourceTypeEKSPod {
 		params["kubernetesServiceAccount"] = serviceAccount
 	}
 
 	for k, v := range action.Parameters {
-		params[k] = v
+		params[k] = interpolateParameter(action.Name, k, v, templateParams)
 	}
 
 	return actionData{
 		actionID:    c.convertActionType(action.Type),
 		description: action.Description,
 		params:      params,
-		targets:     map[string]string{"Pods": action.Target},
+		targets:     map[string]string{actionTargetKeys[resourceType]: action.Target},
 		startAfter:  action.StartAfter,
-	}
+	}, nil
+}
+
+// interpolateParameter replaces ${name} placeholders in an action parameter value with the template parameters
+// Unknown placeholders are kept as written so FIS reports them instead of receiving an empty value
+func interpolateParameter(actionName, key, value string, templateParams map[string]string) string {
+	return parameterPlaceholder.ReplaceAllStringFunc(value, func(placeholder string) string {
+		name := parameterPlaceholder.FindStringSubmatch(placeholder)[1]
+		if v, ok := templateParams[name]; ok {
+			return v
+		}
+		converterLog.Info("Unresolved placeholder in action parameter, passing it through unchanged",
+			"action", actionName, "parameter", key, "placeholder", placeholder)
+		return placeholder
+	})
 }
 
 // ============================================================================
@@ -102,23 +185,37 @@ func (c *FISClient) buildActionData(action fisv1alpha1.ActionSpec, serviceAccoun
 // ============================================================================
 
 func (c *FISClient) convertTargets(crdTargets []fisv1alpha1.TargetSpec, clusterIdentifier string) (map[string]types.CreateExperimentTemplateTargetInput, error) {
+	if len(crdTargets) == 0 {
+		return nil, errEmptyTargets
+	}
 	targets := make(map[string]types.CreateExperimentTemplateTargetInput)
 	for _, t := range crdTargets {
-		data := c.buildTargetData(t, clusterIdentifier)
+		data, err := c.buildTargetData(t, clusterIdentifier)
+		if err != nil {
+			return nil, err
+		}
 		targets[t.Name] = types.CreateExperimentTemplateTargetInput{
-			ResourceType:  aws.String("aws:eks:pod"),
+			ResourceType:  aws.String(data.resourceType),
 			SelectionMode: aws.String(data.selectionMode),
 			Parameters:    data.params,
+			ResourceTags:  data.resourceTags,
+			ResourceArns:  data.resourceArns,
 			Filters:       data.filters,
 		}
 	}
 	return targets, nil
 }
 
-func (c *FISClient) convertActions(crdActions []fisv1alpha1.ActionSpec, serviceAccount string) (map[string]types.CreateExperimentTemplateActionInput, error) {
+func (c *FISClient) convertActions(crdActions []fisv1alpha1.ActionSpec, serviceAccount string, templateParams map[string]string) (map[string]types.CreateExperimentTemplateActionInput, error) {
+	if len(crdActions) == 0 {
+		return nil, errEmptyActions
+	}
 	actions := make(map[string]types.CreateExperimentTemplateActionInput)
 	for _, a := range crdActions {
-		data := c.buildActionData(a, serviceAccount)
+		data, err := c.buildActionData(a, serviceAccount, templateParams)
+		if err != nil {
+			return nil, err
+		}
 		actions[a.Name] = types.CreateExperimentTemplateActionInput{
 			ActionId:    aws.String(data.actionID),
 			Description: aws.String(data.description),
@@ -169,15 +266,45 @@ func (c *FISClient) convertLogConfiguration(cfg *fisv1alpha1.LogConfiguration) *
 	return input
 }
 
-func (c *FISClient) convertExperimentReportConfiguration(cfg *fisv1alpha1.ExperimentReportConfiguration) *types.CreateExperimentTemplateReportConfigurationInput {
+func (c *FISClient) convertExperimentReportConfiguration(cfg *fisv1alpha1.ExperimentReportConfiguration) (*types.CreateExperimentTemplateReportConfigurationInput, error) {
 	input := &types.CreateExperimentTemplateReportConfigurationInput{}
 	if cfg.PreExperimentDuration != "" {
-		input.PreExperimentDuration = aws.String(c.convertDuration(cfg.PreExperimentDuration))
+		duration, err := c.convertDuration(cfg.PreExperimentDuration)
+		if err != nil {
+			return nil, fmt.Errorf("preExperimentDuration: %w", err)
+		}
+		input.PreExperimentDuration = aws.String(duration)
 	}
 	if cfg.PostExperimentDuration != "" {
-		input.PostExperimentDuration = aws.String(c.convertDuration(cfg.PostExperimentDuration))
+		duration, err := c.convertDuration(cfg.PostExperimentDuration)
+		if err != nil {
+			return nil, fmt.Errorf("postExperimentDuration: %w", err)
+		}
+		input.PostExperimentDuration = aws.String(duration)
 	}
-	return input
+	if cfg.DataSources != nil && len(cfg.DataSources.CloudWatchDashboards) > 0 {
+		dashboards := make([]types.ReportConfigurationCloudWatchDashboardInput, 0, len(cfg.DataSources.CloudWatchDashboards))
+		for _, dashboard := range cfg.DataSources.CloudWatchDashboards {
+			dashboards = append(dashboards, types.ReportConfigurationCloudWatchDashboardInput{
+				DashboardIdentifier: aws.String(dashboard.DashboardIdentifier),
+			})
+		}
+		input.DataSources = &types.ExperimentTemplateReportConfigurationDataSourcesInput{
+			CloudWatchDashboards: dashboards,
+		}
+	}
+	if cfg.Outputs != nil && cfg.Outputs.S3Configuration != nil {
+		s3Output := &types.ReportConfigurationS3OutputInput{
+			BucketName: aws.String(cfg.Outputs.S3Configuration.BucketName),
+		}
+		if cfg.Outputs.S3Configuration.Prefix != "" {
+			s3Output.Prefix = aws.String(cfg.Outputs.S3Configuration.Prefix)
+		}
+		input.Outputs = &types.ExperimentTemplateReportConfigurationOutputsInput{
+			S3Configuration: s3Output,
+		}
+	}
+	return input, nil
 }
 
 func (c *FISClient) convertTags(crdTags []fisv1alpha1.Tag) map[string]string {
@@ -193,23 +320,37 @@ func (c *FISClient) convertTags(crdTags []fisv1alpha1.Tag) map[string]string {
 // ============================================================================
 
 func (c *FISClient) convertTargetsForUpdate(crdTargets []fisv1alpha1.TargetSpec, clusterIdentifier string) (map[string]types.UpdateExperimentTemplateTargetInput, error) {
+	if len(crdTargets) == 0 {
+		return nil, errEmptyTargets
+	}
 	targets := make(map[string]types.UpdateExperimentTemplateTargetInput)
 	for _, t := range crdTargets {
-		data := c.buildTargetData(t, clusterIdentifier)
+		data, err := c.buildTargetData(t, clusterIdentifier)
+		if err != nil {
+			return nil, err
+		}
 		targets[t.Name] = types.UpdateExperimentTemplateTargetInput{
-			ResourceType:  aws.String("aws:eks:pod"),
+			ResourceType:  aws.String(data.resourceType),
 			SelectionMode: aws.String(data.selectionMode),
 			Parameters:    data.params,
+			ResourceTags:  data.resourceTags,
+			ResourceArns:  data.resourceArns,
 			Filters:       data.filters,
 		}
 	}
 	return targets, nil
 }
 
-func (c *FISClient) convertActionsForUpdate(crdActions []fisv1alpha1.ActionSpec, serviceAccount string) (map[string]types.UpdateExperimentTemplateActionInputItem, error) {
+func (c *FISClient) convertActionsForUpdate(crdActions []fisv1alpha1.ActionSpec, serviceAccount string, templateParams map[string]string) (map[string]types.UpdateExperimentTemplateActionInputItem, error) {
+	if len(crdActions) == 0 {
+		return nil, errEmptyActions
+	}
 	actions := make(map[string]types.UpdateExperimentTemplateActionInputItem)
 	for _, a := range crdActions {
-		data := c.buildActionData(a, serviceAccount)
+		data, err := c.buildActionData(a, serviceAccount, templateParams)
+		if err != nil {
+			return nil, err
+		}
 		actions[a.Name] = types.UpdateExperimentTemplateActionInputItem{
 			ActionId:    aws.String(data.actionID),
 			Description: aws.String(data.description),
@@ -235,6 +376,8 @@ func (c *FISClient) convertStopConditionsForUpdate(crdConditions []fisv1alpha1.S
 	return conditions
 }
 
+// convertExperimentOptionsForUpdate converts the options an update can change
+// AccountTargeting is fixed when the template is created, the update API does not accept it
 func (c *FISClient) convertExperimentOptionsForUpdate(opts *fisv1alpha1.ExperimentOptions) *types.UpdateExperimentTemplateExperimentOptionsInput {
 	return &types.UpdateExperimentTemplateExperimentOptionsInput{
 		EmptyTargetResolutionMode: types.EmptyTargetResolutionMode(opts.EmptyTargetResolutionMode),
@@ -259,10 +402,53 @@ func (c *FISClient) convertLogConfigurationForUpdate(cfg *fisv1alpha1.LogConfigu
 	return input
 }
 
+func (c *FISClient) convertExperimentReportConfigurationForUpdate(cfg *fisv1alpha1.ExperimentReportConfiguration) (*types.UpdateExperimentTemplateReportConfigurationInput, error) {
+	input, err := c.convertExperimentReportConfiguration(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &types.UpdateExperimentTemplateReportConfigurationInput{
+		DataSources:            input.DataSources,
+		Outputs:                input.Outputs,
+		PreExperimentDuration:  input.PreExperimentDuration,
+		PostExperimentDuration: input.PostExperimentDuration,
+	}, nil
+}
+
 // ============================================================================
 // Helper functions
 // ============================================================================
 
+// buildSelectionMode computes the AWS FIS selectionMode for a target
+// SelectionMode with Count/Percent wins; the deprecated Scope is used otherwise
+// Examples: ALL -> "ALL", COUNT+3 -> "COUNT(3)", PERCENT+50 -> "PERCENT(50)"
+func buildSelectionMode(target fisv1alpha1.TargetSpec) (string, error) {
+	switch strings.ToUpper(target.SelectionMode) {
+	case "":
+		return parseScope(target.Scope), nil
+	case "ALL":
+		return "ALL", nil
+	case "COUNT":
+		if target.Count == nil {
+			return "", fmt.Errorf("count is required when selectionMode is COUNT")
+		}
+		if *target.Count < 1 {
+			return "", fmt.Errorf("count must be at least 1, got %d", *target.Count)
+		}
+		return fmt.Sprintf("COUNT(%d)", *target.Count), nil
+	case "PERCENT":
+		if target.Percent == nil {
+			return "", fmt.Errorf("percent is required when selectionMode is PERCENT")
+		}
+		if *target.Percent < 1 || *target.Percent > 100 {
+			return "", fmt.Errorf("percent must be between 1 and 100, got %d", *target.Percent)
+		}
+		return fmt.Sprintf("PERCENT(%d)", *target.Percent), nil
+	default:
+		return "", fmt.Errorf("unsupported selectionMode %q", target.SelectionMode)
+	}
+}
+
 // parseScope converts user-friendly scope format to AWS FIS selectionMode
 // Examples: "ALL" -> "ALL", "3" -> "COUNT(3)", "50%" -> "PERCENT(50)"
 func parseScope(scope string) string {
@@ -276,6 +462,57 @@ func parseScope(scope string) string {
 	return fmt.Sprintf("COUNT(%s)", scope)
 }
 
+// convertFilters validates target filters and converts them to FIS filter inputs
+// Every filter needs a non-empty path and at least one non-empty value
+func convertFilters(crdFilters []fisv1alpha1.TargetFilter) ([]types.ExperimentTemplateTargetInputFilter, error) {
+	var filters []types.ExperimentTemplateTargetInputFilter
+	for i, f := range crdFilters {
+		path := strings.TrimSpace(f.Path)
+		if path == "" {
+			return nil, fmt.Errorf("filter[%d]: path must not be empty", i)
+		}
+		if len(f.Values) == 0 {
+			return nil, fmt.Errorf("filter[%d] (%s): at least one value is required", i, path)
+		}
+		for j, v := range f.Values {
+			if strings.TrimSpace(v) == "" {
+				return nil, fmt.Errorf("filter[%d] (%s): value[%d] must not be empty", i, path, j)
+			}
+		}
+		filters = append(filters, types.ExperimentTemplateTargetInputFilter{
+			Path:   aws.String(path),
+			Values: f.Values,
+		})
+	}
+	return filters, nil
+}
+
+// withPodPhaseFilter adds the pod phase filter of a pod target
+// Without PodPhases only Running pods are targeted, unless a phase filter is already given explicitly
+func withPodPhaseFilter(filters []types.ExperimentTemplateTargetInputFilter, podPhases []string) []types.ExperimentTemplateTargetInputFilter {
+	if len(podPhases) == 0 {
+		for _, f := range filters {
+			if aws.ToString(f.Path) == podPhaseFilterPath {
+				return filters
+			}
+		}
+		podPhases = []string{defaultPodPhase}
+	}
+	return append(filters, types.ExperimentTemplateTargetInputFilter{
+		Path:   aws.String(podPhaseFilterPath),
+		Values: podPhases,
+	})
+}
+
+// CountFilters returns the total number of filters across all targets
+func CountFilters(targets []fisv1alpha1.TargetSpec) int32 {
+	var count int32
+	for _, t := range targets {
+		count += int32(len(t.Filters))
+	}
+	return count
+}
+
 func buildLabelSelector(labels map[string]string) string {
 	var pairs []string
 	for k, v := range labels {
@@ -284,6 +521,11 @@ func buildLabelSelector(labels map[string]string) string {
 	return strings.Join(pairs, ",")
 }
 
+// targetResourceType returns the FIS resource type of the target, aws:eks:pod when unset
+func targetResourceType(target fisv1alpha1.TargetSpec) string {
+	return defaultString(target.ResourceType, fisv1alpha1.ResourceTypeEKSPod)
+}
+
 func defaultString(val, def string) string {
 	if val == "" {
 		return def
@@ -0,0 +1,820 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	fisv1alpha1 "fis.dksshddl.dev/fis-controller/api/v1alpha1"
+	"fis.dksshddl.dev/fis-controller/internal/aws/fake"
+)
+
+const testClusterIdentifier = "arn:aws:eks:ap-northeast-2:123456789012:cluster/test-cluster"
+
+func TestConvertTargetsMultipleFilters(t *testing.T) {
+	client := &FISClient{}
+	targets := []fisv1alpha1.TargetSpec{
+		{
+			Name:          "web-pods",
+			Namespace:     "default",
+			LabelSelector: map[string]string{"app": "web"},
+			Filters: []fisv1alpha1.TargetFilter{
+				{Path: "State.Name", Values: []string{"running"}},
+				{Path: "Placement.AvailabilityZone", Values: []string{"ap-northeast-2a", "ap-northeast-2c"}},
+			},
+		},
+	}
+
+	created, err := client.convertTargets(targets, testClusterIdentifier)
+	if err != nil {
+		t.Fatalf("convertTargets failed: %v", err)
+	}
+	updated, err := client.convertTargetsForUpdate(targets, testClusterIdentifier)
+	if err != nil {
+		t.Fatalf("convertTargetsForUpdate failed: %v", err)
+	}
+
+	createFilters := created["web-pods"].Filters
+	updateFilters := updated["web-pods"].Filters
+	// The default pod phase filter follows the spec filters
+	if len(createFilters) != 3 || len(updateFilters) != 3 {
+		t.Fatalf("Expected 3 filters for create and update, got: %d and %d", len(createFilters), len(updateFilters))
+	}
+
+	if aws.ToString(createFilters[0].Path) != "State.Name" || createFilters[0].Values[0] != "running" {
+		t.Errorf("Unexpected first filter: %s=%v", aws.ToString(createFilters[0].Path), createFilters[0].Values)
+	}
+	if aws.ToString(createFilters[1].Path) != "Placement.AvailabilityZone" || len(createFilters[1].Values) != 2 {
+		t.Errorf("Unexpected second filter: %s=%v", aws.ToString(createFilters[1].Path), createFilters[1].Values)
+	}
+	if aws.ToString(updateFilters[1].Path) != "Placement.AvailabilityZone" {
+		t.Errorf("Expected update filters to match create filters, got path: %s", aws.ToString(updateFilters[1].Path))
+	}
+}
+
+func TestConvertTargetsWithoutFilters(t *testing.T) {
+	client := &FISClient{}
+	targets := []fisv1alpha1.TargetSpec{
+		{Name: "web-pods", Namespace: "default", LabelSelector: map[string]string{"app": "web"}},
+	}
+
+	created, err := client.convertTargets(targets, testClusterIdentifier)
+	if err != nil {
+		t.Fatalf("convertTargets failed: %v", err)
+	}
+	filters := created["web-pods"].Filters
+	if len(filters) != 1 || aws.ToString(filters[0].Path) != "Status.Phase" || !slices.Equal(filters[0].Values, []string{"Running"}) {
+		t.Errorf("Expected only the default Running phase filter, got: %v", filters)
+	}
+}
+
+func TestConvertTargetsPodPhases(t *testing.T) {
+	client := &FISClient{}
+	tests := []struct {
+		name   string
+		target fisv1alpha1.TargetSpec
+		want   []string
+	}{
+		{
+			name:   "explicit phases",
+			target: fisv1alpha1.TargetSpec{PodPhases: []string{"Running", "Pending"}},
+			want:   []string{"Running", "Pending"},
+		},
+		{
+			name: "explicit phase filter is kept",
+			target: fisv1alpha1.TargetSpec{Filters: []fisv1alpha1.TargetFilter{
+				{Path: "Status.Phase", Values: []string{"Succeeded"}},
+			}},
+			want: []string{"Succeeded"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.target.Name = "web-pods"
+			tt.target.Namespace = "default"
+			tt.target.LabelSelector = map[string]string{"app": "web"}
+			updated, err := client.convertTargetsForUpdate([]fisv1alpha1.TargetSpec{tt.target}, testClusterIdentifier)
+			if err != nil {
+				t.Fatalf("convertTargetsForUpdate failed: %v", err)
+			}
+			filters := updated["web-pods"].Filters
+			if len(filters) != 1 || aws.ToString(filters[0].Path) != "Status.Phase" || !slices.Equal(filters[0].Values, tt.want) {
+				t.Errorf("Expected a single phase filter with %v, got: %v", tt.want, filters)
+			}
+		})
+	}
+}
+
+func TestConvertTargetsNonPodTargetHasNoPhaseFilter(t *testing.T) {
+	client := &FISClient{}
+	targets := []fisv1alpha1.TargetSpec{
+		{Name: "nodes", ResourceType: fisv1alpha1.ResourceTypeEC2Instance, LabelSelector: map[string]string{"role": "worker"}},
+	}
+
+	created, err := client.convertTargets(targets, testClusterIdentifier)
+	if err != nil {
+		t.Fatalf("convertTargets failed: %v", err)
+	}
+	if created["nodes"].Filters != nil {
+		t.Errorf("Expected no filters, got: %v", created["nodes"].Filters)
+	}
+}
+
+func TestConvertTargetsRejectsInvalidFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  fisv1alpha1.TargetFilter
+		wantErr string
+	}{
+		{
+			name:    "empty path",
+			filter:  fisv1alpha1.TargetFilter{Path: " ", Values: []string{"running"}},
+			wantErr: "path must not be empty",
+		},
+		{
+			name:    "no values",
+			filter:  fisv1alpha1.TargetFilter{Path: "State.Name"},
+			wantErr: "at least one value is required",
+		},
+		{
+			name:    "empty value",
+			filter:  fisv1alpha1.TargetFilter{Path: "State.Name", Values: []string{"running", ""}},
+			wantErr: "value[1] must not be empty",
+		},
+	}
+
+	client := &FISClient{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targets := []fisv1alpha1.TargetSpec{
+				{
+					Name:          "web-pods",
+					Namespace:     "default",
+					LabelSelector: map[string]string{"app": "web"},
+					Filters: []fisv1alpha1.TargetFilter{
+						{Path: "State.Name", Values: []string{"running"}},
+						tt.filter,
+					},
+				},
+			}
+
+			if _, err := client.convertTargets(targets, testClusterIdentifier); err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("convertTargets error = %v, want containing %q", err, tt.wantErr)
+			}
+			if _, err := client.convertTargetsForUpdate(targets, testClusterIdentifier); err == nil || !strings.Contains(err.Error(), "web-pods") {
+				t.Errorf("convertTargetsForUpdate error = %v, want one naming the target", err)
+			}
+		})
+	}
+}
+
+func TestCountFilters(t *testing.T) {
+	targets := []fisv1alpha1.TargetSpec{
+		{Name: "a", Filters: []fisv1alpha1.TargetFilter{{Path: "State.Name", Values: []string{"running"}}}},
+		{Name: "b"},
+		{Name: "c", Filters: []fisv1alpha1.TargetFilter{
+			{Path: "State.Name", Values: []string{"running"}},
+			{Path: "Placement.AvailabilityZone", Values: []string{"ap-northeast-2a"}},
+		}},
+	}
+
+	if got := CountFilters(targets); got != 3 {
+		t.Errorf("CountFilters() = %d, want 3", got)
+	}
+}
+
+func TestBuildSelectionMode(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  fisv1alpha1.TargetSpec
+		want    string
+		wantErr bool
+	}{
+		{name: "default", target: fisv1alpha1.TargetSpec{}, want: "ALL"},
+		{name: "all", target: fisv1alpha1.TargetSpec{SelectionMode: "ALL"}, want: "ALL"},
+		{name: "count", target: fisv1alpha1.TargetSpec{SelectionMode: "COUNT", Count: aws.Int32(3)}, want: "COUNT(3)"},
+		{name: "percent", target: fisv1alpha1.TargetSpec{SelectionMode: "PERCENT", Percent: aws.Int32(50)}, want: "PERCENT(50)"},
+		{name: "count without count", target: fisv1alpha1.TargetSpec{SelectionMode: "COUNT"}, wantErr: true},
+		{name: "percent out of range", target: fisv1alpha1.TargetSpec{SelectionMode: "PERCENT", Percent: aws.Int32(150)}, wantErr: true},
+		{name: "unsupported mode", target: fisv1alpha1.TargetSpec{SelectionMode: "SOME"}, wantErr: true},
+		{name: "scope all", target: fisv1alpha1.TargetSpec{Scope: "ALL"}, want: "ALL"},
+		{name: "scope count", target: fisv1alpha1.TargetSpec{Scope: "2"}, want: "COUNT(2)"},
+		{name: "scope percent", target: fisv1alpha1.TargetSpec{Scope: "30%"}, want: "PERCENT(30)"},
+		{name: "selection mode wins over scope", target: fisv1alpha1.TargetSpec{Scope: "ALL", SelectionMode: "COUNT", Count: aws.Int32(1)}, want: "COUNT(1)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildSelectionMode(tt.target)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("buildSelectionMode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("buildSelectionMode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertTargetsContainerName(t *testing.T) {
+	client := &FISClient{}
+	targets := []fisv1alpha1.TargetSpec{
+		{Name: "new", Namespace: "default", LabelSelector: map[string]string{"app": "web"}, TargetContainerName: "nginx", Container: "ignored"},
+		{Name: "legacy", Namespace: "default", LabelSelector: map[string]string{"app": "web"}, Container: "sidecar"},
+	}
+
+	created, err := client.convertTargets(targets, testClusterIdentifier)
+	if err != nil {
+		t.Fatalf("convertTargets failed: %v", err)
+	}
+	if got := created["new"].Parameters["targetContainerName"]; got != "nginx" {
+		t.Errorf("Expected targetContainerName 'nginx', got: %s", got)
+	}
+	if got := created["legacy"].Parameters["targetContainerName"]; got != "sidecar" {
+		t.Errorf("Expected legacy container 'sidecar', got: %s", got)
+	}
+}
+
+func TestConvertTargetsResourceType(t *testing.T) {
+	client := &FISClient{}
+	targets := []fisv1alpha1.TargetSpec{
+		{Name: "pods", Namespace: "default", LabelSelector: map[string]string{"app": "web"}},
+		{
+			Name:          "instances",
+			ResourceType:  fisv1alpha1.ResourceTypeEC2Instance,
+			LabelSelector: map[string]string{"env": "staging"},
+			SelectionMode: "COUNT",
+			Count:         aws.Int32(1),
+			Filters:       []fisv1alpha1.TargetFilter{{Path: "State.Name", Values: []string{"running"}}},
+		},
+	}
+
+	created, err := client.convertTargets(targets, testClusterIdentifier)
+	if err != nil {
+		t.Fatalf("convertTargets failed: %v", err)
+	}
+
+	pods := created["pods"]
+	if got := aws.ToString(pods.ResourceType); got != "aws:eks:pod" {
+		t.Errorf("Expected default resource type aws:eks:pod, got: %s", got)
+	}
+	if pods.Parameters["namespace"] != "default" || pods.ResourceTags != nil {
+		t.Errorf("Expected pod selector parameters and no resource tags, got: %v, %v", pods.Parameters, pods.ResourceTags)
+	}
+
+	instances := created["instances"]
+	if got := aws.ToString(instances.ResourceType); got != "aws:ec2:instance" {
+		t.Errorf("Expected resource type aws:ec2:instance, got: %s", got)
+	}
+	if instances.Parameters != nil {
+		t.Errorf("Expected no pod parameters for an EC2 target, got: %v", instances.Parameters)
+	}
+	if got := instances.ResourceTags["env"]; got != "staging" {
+		t.Errorf("Expected resource tag env=staging, got: %v", instances.ResourceTags)
+	}
+	if got := aws.ToString(instances.SelectionMode); got != "COUNT(1)" {
+		t.Errorf("Expected selection mode COUNT(1), got: %s", got)
+	}
+	if len(instances.Filters) != 1 || aws.ToString(instances.Filters[0].Path) != "State.Name" {
+		t.Errorf("Expected the State.Name filter, got: %v", instances.Filters)
+	}
+
+	updated, err := client.convertTargetsForUpdate(targets, testClusterIdentifier)
+	if err != nil {
+		t.Fatalf("convertTargetsForUpdate failed: %v", err)
+	}
+	if got := aws.ToString(updated["instances"].ResourceType); got != "aws:ec2:instance" {
+		t.Errorf("Expected update resource type aws:ec2:instance, got: %s", got)
+	}
+	if got := updated["instances"].ResourceTags["env"]; got != "staging" {
+		t.Errorf("Expected update resource tag env=staging, got: %v", updated["instances"].ResourceTags)
+	}
+}
+
+func TestConvertTargetsResourceArns(t *testing.T) {
+	client := &FISClient{}
+	instanceArn := "arn:aws:ec2:ap-northeast-2:123456789012:instance/i-0123456789abcdef0"
+
+	created, err := client.convertTargets([]fisv1alpha1.TargetSpec{
+		{Name: "instances", ResourceType: fisv1alpha1.ResourceTypeEC2Instance, ResourceArns: []string{instanceArn}},
+	}, testClusterIdentifier)
+	if err != nil {
+		t.Fatalf("convertTargets failed: %v", err)
+	}
+	target := created["instances"]
+	if len(target.ResourceArns) != 1 || target.ResourceArns[0] != instanceArn {
+		t.Errorf("Expected resource ARNs [%s], got: %v", instanceArn, target.ResourceArns)
+	}
+	if target.Parameters != nil || target.ResourceTags != nil {
+		t.Errorf("Expected no selector parameters or tags, got: %v, %v", target.Parameters, target.ResourceTags)
+	}
+
+	_, err = client.convertTargets([]fisv1alpha1.TargetSpec{
+		{
+			Name:          "instances",
+			ResourceType:  fisv1alpha1.ResourceTypeEC2Instance,
+			ResourceArns:  []string{instanceArn},
+			LabelSelector: map[string]string{"env": "staging"},
+		},
+	}, testClusterIdentifier)
+	if err == nil || !strings.Contains(err.Error(), "mutually exclusive") {
+		t.Errorf("Expected a mutually exclusive error, got: %v", err)
+	}
+
+	_, err = client.convertTargets([]fisv1alpha1.TargetSpec{
+		{Name: "pods", Namespace: "default", ResourceArns: []string{instanceArn}},
+	}, testClusterIdentifier)
+	if err == nil || !strings.Contains(err.Error(), "resourceArns is not supported for aws:eks:pod targets") {
+		t.Errorf("Expected an unsupported resourceArns error for a pod target, got: %v", err)
+	}
+}
+
+func TestBuildCreateInputActionOnTargetSelectedByArn(t *testing.T) {
+	client := &FISClient{}
+	instanceArn := "arn:aws:ec2:ap-northeast-2:123456789012:instance/i-0123456789abcdef0"
+	template := &fisv1alpha1.ExperimentTemplate{
+		Spec: fisv1alpha1.ExperimentTemplateSpec{
+			Targets: []fisv1alpha1.TargetSpec{
+				{Name: "canary", ResourceType: fisv1alpha1.ResourceTypeEC2Instance, ResourceArns: []string{instanceArn}},
+			},
+			Actions: []fisv1alpha1.ActionSpec{
+				{Name: "reboot-canary", Type: "ec2-reboot-instances", Target: "canary", Duration: "1m"},
+			},
+		},
+	}
+
+	input, err := client.buildCreateInput(template, testRoleArn, testClusterIdentifier, "fis-sa")
+	if err != nil {
+		t.Fatalf("buildCreateInput failed: %v", err)
+	}
+
+	target := input.Targets["canary"]
+	if !slices.Equal(target.ResourceArns, []string{instanceArn}) || target.ResourceTags != nil || target.Parameters != nil {
+		t.Errorf("Expected the target to be selected by ARN only, got ARNs %v, tags %v, parameters %v", target.ResourceArns, target.ResourceTags, target.Parameters)
+	}
+	action := input.Actions["reboot-canary"]
+	if aws.ToString(action.ActionId) != "aws:ec2:reboot-instances" || !maps.Equal(action.Targets, map[string]string{"Instances": "canary"}) {
+		t.Errorf("Expected aws:ec2:reboot-instances on {Instances: canary}, got: %s %v", aws.ToString(action.ActionId), action.Targets)
+	}
+}
+
+func TestConvertTargetsSelectionMode(t *testing.T) {
+	tests := []struct {
+		name          string
+		selectionMode string
+		count         *int32
+		percent       *int32
+		want          string
+		wantErr       string
+	}{
+		{name: "all", selectionMode: "ALL", want: "ALL"},
+		{name: "unset", want: "ALL"},
+		{name: "count", selectionMode: "COUNT", count: aws.Int32(3), want: "COUNT(3)"},
+		{name: "count missing", selectionMode: "COUNT", wantErr: "count is required"},
+		{name: "count zero", selectionMode: "COUNT", count: aws.Int32(0), wantErr: "count must be at least 1"},
+		{name: "percent", selectionMode: "PERCENT", percent: aws.Int32(50), want: "PERCENT(50)"},
+		{name: "percent missing", selectionMode: "PERCENT", wantErr: "percent is required"},
+		{name: "percent zero", selectionMode: "PERCENT", percent: aws.Int32(0), wantErr: "percent must be between 1 and 100"},
+		{name: "percent over 100", selectionMode: "PERCENT", percent: aws.Int32(101), wantErr: "percent must be between 1 and 100"},
+	}
+
+	client := &FISClient{}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			targets := []fisv1alpha1.TargetSpec{
+				{
+					Name:          "web-pods",
+					Namespace:     "default",
+					LabelSelector: map[string]string{"app": "web"},
+					SelectionMode: tt.selectionMode,
+					Count:         tt.count,
+					Percent:       tt.percent,
+				},
+			}
+
+			created, createErr := client.convertTargets(targets, testClusterIdentifier)
+			updated, updateErr := client.convertTargetsForUpdate(targets, testClusterIdentifier)
+
+			if tt.wantErr != "" {
+				if createErr == nil || !strings.Contains(createErr.Error(), tt.wantErr) {
+					t.Errorf("convertTargets error = %v, want containing %q", createErr, tt.wantErr)
+				}
+				if updateErr == nil || !strings.Contains(updateErr.Error(), tt.wantErr) {
+					t.Errorf("convertTargetsForUpdate error = %v, want containing %q", updateErr, tt.wantErr)
+				}
+				return
+			}
+
+			if createErr != nil || updateErr != nil {
+				t.Fatalf("Unexpected errors: %v, %v", createErr, updateErr)
+			}
+			if got := aws.ToString(created["web-pods"].SelectionMode); got != tt.want {
+				t.Errorf("Create selectionMode = %q, want %q", got, tt.want)
+			}
+			if got := aws.ToString(updated["web-pods"].SelectionMode); got != tt.want {
+				t.Errorf("Update selectionMode = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildActionDataNetworkBandwidth(t *testing.T) {
+	client := &FISClient{}
+	action := fisv1alpha1.ActionSpec{
+		Name:     "throttle",
+		Type:     "pod-network-bandwidth",
+		Duration: "5m",
+		Target:   "pods",
+		Parameters: map[string]string{
+			"networkBandwidth": "10",
+			"trafficType":      "egress",
+		},
+	}
+
+	data, err := client.buildActionData(action, "fis-sa", nil)
+	if err != nil {
+		t.Fatalf("buildActionData failed: %v", err)
+	}
+	if data.actionID != "aws:eks:pod-network-bandwidth" {
+		t.Errorf("Expected aws:eks:pod-network-bandwidth, got %s", data.actionID)
+	}
+	for k, want := range map[string]string{
+		"networkBandwidth":         "10",
+		"trafficType":              "egress",
+		"duration":                 "PT5M",
+		"kubernetesServiceAccount": "fis-sa",
+	} {
+		if got := data.params[k]; got != want {
+			t.Errorf("Expected parameter %s=%s, got %q", k, want, got)
+		}
+	}
+}
+
+func TestBuildActionDataRequiredParameters(t *testing.T) {
+	tests := []struct {
+		actionType  string
+		params      map[string]string
+		wantErr     string
+		wantPercent string
+	}{
+		{actionType: "pod-cpu-stress", wantPercent: "80"},
+		{actionType: "pod-cpu-stress", params: map[string]string{"percent": "50"}, wantPercent: "50"},
+		{actionType: "pod-memory-stress", wantPercent: "80"},
+		{actionType: "pod-io-stress", wantErr: "pod-io-stress requires parameters: percent"},
+		{actionType: "pod-io-stress", params: map[string]string{"percent": "30"}, wantPercent: "30"},
+		{actionType: "pod-network-latency", wantErr: "pod-network-latency requires parameters: delayMilliseconds"},
+		{actionType: "pod-network-latency", params: map[string]string{"delayMilliseconds": "200"}},
+		{actionType: "pod-network-packet-loss", wantErr: "pod-network-packet-loss requires parameters: lossPercent"},
+		{actionType: "pod-network-packet-loss", params: map[string]string{"lossPercent": "10"}},
+		{actionType: "pod-network-bandwidth", wantErr: "pod-network-bandwidth requires parameters: networkBandwidth"},
+		{actionType: "pod-network-bandwidth", params: map[string]string{"networkBandwidth": "10"}},
+		{actionType: "pod-delete"},
+	}
+
+	client := &FISClient{}
+	for _, tt := range tests {
+		action := fisv1alpha1.ActionSpec{Name: "fault", Type: tt.actionType, Duration: "5m", Target: "pods", Parameters: tt.params}
+
+		data, err := client.buildActionData(action, "fis-sa", nil)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("%s %v: expected error %q, got: %v", tt.actionType, tt.params, tt.wantErr, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s %v: buildActionData failed: %v", tt.actionType, tt.params, err)
+			continue
+		}
+		if got := data.params["percent"]; got != tt.wantPercent {
+			t.Errorf("%s %v: expected percent %q, got %q", tt.actionType, tt.params, tt.wantPercent, got)
+		}
+	}
+}
+
+func TestBuildActionDataNonPodTargets(t *testing.T) {
+	tests := []struct {
+		actionType string
+		params     map[string]string
+		wantID     string
+		wantKey    string
+		wantParams map[string]string
+	}{
+		{
+			actionType: "ec2-stop-instances",
+			wantID:     "aws:ec2:stop-instances",
+			wantKey:    "Instances",
+			wantParams: map[string]string{"startInstancesAfterDuration": "PT5M"},
+		},
+		{actionType: "ec2-reboot-instances", wantID: "aws:ec2:reboot-instances", wantKey: "Instances", wantParams: map[string]string{}},
+		{actionType: "ec2-terminate-instances", wantID: "aws:ec2:terminate-instances", wantKey: "Instances", wantParams: map[string]string{}},
+		{
+			actionType: "nodegroup-terminate-instances",
+			params:     map[string]string{"instanceTerminationPercentage": "20"},
+			wantID:     "aws:eks:terminate-nodegroup-instances",
+			wantKey:    "Nodegroups",
+			wantParams: map[string]string{"instanceTerminationPercentage": "20"},
+		},
+		{actionType: "ecs-stop-task", wantID: "aws:ecs:stop-task", wantKey: "Tasks", wantParams: map[string]string{}},
+	}
+
+	client := &FISClient{}
+	for _, tt := range tests {
+		action := fisv1alpha1.ActionSpec{Name: "fault", Type: tt.actionType, Duration: "5m", Target: "resources", Parameters: tt.params}
+
+		data, err := client.buildActionData(action, "fis-sa", nil)
+		if err != nil {
+			t.Errorf("%s: buildActionData failed: %v", tt.actionType, err)
+			continue
+		}
+		if data.actionID != tt.wantID {
+			t.Errorf("%s: expected action ID %s, got %s", tt.actionType, tt.wantID, data.actionID)
+		}
+		if len(data.targets) != 1 || data.targets[tt.wantKey] != "resources" {
+			t.Errorf("%s: expected targets {%s: resources}, got: %v", tt.actionType, tt.wantKey, data.targets)
+		}
+		if !maps.Equal(data.params, tt.wantParams) {
+			t.Errorf("%s: expected parameters %v, got: %v", tt.actionType, tt.wantParams, data.params)
+		}
+	}
+
+	_, err := client.buildActionData(fisv1alpha1.ActionSpec{Name: "fault", Type: "nodegroup-terminate-instances", Duration: "5m", Target: "nodes"}, "", nil)
+	if err == nil || !strings.Contains(err.Error(), "requires parameters: instanceTerminationPercentage") {
+		t.Errorf("Expected a missing instanceTerminationPercentage error, got: %v", err)
+	}
+}
+
+func TestBuildCreateInputActionOnEC2Target(t *testing.T) {
+	client := &FISClient{}
+	template := &fisv1alpha1.ExperimentTemplate{
+		Spec: fisv1alpha1.ExperimentTemplateSpec{
+			Description: "Stop staging workers",
+			Targets: []fisv1alpha1.TargetSpec{
+				{
+					Name:          "workers",
+					ResourceType:  fisv1alpha1.ResourceTypeEC2Instance,
+					LabelSelector: map[string]string{"env": "staging"},
+					SelectionMode: "COUNT",
+					Count:         aws.Int32(1),
+				},
+			},
+			Actions: []fisv1alpha1.ActionSpec{
+				{Name: "stop-worker", Type: "ec2-stop-instances", Target: "workers", Duration: "10m"},
+			},
+		},
+	}
+
+	input, err := client.buildCreateInput(template, testRoleArn, testClusterIdentifier, "fis-sa")
+	if err != nil {
+		t.Fatalf("buildCreateInput failed: %v", err)
+	}
+
+	target := input.Targets["workers"]
+	if aws.ToString(target.ResourceType) != fisv1alpha1.ResourceTypeEC2Instance || aws.ToString(target.SelectionMode) != "COUNT(1)" {
+		t.Errorf("Expected an aws:ec2:instance target with COUNT(1), got: %s %s", aws.ToString(target.ResourceType), aws.ToString(target.SelectionMode))
+	}
+	if target.ResourceTags["env"] != "staging" || target.Parameters != nil {
+		t.Errorf("Expected tag env=staging and no pod parameters, got: %v, %v", target.ResourceTags, target.Parameters)
+	}
+
+	action := input.Actions["stop-worker"]
+	if aws.ToString(action.ActionId) != "aws:ec2:stop-instances" {
+		t.Errorf("Expected aws:ec2:stop-instances, got: %s", aws.ToString(action.ActionId))
+	}
+	if !maps.Equal(action.Targets, map[string]string{"Instances": "workers"}) {
+		t.Errorf("Expected targets {Instances: workers}, got: %v", action.Targets)
+	}
+	if !maps.Equal(action.Parameters, map[string]string{"startInstancesAfterDuration": "PT10M"}) {
+		t.Errorf("Expected only startInstancesAfterDuration=PT10M, got: %v", action.Parameters)
+	}
+
+	update, err := client.buildUpdateInput(template, "EXT1", testRoleArn, testClusterIdentifier, "fis-sa")
+	if err != nil {
+		t.Fatalf("buildUpdateInput failed: %v", err)
+	}
+	if !maps.Equal(update.Actions["stop-worker"].Targets, map[string]string{"Instances": "workers"}) {
+		t.Errorf("Expected update targets {Instances: workers}, got: %v", update.Actions["stop-worker"].Targets)
+	}
+}
+
+func TestConvertActionsInterpolatesParameters(t *testing.T) {
+	client := &FISClient{}
+	actions := []fisv1alpha1.ActionSpec{{
+		Name:     "latency",
+		Type:     "pod-network-latency",
+		Duration: "5m",
+		Target:   "pods",
+		Parameters: map[string]string{
+			"delayMilliseconds":  "${latency}",
+			"jitterMilliseconds": "${jitter}",
+			"interface":          "eth0",
+		},
+	}}
+
+	converted, err := client.convertActions(actions, "fis-sa", map[string]string{"latency": "250"})
+	if err != nil {
+		t.Fatalf("convertActions failed: %v", err)
+	}
+	params := converted["latency"].Parameters
+	for k, want := range map[string]string{
+		"delayMilliseconds":  "250",
+		"jitterMilliseconds": "${jitter}",
+		"interface":          "eth0",
+	} {
+		if got := params[k]; got != want {
+			t.Errorf("Expected parameter %s=%s, got %q", k, want, got)
+		}
+	}
+}
+
+func TestConvertExperimentReportConfiguration(t *testing.T) {
+	client := &FISClient{}
+	cfg := &fisv1alpha1.ExperimentReportConfiguration{
+		PreExperimentDuration: "20m",
+		DataSources: &fisv1alpha1.ReportDataSources{
+			CloudWatchDashboards: []fisv1alpha1.CloudWatchDashboard{
+				{DashboardIdentifier: "arn:aws:cloudwatch::123456789012:dashboard/web"},
+				{DashboardIdentifier: "arn:aws:cloudwatch::123456789012:dashboard/db"},
+			},
+		},
+		Outputs: &fisv1alpha1.ReportOutputs{
+			S3Configuration: &fisv1alpha1.S3Configuration{BucketName: "my-fis-reports", Prefix: "reports/nginx"},
+		},
+	}
+
+	input, err := client.convertExperimentReportConfiguration(cfg)
+	if err != nil {
+		t.Fatalf("convertExperimentReportConfiguration failed: %v", err)
+	}
+	if got := aws.ToString(input.PreExperimentDuration); got != "PT20M" {
+		t.Errorf("Expected preExperimentDuration PT20M, got: %s", got)
+	}
+
+	if input.DataSources == nil || len(input.DataSources.CloudWatchDashboards) != 2 {
+		t.Fatalf("Expected 2 dashboards, got: %+v", input.DataSources)
+	}
+	for i, want := range []string{"arn:aws:cloudwatch::123456789012:dashboard/web", "arn:aws:cloudwatch::123456789012:dashboard/db"} {
+		if got := aws.ToString(input.DataSources.CloudWatchDashboards[i].DashboardIdentifier); got != want {
+			t.Errorf("Expected dashboard %d to be %s, got: %s", i, want, got)
+		}
+	}
+
+	if input.Outputs == nil || input.Outputs.S3Configuration == nil {
+		t.Fatalf("Expected an S3 report output, got: %+v", input.Outputs)
+	}
+	if got := aws.ToString(input.Outputs.S3Configuration.BucketName); got != "my-fis-reports" {
+		t.Errorf("Expected bucket my-fis-reports, got: %s", got)
+	}
+	if got := aws.ToString(input.Outputs.S3Configuration.Prefix); got != "reports/nginx" {
+		t.Errorf("Expected prefix reports/nginx, got: %s", got)
+	}
+}
+
+func TestUpdateExperimentTemplateCarriesReportConfiguration(t *testing.T) {
+	fisAPI := &fake.FIS{}
+	client := NewFISClientFromAPI(fisAPI, aws.Config{})
+	template := &fisv1alpha1.ExperimentTemplate{
+		Spec: fisv1alpha1.ExperimentTemplateSpec{
+			Targets: []fisv1alpha1.TargetSpec{
+				{Name: "nginx-pods", Namespace: "default", LabelSelector: map[string]string{"app": "nginx"}},
+			},
+			Actions: []fisv1alpha1.ActionSpec{
+				{Name: "cpu-stress", Type: "pod-cpu-stress", Target: "nginx-pods", Duration: "5m"},
+			},
+			ExperimentReportConfiguration: &fisv1alpha1.ExperimentReportConfiguration{
+				PreExperimentDuration:  "10m",
+				PostExperimentDuration: "30m",
+				DataSources: &fisv1alpha1.ReportDataSources{
+					CloudWatchDashboards: []fisv1alpha1.CloudWatchDashboard{{DashboardIdentifier: "arn:aws:cloudwatch::123456789012:dashboard/web"}},
+				},
+				Outputs: &fisv1alpha1.ReportOutputs{
+					S3Configuration: &fisv1alpha1.S3Configuration{BucketName: "my-fis-reports"},
+				},
+			},
+		},
+	}
+
+	if err := client.UpdateExperimentTemplate(context.Background(), template, "EXT1", testRoleArn, testClusterIdentifier, "fis-sa"); err != nil {
+		t.Fatalf("UpdateExperimentTemplate failed: %v", err)
+	}
+	if len(fisAPI.UpdateExperimentTemplateInputs) != 1 {
+		t.Fatalf("Expected 1 UpdateExperimentTemplate call, got: %d", len(fisAPI.UpdateExperimentTemplateInputs))
+	}
+
+	report := fisAPI.UpdateExperimentTemplateInputs[0].ExperimentReportConfiguration
+	if report == nil {
+		t.Fatal("Expected the update to carry the report configuration")
+	}
+	if got := aws.ToString(report.PreExperimentDuration); got != "PT10M" {
+		t.Errorf("Expected preExperimentDuration PT10M, got: %s", got)
+	}
+	if got := aws.ToString(report.PostExperimentDuration); got != "PT30M" {
+		t.Errorf("Expected postExperimentDuration PT30M, got: %s", got)
+	}
+	if report.DataSources == nil || len(report.DataSources.CloudWatchDashboards) != 1 {
+		t.Errorf("Expected 1 dashboard, got: %+v", report.DataSources)
+	}
+	if report.Outputs == nil || aws.ToString(report.Outputs.S3Configuration.BucketName) != "my-fis-reports" {
+		t.Errorf("Expected the S3 report output, got: %+v", report.Outputs)
+	}
+}
+
+func TestCreateExperimentTemplateSerializesActionSequence(t *testing.T) {
+	fisAPI := &fake.FIS{}
+	client := NewFISClientFromAPI(fisAPI, aws.Config{})
+	template := &fisv1alpha1.ExperimentTemplate{
+		Spec: fisv1alpha1.ExperimentTemplateSpec{
+			Targets: []fisv1alpha1.TargetSpec{
+				{Name: "nginx-pods", Namespace: "default", LabelSelector: map[string]string{"app": "nginx"}},
+			},
+			Actions: []fisv1alpha1.ActionSpec{
+				{Name: "memory-stress", Type: "pod-memory-stress", Target: "nginx-pods", Duration: "5m"},
+				{Name: "cpu-stress", Type: "pod-cpu-stress", Target: "nginx-pods", Duration: "5m"},
+				{Name: "pod-delete", Type: "pod-delete", Target: "nginx-pods", Duration: "1m", StartAfter: []string{"warmup"}},
+				{Name: "warmup", Type: "pod-cpu-stress", Target: "nginx-pods", Duration: "1m"},
+			},
+			ActionSequence: []string{"cpu-stress", "memory-stress", "pod-delete"},
+		},
+	}
+
+	if _, err := client.CreateExperimentTemplate(context.Background(), template, testRoleArn, testClusterIdentifier, "fis-sa"); err != nil {
+		t.Fatalf("CreateExperimentTemplate failed: %v", err)
+	}
+	if len(fisAPI.CreateExperimentTemplateInputs) != 1 {
+		t.Fatalf("Expected 1 CreateExperimentTemplate call, got: %d", len(fisAPI.CreateExperimentTemplateInputs))
+	}
+
+	actions := fisAPI.CreateExperimentTemplateInputs[0].Actions
+	for name, want := range map[string][]string{
+		"cpu-stress":    nil,
+		"memory-stress": {"cpu-stress"},
+		"pod-delete":    {"warmup", "memory-stress"},
+		"warmup":        nil,
+	} {
+		if got := actions[name].StartAfter; !slices.Equal(got, want) {
+			t.Errorf("Expected %s to start after %v, got: %v", name, want, got)
+		}
+	}
+	if got := template.Spec.Actions[0].StartAfter; len(got) != 0 {
+		t.Errorf("Expected the spec to stay unchanged, got startAfter: %v", got)
+	}
+}
+
+func TestExperimentTemplateWithoutTargetsOrActions(t *testing.T) {
+	targets := []fisv1alpha1.TargetSpec{
+		{Name: "nginx-pods", Namespace: "default", LabelSelector: map[string]string{"app": "nginx"}},
+	}
+	actions := []fisv1alpha1.ActionSpec{
+		{Name: "cpu-stress", Type: "pod-cpu-stress", Target: "nginx-pods", Duration: "5m"},
+	}
+	tests := []struct {
+		name    string
+		spec    fisv1alpha1.ExperimentTemplateSpec
+		wantErr error
+	}{
+		{name: "no targets", spec: fisv1alpha1.ExperimentTemplateSpec{Actions: actions}, wantErr: errEmptyTargets},
+		{name: "no actions", spec: fisv1alpha1.ExperimentTemplateSpec{Targets: targets}, wantErr: errEmptyActions},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fisAPI := &fake.FIS{}
+			client := NewFISClientFromAPI(fisAPI, aws.Config{})
+			template := &fisv1alpha1.ExperimentTemplate{Spec: tt.spec}
+
+			_, err := client.CreateExperimentTemplate(context.Background(), template, testRoleArn, testClusterIdentifier, "fis-sa")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected create to fail with %q, got: %v", tt.wantErr, err)
+			}
+			err = client.UpdateExperimentTemplate(context.Background(), template, "EXT1", testRoleArn, testClusterIdentifier, "fis-sa")
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Expected update to fail with %q, got: %v", tt.wantErr, err)
+			}
+			if len(fisAPI.CreateExperimentTemplateInputs) != 0 || len(fisAPI.UpdateExperimentTemplateInputs) != 0 {
+				t.Error("Expected no AWS FIS calls for an incomplete template")
+			}
+		})
+	}
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fis"
+	"github.com/aws/aws-sdk-go-v2/service/fis/types"
+
+	fisv1alpha1 "fis.dksshddl.dev/fis-controller/api/v1alpha1"
+)
+
+// DiffExperimentTemplate compares the AWS FIS experiment template with the desired CRD spec
+// and returns one human-readable line per difference, without changing anything
+func (c *FISClient) DiffExperimentTemplate(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, templateID, roleArn, clusterIdentifier, serviceAccount string) ([]string, error) {
+	desired, err := c.buildUpdateInput(template, templateID, roleArn, clusterIdentifier, serviceAccount)
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := c.GetExperimentTemplate(ctx, templateID)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffTemplate(current, desired), nil
+}
+
+// FormatTemplateDiff renders the differences returned by DiffExperimentTemplate on a single line
+func FormatTemplateDiff(changes []string) string {
+	if len(changes) == 0 {
+		return "no changes"
+	}
+	return strings.Join(changes, "; ")
+}
+
+// diffTemplate lists the differences between an existing template and an update input
+func diffTemplate(current *types.ExperimentTemplate, desired *fis.UpdateExperimentTemplateInput) []string {
+	var changes []string
+
+	changes = appendValueDiff(changes, "description", aws.ToString(current.Description), aws.ToString(desired.Description))
+	changes = appendValueDiff(changes, "roleArn", aws.ToString(current.RoleArn), aws.ToString(desired.RoleArn))
+
+	for _, name := range unionKeys(current.Targets, desired.Targets) {
+		prefix := fmt.Sprintf("target %q", name)
+		cur, inCurrent := current.Targets[name]
+		want, inDesired := desired.Targets[name]
+		switch {
+		case !inCurrent:
+			changes = append(changes, prefix+" added")
+		case !inDesired:
+			changes = append(changes, prefix+" removed")
+		default:
+			changes = appendValueDiff(changes, prefix+" resourceType", aws.ToString(cur.ResourceType), aws.ToString(want.ResourceType))
+			changes = appendValueDiff(changes, prefix+" selectionMode", aws.ToString(cur.SelectionMode), aws.ToString(want.SelectionMode))
+			changes = appendMapDiff(changes, prefix+" parameter", cur.Parameters, want.Parameters)
+			changes = appendMapDiff(changes, prefix+" resourceTag", cur.ResourceTags, want.ResourceTags)
+			changes = appendValueDiff(changes, prefix+" resourceArns", strings.Join(cur.ResourceArns, ","), strings.Join(want.ResourceArns, ","))
+			changes = appendValueDiff(changes, prefix+" filters", formatTargetFilters(cur.Filters), formatTargetInputFilters(want.Filters))
+		}
+	}
+
+	for _, name := range unionKeys(current.Actions, desired.Actions) {
+		prefix := fmt.Sprintf("action %q", name)
+		cur, inCurrent := current.Actions[name]
+		want, inDesired := desired.Actions[name]
+		switch {
+		case !inCurrent:
+			changes = append(changes, prefix+" added")
+		case !inDesired:
+			changes = append(changes, prefix+" removed")
+		default:
+			changes = appendValueDiff(changes, prefix+" actionId", aws.ToString(cur.ActionId), aws.ToString(want.ActionId))
+			changes = appendValueDiff(changes, prefix+" description", aws.ToString(cur.Description), aws.ToString(want.Description))
+			changes = appendMapDiff(changes, prefix+" parameter", cur.Parameters, want.Parameters)
+			changes = appendMapDiff(changes, prefix+" target", cur.Targets, want.Targets)
+			changes = appendValueDiff(changes, prefix+" startAfter", strings.Join(cur.StartAfter, ","), strings.Join(want.StartAfter, ","))
+		}
+	}
+
+	var currentStops, desiredStops []string
+	for _, cond := range current.StopConditions {
+		currentStops = append(currentStops, formatStopCondition(aws.ToString(cond.Source), aws.ToString(cond.Value)))
+	}
+	for _, cond := range desired.StopConditions {
+		desiredStops = append(desiredStops, formatStopCondition(aws.ToString(cond.Source), aws.ToString(cond.Value)))
+	}
+	changes = appendValueDiff(changes, "stopConditions", strings.Join(currentStops, ","), strings.Join(desiredStops, ","))
+
+	var currentPre, currentPost, desiredPre, desiredPost string
+	if report := current.ExperimentReportConfiguration; report != nil {
+		currentPre, currentPost = aws.ToString(report.PreExperimentDuration), aws.ToString(report.PostExperimentDuration)
+	}
+	if report := desired.ExperimentReportConfiguration; report != nil {
+		desiredPre, desiredPost = aws.ToString(report.PreExperimentDuration), aws.ToString(report.PostExperimentDuration)
+	}
+	changes = appendValueDiff(changes, "report preExperimentDuration", currentPre, desiredPre)
+	changes = appendValueDiff(changes, "report postExperimentDuration", currentPost, desiredPost)
+
+	return changes
+}
+
+// appendValueDiff appends a "field: old -> new" line when the values differ
+func appendValueDiff(changes []string, field, current, desired string) []string {
+	if current == desired {
+		return changes
+	}
+	return append(changes, fmt.Sprintf("%s: %q -> %q", field, current, desired))
+}
+
+// appendMapDiff appends one line per added, removed or changed key
+func appendMapDiff(changes []string, field string, current, desired map[string]string) []string {
+	for _, key := range unionKeys(current, desired) {
+		cur, inCurrent := current[key]
+		want, inDesired := desired[key]
+		switch {
+		case !inCurrent:
+			changes = append(changes, fmt.Sprintf("%s %s added: %q", field, key, want))
+		case !inDesired:
+			changes = append(changes, fmt.Sprintf("%s %s removed", field, key))
+		default:
+			changes = appendValueDiff(changes, field+" "+key, cur, want)
+		}
+	}
+	return changes
+}
+
+// unionKeys returns the sorted keys present in either map
+func unionKeys[V1, V2 any](a map[string]V1, b map[string]V2) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func formatTargetFilters(filters []types.ExperimentTemplateTargetFilter) string {
+	parts := make([]string, 0, len(filters))
+	for _, f := range filters {
+		parts = append(parts, aws.ToString(f.Path)+"="+strings.Join(f.Values, "|"))
+	}
+	return strings.Join(parts, ",")
+}
+
+func formatTargetInputFilters(filters []types.ExperimentTemplateTargetInputFilter) string {
+	parts := make([]string, 0, len(filters))
+	for _, f := range filters {
+		parts = append(parts, aws.ToString(f.Path)+"="+strings.Join(f.Values, "|"))
+	}
+	return strings.Join(parts, ",")
+}
+
+func formatStopCondition(source, value string) string {
+	if value == "" {
+		return source
+	}
+	return source + "=" + value
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fis"
+	"github.com/aws/aws-sdk-go-v2/service/fis/types"
+
+	fisv1alpha1 "fis.dksshddl.dev/fis-controller/api/v1alpha1"
+	"fis.dksshddl.dev/fis-controller/internal/aws/fake"
+)
+
+const testRoleArn = "arn:aws:iam::123456789012:role/fis"
+
+func newDiffTestTemplate() *fisv1alpha1.ExperimentTemplate {
+	return &fisv1alpha1.ExperimentTemplate{
+		Spec: fisv1alpha1.ExperimentTemplateSpec{
+			Description: "CPU stress on nginx",
+			Targets: []fisv1alpha1.TargetSpec{
+				{Name: "nginx-pods", Namespace: "default", LabelSelector: map[string]string{"app": "nginx"}, Scope: "ALL"},
+			},
+			Actions: []fisv1alpha1.ActionSpec{
+				{Name: "cpu-stress", Type: "pod-cpu-stress", Target: "nginx-pods", Duration: "5m"},
+			},
+		},
+	}
+}
+
+// awsTemplateFor returns the AWS template that an update with the given spec would produce
+func awsTemplateFor(t *testing.T, client *FISClient, template *fisv1alpha1.ExperimentTemplate) *types.ExperimentTemplate {
+	t.Helper()
+	input, err := client.buildUpdateInput(template, "EXT1", testRoleArn, testClusterIdentifier, "fis-sa")
+	if err != nil {
+		t.Fatalf("buildUpdateInput failed: %v", err)
+	}
+
+	current := &types.ExperimentTemplate{
+		Id:          input.Id,
+		Description: input.Description,
+		RoleArn:     input.RoleArn,
+		Targets:     map[string]types.ExperimentTemplateTarget{},
+		Actions:     map[string]types.ExperimentTemplateAction{},
+	}
+	for name, target := range input.Targets {
+		filters := make([]types.ExperimentTemplateTargetFilter, 0, len(target.Filters))
+		for _, f := range target.Filters {
+			filters = append(filters, types.ExperimentTemplateTargetFilter{Path: f.Path, Values: f.Values})
+		}
+		current.Targets[name] = types.ExperimentTemplateTarget{
+			ResourceType:  target.ResourceType,
+			SelectionMode: target.SelectionMode,
+			Parameters:    target.Parameters,
+			Filters:       filters,
+		}
+	}
+	if report := input.ExperimentReportConfiguration; report != nil {
+		current.ExperimentReportConfiguration = &types.ExperimentTemplateReportConfiguration{
+			PreExperimentDuration:  report.PreExperimentDuration,
+			PostExperimentDuration: report.PostExperimentDuration,
+		}
+	}
+	for name, action := range input.Actions {
+		current.Actions[name] = types.ExperimentTemplateAction{
+			ActionId:    action.ActionId,
+			Description: action.Description,
+			Parameters:  action.Parameters,
+			Targets:     action.Targets,
+			StartAfter:  action.StartAfter,
+		}
+	}
+	return current
+}
+
+func TestDiffExperimentTemplateNoChanges(t *testing.T) {
+	client := &FISClient{}
+	template := newDiffTestTemplate()
+	current := awsTemplateFor(t, client, template)
+
+	desired, err := client.buildUpdateInput(template, "EXT1", testRoleArn, testClusterIdentifier, "fis-sa")
+	if err != nil {
+		t.Fatalf("buildUpdateInput failed: %v", err)
+	}
+	if changes := diffTemplate(current, desired); len(changes) != 0 {
+		t.Errorf("Expected no changes, got: %v", changes)
+	}
+	if got := FormatTemplateDiff(nil); got != "no changes" {
+		t.Errorf("Expected 'no changes', got: %s", got)
+	}
+}
+
+func TestDiffExperimentTemplateDescriptionOnly(t *testing.T) {
+	fisAPI := &fake.FIS{}
+	client := NewFISClientFromAPI(fisAPI, aws.Config{})
+	template := newDiffTestTemplate()
+	current := awsTemplateFor(t, client, template)
+	fisAPI.GetExperimentTemplateFunc = func(*fis.GetExperimentTemplateInput) (*fis.GetExperimentTemplateOutput, error) {
+		return &fis.GetExperimentTemplateOutput{ExperimentTemplate: current}, nil
+	}
+
+	template.Spec.Description = "CPU stress on nginx, nightly"
+	changes, err := client.DiffExperimentTemplate(context.Background(), template, "EXT1", testRoleArn, testClusterIdentifier, "fis-sa")
+	if err != nil {
+		t.Fatalf("DiffExperimentTemplate failed: %v", err)
+	}
+
+	want := `description: "CPU stress on nginx" -> "CPU stress on nginx, nightly"`
+	if len(changes) != 1 || changes[0] != want {
+		t.Errorf("Expected only the description change, got: %v", changes)
+	}
+	if len(fisAPI.UpdateExperimentTemplateInputs) != 0 {
+		t.Errorf("Expected no UpdateExperimentTemplate calls, got: %d", len(fisAPI.UpdateExperimentTemplateInputs))
+	}
+}
+
+func TestDiffExperimentTemplateTargetChange(t *testing.T) {
+	client := &FISClient{}
+	template := newDiffTestTemplate()
+	current := awsTemplateFor(t, client, template)
+
+	template.Spec.Targets[0].Namespace = "prod"
+	template.Spec.Targets[0].Scope = "2"
+	template.Spec.Targets = append(template.Spec.Targets, fisv1alpha1.TargetSpec{
+		Name: "api-pods", Namespace: "prod", LabelSelector: map[string]string{"app": "api"},
+	})
+	desired, err := client.buildUpdateInput(template, "EXT1", testRoleArn, testClusterIdentifier, "fis-sa")
+	if err != nil {
+		t.Fatalf("buildUpdateInput failed: %v", err)
+	}
+
+	changes := diffTemplate(current, desired)
+	want := []string{
+		`target "api-pods" added`,
+		`target "nginx-pods" selectionMode: "ALL" -> "COUNT(2)"`,
+		`target "nginx-pods" parameter namespace: "default" -> "prod"`,
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("Expected %d changes, got: %v", len(want), changes)
+	}
+	for i := range want {
+		if changes[i] != want[i] {
+			t.Errorf("changes[%d] = %s, want %s", i, changes[i], want[i])
+		}
+	}
+}
+
+func TestDiffExperimentTemplateReportDuration(t *testing.T) {
+	client := &FISClient{}
+	template := newDiffTestTemplate()
+	template.Spec.ExperimentReportConfiguration = &fisv1alpha1.ExperimentReportConfiguration{PostExperimentDuration: "10m"}
+	current := awsTemplateFor(t, client, template)
+
+	template.Spec.ExperimentReportConfiguration.PostExperimentDuration = "20m"
+	desired, err := client.buildUpdateInput(template, "EXT1", testRoleArn, testClusterIdentifier, "fis-sa")
+	if err != nil {
+		t.Fatalf("buildUpdateInput failed: %v", err)
+	}
+
+	want := `report postExperimentDuration: "PT10M" -> "PT20M"`
+	if changes := diffTemplate(current, desired); len(changes) != 1 || changes[0] != want {
+		t.Errorf("Expected only the report duration change, got: %v", changes)
+	}
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fis"
+	"github.com/aws/aws-sdk-go-v2/service/fis/types"
+	"github.com/google/uuid"
+
+	fisv1alpha1 "fis.dksshddl.dev/fis-controller/api/v1alpha1"
+)
+
+// SyncTargetAccountConfigurations makes the target account configurations of an experiment template
+// match the desired list: missing accounts are created, changed ones updated and the rest deleted
+func (c *FISClient) SyncTargetAccountConfigurations(ctx context.Context, templateID string, desired []fisv1alpha1.TargetAccountConfiguration) error {
+	existing, err := c.listTargetAccountConfigurations(ctx, templateID)
+	if err != nil {
+		return err
+	}
+
+	for _, config := range desired {
+		current, ok := existing[config.AccountID]
+		delete(existing, config.AccountID)
+
+		switch {
+		case !ok:
+			_, err := c.client.CreateTargetAccountConfiguration(ctx, &fis.CreateTargetAccountConfigurationInput{
+				ClientToken:          aws.String(uuid.New().String()),
+				ExperimentTemplateId: aws.String(templateID),
+				AccountId:            aws.String(config.AccountID),
+				RoleArn:              aws.String(config.RoleArn),
+				Description:          aws.String(config.Description),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create target account configuration for account %s: %w", config.AccountID, err)
+			}
+		case aws.ToString(current.RoleArn) != config.RoleArn || aws.ToString(current.Description) != config.Description:
+			_, err := c.client.UpdateTargetAccountConfiguration(ctx, &fis.UpdateTargetAccountConfigurationInput{
+				ExperimentTemplateId: aws.String(templateID),
+				AccountId:            aws.String(config.AccountID),
+				RoleArn:              aws.String(config.RoleArn),
+				Description:          aws.String(config.Description),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to update target account configuration for account %s: %w", config.AccountID, err)
+			}
+		}
+	}
+
+	for accountID := range existing {
+		if err := c.deleteTargetAccountConfiguration(ctx, templateID, accountID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DeleteTargetAccountConfigurations deletes every target account configuration of an experiment template
+func (c *FISClient) DeleteTargetAccountConfigurations(ctx context.Context, templateID string) error {
+	existing, err := c.listTargetAccountConfigurations(ctx, templateID)
+	if err != nil {
+		return err
+	}
+	for accountID := range existing {
+		if err := c.deleteTargetAccountConfiguration(ctx, templateID, accountID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listTargetAccountConfigurations returns the target account configurations of a template keyed by account ID
+func (c *FISClient) listTargetAccountConfigurations(ctx context.Context, templateID string) (map[string]types.TargetAccountConfigurationSummary, error) {
+	configs := make(map[string]types.TargetAccountConfigurationSummary)
+	var nextToken *string
+	for {
+		output, err := c.client.ListTargetAccountConfigurations(ctx, &fis.ListTargetAccountConfigurationsInput{
+			ExperimentTemplateId: aws.String(templateID),
+			NextToken:            nextToken,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list target account configurations: %w", err)
+		}
+		for _, config := range output.TargetAccountConfigurations {
+			configs[aws.ToString(config.AccountId)] = config
+		}
+		if output.NextToken == nil {
+			return configs, nil
+		}
+		nextToken = output.NextToken
+	}
+}
+
+// deleteTargetAccountConfiguration deletes one target account configuration, ignoring one that is already gone
+func (c *FISClient) deleteTargetAccountConfiguration(ctx context.Context, templateID, accountID string) error {
+	_, err := c.client.DeleteTargetAccountConfiguration(ctx, &fis.DeleteTargetAccountConfigurationInput{
+		ExperimentTemplateId: aws.String(templateID),
+		AccountId:            aws.String(accountID),
+	})
+	if err != nil && !IsFISNotFoundError(err) {
+		return fmt.Errorf("failed to delete target account configuration for account %s: %w", accountID, err)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fis/types"
+
+	fisv1alpha1 "fis.dksshddl.dev/fis-controller/api/v1alpha1"
+	"fis.dksshddl.dev/fis-controller/internal/aws/fake"
+)
+
+func TestSyncTargetAccountConfigurations(t *testing.T) {
+	fisAPI := &fake.FIS{
+		TargetAccountConfigurations: map[string]map[string]types.TargetAccountConfigurationSummary{
+			"EXT1": {
+				"111111111111": {AccountId: aws.String("111111111111"), RoleArn: aws.String("arn:aws:iam::111111111111:role/old")},
+				"333333333333": {AccountId: aws.String("333333333333"), RoleArn: aws.String("arn:aws:iam::333333333333:role/fis")},
+			},
+		},
+	}
+	client := NewFISClientFromAPI(fisAPI, aws.Config{})
+
+	desired := []fisv1alpha1.TargetAccountConfiguration{
+		{AccountID: "111111111111", RoleArn: "arn:aws:iam::111111111111:role/new"},
+		{AccountID: "222222222222", RoleArn: "arn:aws:iam::222222222222:role/fis", Description: "prod"},
+	}
+	if err := client.SyncTargetAccountConfigurations(context.Background(), "EXT1", desired); err != nil {
+		t.Fatalf("SyncTargetAccountConfigurations failed: %v", err)
+	}
+
+	if len(fisAPI.CreateTargetAccountConfigurationInputs) != 1 || aws.ToString(fisAPI.CreateTargetAccountConfigurationInputs[0].AccountId) != "222222222222" {
+		t.Errorf("Expected account 222222222222 to be created, got: %v", fisAPI.CreateTargetAccountConfigurationInputs)
+	}
+	if len(fisAPI.UpdateTargetAccountConfigurationInputs) != 1 || aws.ToString(fisAPI.UpdateTargetAccountConfigurationInputs[0].RoleArn) != "arn:aws:iam::111111111111:role/new" {
+		t.Errorf("Expected the role of account 111111111111 to be updated, got: %v", fisAPI.UpdateTargetAccountConfigurationInputs)
+	}
+	if len(fisAPI.DeleteTargetAccountConfigurationInputs) != 1 || aws.ToString(fisAPI.DeleteTargetAccountConfigurationInputs[0].AccountId) != "333333333333" {
+		t.Errorf("Expected account 333333333333 to be deleted, got: %v", fisAPI.DeleteTargetAccountConfigurationInputs)
+	}
+
+	// A second sync with the same spec changes nothing
+	if err := client.SyncTargetAccountConfigurations(context.Background(), "EXT1", desired); err != nil {
+		t.Fatalf("SyncTargetAccountConfigurations failed: %v", err)
+	}
+	calls := len(fisAPI.CreateTargetAccountConfigurationInputs) + len(fisAPI.UpdateTargetAccountConfigurationInputs) + len(fisAPI.DeleteTargetAccountConfigurationInputs)
+	if calls != 3 {
+		t.Errorf("Expected no further changes, got %d mutating calls in total", calls)
+	}
+
+	if err := client.DeleteTargetAccountConfigurations(context.Background(), "EXT1"); err != nil {
+		t.Fatalf("DeleteTargetAccountConfigurations failed: %v", err)
+	}
+	if len(fisAPI.TargetAccountConfigurations["EXT1"]) != 0 {
+		t.Errorf("Expected all configurations to be deleted, got: %v", fisAPI.TargetAccountConfigurations["EXT1"])
+	}
+}
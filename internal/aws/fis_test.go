@@ -0,0 +1,213 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/service/fis"
+	"github.com/aws/aws-sdk-go-v2/service/fis/types"
+
+	"fis.dksshddl.dev/fis-controller/internal/aws/fake"
+)
+
+func TestExperimentConsoleURL(t *testing.T) {
+	tests := []struct {
+		region string
+		want   string
+	}{
+		{region: "ap-northeast-2", want: "https://ap-northeast-2.console.aws.amazon.com/fis/home?region=ap-northeast-2#ExperimentDetails:ExperimentId=EXP123"},
+		{region: "cn-north-1", want: "https://cn-north-1.console.amazonaws.cn/fis/home?region=cn-north-1#ExperimentDetails:ExperimentId=EXP123"},
+		{region: "us-gov-west-1", want: "https://us-gov-west-1.console.amazonaws-us-gov.com/fis/home?region=us-gov-west-1#ExperimentDetails:ExperimentId=EXP123"},
+	}
+
+	for _, tt := range tests {
+		if got := ExperimentConsoleURL(tt.region, "EXP123"); got != tt.want {
+			t.Errorf("ExperimentConsoleURL(%q) = %s, want %s", tt.region, got, tt.want)
+		}
+	}
+}
+
+func TestNewFISClientRetryMode(t *testing.T) {
+	t.Setenv("AWS_REGION", "ap-northeast-2")
+	t.Setenv("AWS_ACCESS_KEY_ID", "test")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "test")
+
+	tests := []struct {
+		mode     aws.RetryMode
+		adaptive bool
+	}{
+		{mode: ""},
+		{mode: aws.RetryModeStandard},
+		{mode: aws.RetryModeAdaptive, adaptive: true},
+	}
+
+	for _, tt := range tests {
+		client, err := NewFISClient(context.Background(), FISConfig{MaxRetries: 5, RetryMode: tt.mode})
+		if err != nil {
+			t.Fatalf("NewFISClient(%q) failed: %v", tt.mode, err)
+		}
+
+		retryer := client.GetAWSConfig().Retryer()
+		if _, ok := retryer.(*retry.AdaptiveMode); ok != tt.adaptive {
+			t.Errorf("NewFISClient(%q) retryer = %T, want adaptive %v", tt.mode, retryer, tt.adaptive)
+		}
+		if got := retryer.MaxAttempts(); got != 5 {
+			t.Errorf("NewFISClient(%q) max attempts = %d, want 5", tt.mode, got)
+		}
+	}
+
+	if _, err := NewFISClient(context.Background(), FISConfig{RetryMode: "legacy"}); err == nil {
+		t.Error("Expected an error for an unsupported retry mode")
+	}
+}
+
+func TestWaitForExperimentTemplate(t *testing.T) {
+	delays := templateConsistencyDelays
+	templateConsistencyDelays = []time.Duration{time.Millisecond, time.Millisecond}
+	defer func() { templateConsistencyDelays = delays }()
+
+	notFound := &types.ResourceNotFoundException{Message: aws.String("template not found")}
+
+	t.Run("not found then found", func(t *testing.T) {
+		fisAPI := &fake.FIS{}
+		fisAPI.GetExperimentTemplateFunc = func(params *fis.GetExperimentTemplateInput) (*fis.GetExperimentTemplateOutput, error) {
+			if len(fisAPI.GetExperimentTemplateInputs) == 1 {
+				return nil, notFound
+			}
+			return &fis.GetExperimentTemplateOutput{ExperimentTemplate: &types.ExperimentTemplate{Id: params.Id}}, nil
+		}
+
+		template, err := NewFISClientFromAPI(fisAPI, aws.Config{}).WaitForExperimentTemplate(context.Background(), "EXT1")
+		if err != nil {
+			t.Fatalf("WaitForExperimentTemplate failed: %v", err)
+		}
+		if aws.ToString(template.Id) != "EXT1" {
+			t.Errorf("Expected template EXT1, got: %s", aws.ToString(template.Id))
+		}
+		if got := len(fisAPI.GetExperimentTemplateInputs); got != 2 {
+			t.Errorf("Expected 2 get attempts, got %d", got)
+		}
+	})
+
+	t.Run("not found after all attempts", func(t *testing.T) {
+		fisAPI := &fake.FIS{
+			GetExperimentTemplateFunc: func(*fis.GetExperimentTemplateInput) (*fis.GetExperimentTemplateOutput, error) {
+				return nil, notFound
+			},
+		}
+
+		_, err := NewFISClientFromAPI(fisAPI, aws.Config{}).WaitForExperimentTemplate(context.Background(), "EXT1")
+		if !IsFISNotFoundError(err) {
+			t.Errorf("Expected a not found error, got: %v", err)
+		}
+		if got := len(fisAPI.GetExperimentTemplateInputs); got != 3 {
+			t.Errorf("Expected 3 get attempts, got %d", got)
+		}
+	})
+
+	t.Run("other errors are not retried", func(t *testing.T) {
+		fisAPI := &fake.FIS{
+			GetExperimentTemplateFunc: func(*fis.GetExperimentTemplateInput) (*fis.GetExperimentTemplateOutput, error) {
+				return nil, errors.New("boom")
+			},
+		}
+
+		if _, err := NewFISClientFromAPI(fisAPI, aws.Config{}).WaitForExperimentTemplate(context.Background(), "EXT1"); err == nil {
+			t.Error("Expected an error")
+		}
+		if got := len(fisAPI.GetExperimentTemplateInputs); got != 1 {
+			t.Errorf("Expected 1 get attempt, got %d", got)
+		}
+	})
+}
+
+func TestListResolvedTargetArns(t *testing.T) {
+	fisAPI := &fake.FIS{}
+	fisAPI.ListExperimentResolvedTargetsFunc = func(params *fis.ListExperimentResolvedTargetsInput) (*fis.ListExperimentResolvedTargetsOutput, error) {
+		if params.NextToken == nil {
+			return &fis.ListExperimentResolvedTargetsOutput{
+				ResolvedTargets: []types.ResolvedTarget{
+					{TargetName: aws.String("web"), TargetInformation: map[string]string{"arn": "arn:aws:ec2:us-east-1:123456789012:instance/i-1"}},
+					{TargetName: aws.String("web"), TargetInformation: map[string]string{"namespace": "default"}},
+				},
+				NextToken: aws.String("page-2"),
+			}, nil
+		}
+		return &fis.ListExperimentResolvedTargetsOutput{
+			ResolvedTargets: []types.ResolvedTarget{
+				{TargetName: aws.String("web"), TargetInformation: map[string]string{"arn": "arn:aws:ec2:us-east-1:123456789012:instance/i-2"}},
+				{TargetName: aws.String("web"), TargetInformation: map[string]string{"arn": "arn:aws:ec2:us-east-1:123456789012:instance/i-3"}},
+			},
+		}, nil
+	}
+	client := NewFISClientFromAPI(fisAPI, aws.Config{})
+
+	arns, truncated, err := client.ListResolvedTargetArns(context.Background(), "EXP1", 10)
+	if err != nil {
+		t.Fatalf("ListResolvedTargetArns failed: %v", err)
+	}
+	if len(arns) != 3 || truncated {
+		t.Errorf("Expected 3 ARNs across both pages, got: %v (truncated %v)", arns, truncated)
+	}
+
+	arns, truncated, err = client.ListResolvedTargetArns(context.Background(), "EXP1", 2)
+	if err != nil {
+		t.Fatalf("ListResolvedTargetArns failed: %v", err)
+	}
+	if len(arns) != 2 || !truncated {
+		t.Errorf("Expected 2 ARNs and truncation, got: %v (truncated %v)", arns, truncated)
+	}
+}
+
+func TestListExperimentsByTemplateIgnoresOtherTemplates(t *testing.T) {
+	fisAPI := &fake.FIS{}
+	fisAPI.ListExperimentsFunc = func(params *fis.ListExperimentsInput) (*fis.ListExperimentsOutput, error) {
+		if params.NextToken == nil {
+			return &fis.ListExperimentsOutput{
+				Experiments: []types.ExperimentSummary{
+					{Id: aws.String("EXP1"), ExperimentTemplateId: aws.String("EXT1"), State: &types.ExperimentState{}},
+					{Id: aws.String("EXP2"), ExperimentTemplateId: aws.String("EXT12"), State: &types.ExperimentState{}},
+				},
+				NextToken: aws.String("page-2"),
+			}, nil
+		}
+		return &fis.ListExperimentsOutput{
+			Experiments: []types.ExperimentSummary{
+				{Id: aws.String("EXP3"), ExperimentTemplateId: aws.String("EXT2"), State: &types.ExperimentState{}},
+				{Id: aws.String("EXP4"), ExperimentTemplateId: aws.String("EXT1"), State: &types.ExperimentState{}},
+				{Id: aws.String("EXP5"), State: &types.ExperimentState{}},
+			},
+		}, nil
+	}
+
+	experiments, err := NewFISClientFromAPI(fisAPI, aws.Config{}).ListExperimentsByTemplate(context.Background(), "EXT1")
+	if err != nil {
+		t.Fatalf("ListExperimentsByTemplate failed: %v", err)
+	}
+	if len(experiments) != 2 || experiments[0].ID != "EXP1" || experiments[1].ID != "EXP4" {
+		t.Errorf("Expected only EXP1 and EXP4 of template EXT1, got: %+v", experiments)
+	}
+	if len(fisAPI.ListExperimentsInputs) != 2 || aws.ToString(fisAPI.ListExperimentsInputs[0].ExperimentTemplateId) != "EXT1" {
+		t.Errorf("Expected both pages to be requested for template EXT1, got: %d inputs", len(fisAPI.ListExperimentsInputs))
+	}
+}
@@ -17,7 +17,13 @@ limitations under the License.
 package aws
 
 import (
+	"fmt"
 	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/service/fis/types"
+
+	fisv1alpha1 "fis.dksshddl.dev/fis-controller/api/v1alpha1"
 )
 
 // convertActionType converts CRD action type to AWS FIS action ID
@@ -28,7 +34,14 @@ func (c *FISClient) convertActionType(actionType string) string {
 		"pod-io-stress":           "aws:eks:pod-io-stress",
 		"pod-network-latency":     "aws:eks:pod-network-latency",
 		"pod-network-packet-loss": "aws:eks:pod-network-packet-loss",
+		"pod-network-bandwidth":   "aws:eks:pod-network-bandwidth",
 		"pod-delete":              "aws:eks:pod-delete",
+
+		"ec2-stop-instances":            "aws:ec2:stop-instances",
+		"ec2-reboot-instances":          "aws:ec2:reboot-instances",
+		"ec2-terminate-instances":       "aws:ec2:terminate-instances",
+		"nodegroup-terminate-instances": "aws:eks:terminate-nodegroup-instances",
+		"ecs-stop-task":                 "aws:ecs:stop-task",
 	}
 
 	if awsActionId, ok := actionMap[actionType]; ok {
@@ -39,16 +52,142 @@ func (c *FISClient) convertActionType(actionType string) string {
 	return actionType
 }
 
-// convertDuration converts Kubernetes duration format to AWS ISO 8601 duration
-// e.g., "5m" -> "PT5M", "1h" -> "PT1H", "30s" -> "PT30S"
-func (c *FISClient) convertDuration(duration string) string {
+// actionResourceTypes are the target resource types of the action types that do not act on pods
+var actionResourceTypes = map[string]string{
+	"ec2-stop-instances":            fisv1alpha1.ResourceTypeEC2Instance,
+	"ec2-reboot-instances":          fisv1alpha1.ResourceTypeEC2Instance,
+	"ec2-terminate-instances":       fisv1alpha1.ResourceTypeEC2Instance,
+	"nodegroup-terminate-instances": fisv1alpha1.ResourceTypeEKSNodegroup,
+	"ecs-stop-task":                 fisv1alpha1.ResourceTypeECSTask,
+}
+
+// ActionResourceType returns the target resource type an action type acts on, aws:eks:pod for pod actions
+func ActionResourceType(actionType string) string {
+	if resourceType, ok := actionResourceTypes[actionType]; ok {
+		return resourceType
+	}
+	return fisv1alpha1.ResourceTypeEKSPod
+}
+
+// actionTargetKeys are the keys FIS expects in the targets of an action acting on each resource type
+var actionTargetKeys = map[string]string{
+	fisv1alpha1.ResourceTypeEKSPod:       "Pods",
+	fisv1alpha1.ResourceTypeEC2Instance:  "Instances",
+	fisv1alpha1.ResourceTypeEKSNodegroup: "Nodegroups",
+	fisv1alpha1.ResourceTypeECSTask:      "Tasks",
+}
+
+// actionDurationParameters name the parameter that carries the duration of non-pod action types
+// Types mapped to "" act once and take no duration; all other types use "duration"
+var actionDurationParameters = map[string]string{
+	"ec2-stop-instances":            "startInstancesAfterDuration",
+	"ec2-reboot-instances":          "",
+	"ec2-terminate-instances":       "",
+	"nodegroup-terminate-instances": "",
+	"ecs-stop-task":                 "",
+}
+
+// actionDurationParameter returns the parameter that carries the duration of the action type, "" when it takes none
+func actionDurationParameter(actionType string) string {
+	if key, ok := actionDurationParameters[actionType]; ok {
+		return key
+	}
+	return "duration"
+}
+
+// actionParameterDefaults are the parameters set on an action of each type when its spec leaves them out
+var actionParameterDefaults = map[string]map[string]string{
+	"pod-cpu-stress":    {"percent": "80"},
+	"pod-memory-stress": {"percent": "80"},
+}
+
+// requiredActionParameters are the parameters an action of each type must set because they have no default
+var requiredActionParameters = map[string][]string{
+	"pod-io-stress":           {"percent"},
+	"pod-network-latency":     {"delayMilliseconds"},
+	"pod-network-packet-loss": {"lossPercent"},
+	"pod-network-bandwidth":   {"networkBandwidth"},
+
+	"nodegroup-terminate-instances": {"instanceTerminationPercentage"},
+}
+
+// MissingActionParameters returns the required parameters of the action type that params does not set
+func MissingActionParameters(actionType string, params map[string]string) []string {
+	var missing []string
+	for _, key := range requiredActionParameters[actionType] {
+		if _, ok := params[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	return missing
+}
+
+// convertDuration converts a Go-style duration to an AWS ISO 8601 duration
+// e.g., "30s" -> "PT30S", "5m" -> "PT5M", "1h30m" -> "PT1H30M", "90s" -> "PT1M30S"
+// Values already in "PT..." form are validated and passed through
+func (c *FISClient) convertDuration(duration string) (string, error) {
 	if strings.HasPrefix(duration, "PT") {
-		return duration // Already in AWS format
+		if _, err := ParseISODuration(duration); err != nil {
+			return "", fmt.Errorf("invalid duration %q: %w", duration, err)
+		}
+		return duration, nil
+	}
+
+	d, err := time.ParseDuration(duration)
+	if err != nil {
+		return "", fmt.Errorf("invalid duration %q: %w", duration, err)
 	}
+	if d < time.Second {
+		return "", fmt.Errorf("invalid duration %q: must be at least 1s", duration)
+	}
+	if d%time.Second != 0 {
+		return "", fmt.Errorf("invalid duration %q: must be a whole number of seconds", duration)
+	}
+
+	return formatISODuration(d), nil
+}
+
+// formatISODuration formats a whole-second duration as ISO 8601, omitting zero components
+func formatISODuration(d time.Duration) string {
+	hours := int64(d / time.Hour)
+	minutes := int64(d % time.Hour / time.Minute)
+	seconds := int64(d % time.Minute / time.Second)
 
-	// Convert from Kubernetes format
-	duration = strings.ToUpper(duration)
-	return "PT" + duration
+	var b strings.Builder
+	b.WriteString("PT")
+	if hours > 0 {
+		fmt.Fprintf(&b, "%dH", hours)
+	}
+	if minutes > 0 {
+		fmt.Fprintf(&b, "%dM", minutes)
+	}
+	if seconds > 0 {
+		fmt.Fprintf(&b, "%dS", seconds)
+	}
+	return b.String()
+}
+
+// ParseISODuration parses an AWS ISO 8601 duration such as "PT5M" or "PT1H30M"
+func ParseISODuration(duration string) (time.Duration, error) {
+	if !strings.HasPrefix(duration, "PT") {
+		return 0, fmt.Errorf("unsupported duration %q, expected PT prefix", duration)
+	}
+	return time.ParseDuration(strings.ToLower(strings.TrimPrefix(duration, "PT")))
+}
+
+// MaxActionDuration returns the longest action duration in an AWS FIS experiment template
+// Actions without a parsable duration or startInstancesAfterDuration parameter are ignored
+func MaxActionDuration(template *types.ExperimentTemplate) time.Duration {
+	var longest time.Duration
+	for _, action := range template.Actions {
+		for _, key := range []string{"duration", "startInstancesAfterDuration"} {
+			d, err := ParseISODuration(action.Parameters[key])
+			if err == nil && d > longest {
+				longest = d
+			}
+		}
+	}
+	return longest
 }
 
 // convertStopConditionSource converts CRD stop condition source to AWS format
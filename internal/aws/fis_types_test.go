@@ -0,0 +1,81 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseISODuration(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    time.Duration
+		wantErr bool
+	}{
+		{in: "PT30S", want: 30 * time.Second},
+		{in: "PT5M", want: 5 * time.Minute},
+		{in: "PT1H30M", want: 90 * time.Minute},
+		{in: "5m", wantErr: true},
+		{in: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseISODuration(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseISODuration(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseISODuration(%q) = %s, want %s", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestConvertDuration(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "30s", want: "PT30S"},
+		{in: "5m", want: "PT5M"},
+		{in: "1h", want: "PT1H"},
+		{in: "1h30m", want: "PT1H30M"},
+		{in: "90s", want: "PT1M30S"},
+		{in: "1h0m15s", want: "PT1H15S"},
+		{in: "PT5M", want: "PT5M"},
+		{in: "PT1H30M", want: "PT1H30M"},
+		{in: "2d", wantErr: true},
+		{in: "abc", wantErr: true},
+		{in: "500ms", wantErr: true},
+		{in: "1.5s", wantErr: true},
+		{in: "PT2D", wantErr: true},
+	}
+
+	client := &FISClient{}
+	for _, tt := range tests {
+		got, err := client.convertDuration(tt.in)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("convertDuration(%q) error = %v, wantErr %v", tt.in, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("convertDuration(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -18,18 +18,76 @@ package aws
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 )
 
+// IAMAPI is the subset of the AWS IAM API used by IAMClient
+// It is satisfied by *iam.Client and allows tests to substitute a fake
+type IAMAPI interface {
+	GetRole(ctx context.Context, params *iam.GetRoleInput, optFns ...func(*iam.Options)) (*iam.GetRoleOutput, error)
+	CreateRole(ctx context.Context, params *iam.CreateRoleInput, optFns ...func(*iam.Options)) (*iam.CreateRoleOutput, error)
+	DeleteRole(ctx context.Context, params *iam.DeleteRoleInput, optFns ...func(*iam.Options)) (*iam.DeleteRoleOutput, error)
+	PutRolePolicy(ctx context.Context, params *iam.PutRolePolicyInput, optFns ...func(*iam.Options)) (*iam.PutRolePolicyOutput, error)
+	ListRolePolicies(ctx context.Context, params *iam.ListRolePoliciesInput, optFns ...func(*iam.Options)) (*iam.ListRolePoliciesOutput, error)
+	DeleteRolePolicy(ctx context.Context, params *iam.DeleteRolePolicyInput, optFns ...func(*iam.Options)) (*iam.DeleteRolePolicyOutput, error)
+	AttachRolePolicy(ctx context.Context, params *iam.AttachRolePolicyInput, optFns ...func(*iam.Options)) (*iam.AttachRolePolicyOutput, error)
+	DetachRolePolicy(ctx context.Context, params *iam.DetachRolePolicyInput, optFns ...func(*iam.Options)) (*iam.DetachRolePolicyOutput, error)
+	ListAttachedRolePolicies(ctx context.Context, params *iam.ListAttachedRolePoliciesInput, optFns ...func(*iam.Options)) (*iam.ListAttachedRolePoliciesOutput, error)
+}
+
+// RolePolicyOptions selects the permissions given to an auto-created FIS role
+type RolePolicyOptions struct {
+	// PolicyArns are managed policies attached to the role
+	PolicyArns []string
+
+	// SkipInlinePolicy leaves out the controller's inline policy, for roles whose managed policies grant everything
+	SkipInlinePolicy bool
+
+	// ClusterArn scopes the inline policy's EKS actions; they apply to all clusters when empty
+	ClusterArn string
+
+	// LogGroupArn scopes the inline policy's logs actions; they apply to all log groups when empty
+	LogGroupArn string
+
+	// PermissionsBoundary is the policy ARN set as the role's permissions boundary; no boundary when empty
+	PermissionsBoundary string
+}
+
+const (
+	// defaultRoleNamePrefix starts auto-created role names unless RoleNaming sets a prefix
+	defaultRoleNamePrefix = "fis"
+
+	// maxRoleNameLength is the IAM limit for role names
+	maxRoleNameLength = 64
+
+	// roleNameHashLength is the length of the hash suffix added to truncated role names
+	roleNameHashLength = 8
+)
+
+// RoleNaming selects the name and IAM path of auto-created FIS roles
+type RoleNaming struct {
+	// Prefix starts every role name; defaults to "fis"
+	Prefix string
+
+	// Path is the IAM path of the roles (e.g. /chaos/); defaults to /
+	Path string
+}
+
 // IAMClient wraps AWS IAM client
 type IAMClient struct {
-	client *iam.Client
+	client IAMAPI
+
+	// RoleNaming names the roles created by EnsureIAMRole and removed by DeleteIAMRole
+	RoleNaming RoleNaming
 }
 
 // NewIAMClient creates a new IAM client using the same config as FIS client
@@ -39,8 +97,16 @@ func NewIAMClient(awsConfig aws.Config) *IAMClient {
 	}
 }
 
+// NewIAMClientFromAPI creates an IAM client backed by the given API implementation
+func NewIAMClientFromAPI(api IAMAPI) *IAMClient {
+	return &IAMClient{
+		client: api,
+	}
+}
+
 // CreateFISRole creates an IAM role for FIS experiment template
-func (c *IAMClient) CreateFISRole(ctx context.Context, roleName, namespace, templateName string) (string, error) {
+// The role gets the inline FIS policy unless opts skips it, and every managed policy in opts
+func (c *IAMClient) CreateFISRole(ctx context.Context, roleName, namespace, templateName string, opts RolePolicyOptions) (string, error) {
 	// Trust policy for FIS service
 	trustPolicy := map[string]interface{}{
 		"Version": "2012-10-17",
@@ -64,6 +130,7 @@ func (c *IAMClient) CreateFISRole(ctx context.Context, roleName, namespace, temp
 	createRoleInput := &iam.CreateRoleInput{
 		RoleName:                 aws.String(roleName),
 		AssumeRolePolicyDocument: aws.String(string(trustPolicyJSON)),
+		Path:                     aws.String(c.RoleNaming.path()),
 		Description:              aws.String(fmt.Sprintf("IAM role for FIS experiment template %s/%s", namespace, templateName)),
 		Tags: []iamtypes.Tag{
 			{
@@ -81,6 +148,10 @@ func (c *IAMClient) CreateFISRole(ctx context.Context, roleName, namespace, temp
 		},
 	}
 
+	if opts.PermissionsBoundary != "" {
+		createRoleInput.PermissionsBoundary = aws.String(opts.PermissionsBoundary)
+	}
+
 	createRoleOutput, err := c.client.CreateRole(ctx, createRoleInput)
 	if err != nil {
 		return "", fmt.Errorf("failed to create IAM role: %w", err)
@@ -88,9 +159,56 @@ func (c *IAMClient) CreateFISRole(ctx context.Context, roleName, namespace, temp
 
 	roleArn := aws.ToString(createRoleOutput.Role.Arn)
 
+	for _, policyArn := range opts.PolicyArns {
+		_, err := c.client.AttachRolePolicy(ctx, &iam.AttachRolePolicyInput{
+			RoleName:  aws.String(roleName),
+			PolicyArn: aws.String(policyArn),
+		})
+		if err != nil {
+			return "", fmt.Errorf("failed to attach managed policy %s to role: %w", policyArn, err)
+		}
+	}
+	if opts.SkipInlinePolicy {
+		return roleArn, nil
+	}
+
 	// Attach FIS service policy
 	// This policy allows FIS to perform actions on EKS pods
-	policyDocument := map[string]interface{}{
+	policyDocument := fisRolePolicyDocument(opts)
+
+	policyDocumentJSON, err := json.Marshal(policyDocument)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal policy document: %w", err)
+	}
+
+	policyName := fmt.Sprintf("%s-policy", roleName)
+	putPolicyInput := &iam.PutRolePolicyInput{
+		RoleName:       aws.String(roleName),
+		PolicyName:     aws.String(policyName),
+		PolicyDocument: aws.String(string(policyDocumentJSON)),
+	}
+
+	_, err = c.client.PutRolePolicy(ctx, putPolicyInput)
+	if err != nil {
+		return "", fmt.Errorf("failed to attach policy to role: %w", err)
+	}
+
+	return roleArn, nil
+}
+
+// fisRolePolicyDocument builds the inline policy of an auto-created FIS role
+// EKS and logs actions are limited to the cluster and log group in opts, falling back to "*" when unknown
+func fisRolePolicyDocument(opts RolePolicyOptions) map[string]interface{} {
+	clusterResource := "*"
+	if opts.ClusterArn != "" {
+		clusterResource = opts.ClusterArn
+	}
+	logsResource := "*"
+	if opts.LogGroupArn != "" {
+		logsResource = opts.LogGroupArn
+	}
+
+	return map[string]interface{}{
 		"Version": "2012-10-17",
 		"Statement": []map[string]interface{}{
 			{
@@ -99,7 +217,7 @@ func (c *IAMClient) CreateFISRole(ctx context.Context, roleName, namespace, temp
 					"eks:DescribeCluster",
 					"eks:ListClusters",
 				},
-				"Resource": "*",
+				"Resource": clusterResource,
 			},
 			{
 				"Effect": "Allow",
@@ -121,29 +239,10 @@ func (c *IAMClient) CreateFISRole(ctx context.Context, roleName, namespace, temp
 					"logs:DescribeResourcePolicies",
 					"logs:DescribeLogGroups",
 				},
-				"Resource": "*",
+				"Resource": logsResource,
 			},
 		},
 	}
-
-	policyDocumentJSON, err := json.Marshal(policyDocument)
-	if err != nil {
-		return "", fmt.Errorf("failed to marshal policy document: %w", err)
-	}
-
-	policyName := fmt.Sprintf("%s-policy", roleName)
-	putPolicyInput := &iam.PutRolePolicyInput{
-		RoleName:       aws.String(roleName),
-		PolicyName:     aws.String(policyName),
-		PolicyDocument: aws.String(string(policyDocumentJSON)),
-	}
-
-	_, err = c.client.PutRolePolicy(ctx, putPolicyInput)
-	if err != nil {
-		return "", fmt.Errorf("failed to attach policy to role: %w", err)
-	}
-
-	return roleArn, nil
 }
 
 // DeleteFISRole deletes an IAM role created for FIS experiment template
@@ -170,6 +269,33 @@ func (c *IAMClient) DeleteFISRole(ctx context.Context, roleName string) error {
 		}
 	}
 
+	// Detach all managed policies, a role with attachments cannot be deleted
+	var marker *string
+	for {
+		listAttachedOutput, err := c.client.ListAttachedRolePolicies(ctx, &iam.ListAttachedRolePoliciesInput{
+			RoleName: aws.String(roleName),
+			Marker:   marker,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list attached role policies: %w", err)
+		}
+
+		for _, policy := range listAttachedOutput.AttachedPolicies {
+			_, err := c.client.DetachRolePolicy(ctx, &iam.DetachRolePolicyInput{
+				RoleName:  aws.String(roleName),
+				PolicyArn: policy.PolicyArn,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to detach role policy %s: %w", aws.ToString(policy.PolicyArn), err)
+			}
+		}
+
+		if !listAttachedOutput.IsTruncated {
+			break
+		}
+		marker = listAttachedOutput.Marker
+	}
+
 	// Delete the role
 	deleteRoleInput := &iam.DeleteRoleInput{
 		RoleName: aws.String(roleName),
@@ -202,36 +328,51 @@ func (c *IAMClient) RoleExists(ctx context.Context, roleName string) (bool, erro
 	return true, nil
 }
 
-// GenerateRoleName generates a unique role name for an experiment template
-func GenerateRoleName(namespace, templateName string) string {
+// RoleName generates a unique role name for an experiment template
+func (n RoleNaming) RoleName(namespace, templateName string) string {
+	prefix := n.Prefix
+	if prefix == "" {
+		prefix = defaultRoleNamePrefix
+	}
+
 	// IAM role names must be alphanumeric plus +=,.@-_ and max 64 chars
 	// For cluster-scoped resources, namespace is empty
 	var roleName string
 	if namespace == "" {
-		roleName = fmt.Sprintf("fis-%s", templateName)
+		roleName = fmt.Sprintf("%s-%s", prefix, templateName)
 	} else {
-		roleName = fmt.Sprintf("fis-%s-%s", namespace, templateName)
+		roleName = fmt.Sprintf("%s-%s-%s", prefix, namespace, templateName)
 	}
 
-	// Truncate if too long (max 64 chars)
-	if len(roleName) > 64 {
-		roleName = roleName[:64]
+	// Truncate if too long, keeping a hash of the full name so long names that share a prefix don't collide
+	if len(roleName) > maxRoleNameLength {
+		sum := sha256.Sum256([]byte(roleName))
+		suffix := hex.EncodeToString(sum[:])[:roleNameHashLength]
+		roleName = roleName[:maxRoleNameLength-roleNameHashLength-1] + "-" + suffix
 	}
 
 	return roleName
 }
 
+// path returns the IAM path of auto-created roles
+func (n RoleNaming) path() string {
+	if n.Path == "" {
+		return "/"
+	}
+	return n.Path
+}
+
 // EnsureIAMRole ensures an IAM role exists for the experiment template
 // If roleArn is provided, it validates the role exists
-// If roleArn is empty, it creates a new role
-func EnsureIAMRole(ctx context.Context, iamClient *IAMClient, namespace, templateName, roleArn string) (string, error) {
+// If roleArn is empty, it creates a new role with the policies selected by opts
+func EnsureIAMRole(ctx context.Context, iamClient *IAMClient, namespace, templateName, roleArn string, opts RolePolicyOptions) (string, error) {
 	// If roleArn is provided, just return it (assume it's valid)
 	if roleArn != "" {
 		return roleArn, nil
 	}
 
 	// Generate role name
-	roleName := GenerateRoleName(namespace, templateName)
+	roleName := iamClient.RoleNaming.RoleName(namespace, templateName)
 
 	// Check if role already exists
 	exists, err := iamClient.RoleExists(ctx, roleName)
@@ -252,7 +393,7 @@ func EnsureIAMRole(ctx context.Context, iamClient *IAMClient, namespace, templat
 	}
 
 	// Create new role
-	createdRoleArn, err := iamClient.CreateFISRole(ctx, roleName, namespace, templateName)
+	createdRoleArn, err := iamClient.CreateFISRole(ctx, roleName, namespace, templateName, opts)
 	if err != nil {
 		return "", fmt.Errorf("failed to create IAM role: %w", err)
 	}
@@ -260,9 +401,20 @@ func EnsureIAMRole(ctx context.Context, iamClient *IAMClient, namespace, templat
 	return createdRoleArn, nil
 }
 
+// IsAutoCreatedRole reports whether roleArn names the role EnsureIAMRole creates for the template
+func IsAutoCreatedRole(iamClient *IAMClient, roleArn, namespace, templateName string) bool {
+	// The ARN resource is the role path without its leading slash followed by the name
+	_, resource, found := strings.Cut(roleArn, ":role/")
+	if !found {
+		return false
+	}
+	naming := iamClient.RoleNaming
+	return resource == strings.TrimPrefix(naming.path(), "/")+naming.RoleName(namespace, templateName)
+}
+
 // DeleteIAMRole deletes the IAM role for an experiment template
 func DeleteIAMRole(ctx context.Context, iamClient *IAMClient, namespace, templateName string) error {
-	roleName := GenerateRoleName(namespace, templateName)
+	roleName := iamClient.RoleNaming.RoleName(namespace, templateName)
 
 	// Check if role exists
 	exists, err := iamClient.RoleExists(ctx, roleName)
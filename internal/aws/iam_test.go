@@ -0,0 +1,248 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+
+	"fis.dksshddl.dev/fis-controller/internal/aws/fake"
+)
+
+func TestCreateFISRoleAttachesManagedPolicies(t *testing.T) {
+	policies := []string{
+		"arn:aws:iam::aws:policy/AWSFaultInjectionSimulatorEKSAccess",
+		"arn:aws:iam::123456789012:policy/fis-shared",
+	}
+
+	tests := []struct {
+		name       string
+		opts       RolePolicyOptions
+		wantInline bool
+	}{
+		{name: "inline only", wantInline: true},
+		{name: "managed and inline", opts: RolePolicyOptions{PolicyArns: policies}, wantInline: true},
+		{name: "managed only", opts: RolePolicyOptions{PolicyArns: policies, SkipInlinePolicy: true}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iamAPI := &fake.IAM{}
+			client := NewIAMClientFromAPI(iamAPI)
+
+			roleArn, err := client.CreateFISRole(context.Background(), "fis-test", "", "test", tt.opts)
+			if err != nil {
+				t.Fatalf("CreateFISRole failed: %v", err)
+			}
+			if roleArn != fake.RoleArn("fis-test") {
+				t.Errorf("Expected role ARN %s, got: %s", fake.RoleArn("fis-test"), roleArn)
+			}
+			if got := iamAPI.AttachedPolicies["fis-test"]; !slices.Equal(got, tt.opts.PolicyArns) {
+				t.Errorf("Expected attached policies %v, got: %v", tt.opts.PolicyArns, got)
+			}
+			if gotInline := len(iamAPI.PutRolePolicyInputs) == 1; gotInline != tt.wantInline {
+				t.Errorf("Expected inline policy %v, got %d PutRolePolicy calls", tt.wantInline, len(iamAPI.PutRolePolicyInputs))
+			}
+		})
+	}
+}
+
+func TestDeleteFISRoleDetachesManagedPolicies(t *testing.T) {
+	iamAPI := &fake.IAM{}
+	client := NewIAMClientFromAPI(iamAPI)
+	ctx := context.Background()
+
+	opts := RolePolicyOptions{PolicyArns: []string{
+		"arn:aws:iam::aws:policy/AWSFaultInjectionSimulatorEKSAccess",
+		"arn:aws:iam::123456789012:policy/fis-shared",
+	}}
+	if _, err := client.CreateFISRole(ctx, "fis-test", "", "test", opts); err != nil {
+		t.Fatalf("CreateFISRole failed: %v", err)
+	}
+
+	if err := client.DeleteFISRole(ctx, "fis-test"); err != nil {
+		t.Fatalf("DeleteFISRole failed: %v", err)
+	}
+	if len(iamAPI.DetachRolePolicyInputs) != 2 {
+		t.Errorf("Expected 2 DetachRolePolicy calls, got: %d", len(iamAPI.DetachRolePolicyInputs))
+	}
+	if len(iamAPI.AttachedPolicies["fis-test"]) != 0 {
+		t.Errorf("Expected no attached policies left, got: %v", iamAPI.AttachedPolicies["fis-test"])
+	}
+	if _, ok := iamAPI.Roles["fis-test"]; ok {
+		t.Error("Expected role to be deleted")
+	}
+}
+
+func TestCreateFISRoleScopesInlinePolicy(t *testing.T) {
+	const (
+		clusterArn  = "arn:aws:eks:ap-northeast-2:123456789012:cluster/demo"
+		logGroupArn = "arn:aws:logs:ap-northeast-2:123456789012:log-group:/aws/fis/experiments:*"
+	)
+
+	tests := []struct {
+		name         string
+		opts         RolePolicyOptions
+		wantCluster  string
+		wantLogGroup string
+	}{
+		{name: "no scope known", wantCluster: "*", wantLogGroup: "*"},
+		{name: "cluster only", opts: RolePolicyOptions{ClusterArn: clusterArn}, wantCluster: clusterArn, wantLogGroup: "*"},
+		{
+			name:         "cluster and log group",
+			opts:         RolePolicyOptions{ClusterArn: clusterArn, LogGroupArn: logGroupArn},
+			wantCluster:  clusterArn,
+			wantLogGroup: logGroupArn,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			iamAPI := &fake.IAM{}
+			client := NewIAMClientFromAPI(iamAPI)
+
+			if _, err := client.CreateFISRole(context.Background(), "fis-test", "", "test", tt.opts); err != nil {
+				t.Fatalf("CreateFISRole failed: %v", err)
+			}
+			if len(iamAPI.PutRolePolicyInputs) != 1 {
+				t.Fatalf("Expected 1 PutRolePolicy call, got: %d", len(iamAPI.PutRolePolicyInputs))
+			}
+
+			var policy struct {
+				Statement []struct {
+					Action   []string
+					Resource string
+				}
+			}
+			if err := json.Unmarshal([]byte(*iamAPI.PutRolePolicyInputs[0].PolicyDocument), &policy); err != nil {
+				t.Fatalf("Failed to parse policy document: %v", err)
+			}
+			for _, statement := range policy.Statement {
+				switch {
+				case slices.Contains(statement.Action, "eks:DescribeCluster"):
+					if statement.Resource != tt.wantCluster {
+						t.Errorf("Expected EKS actions on %s, got: %s", tt.wantCluster, statement.Resource)
+					}
+				case slices.Contains(statement.Action, "logs:CreateLogDelivery"):
+					if statement.Resource != tt.wantLogGroup {
+						t.Errorf("Expected logs actions on %s, got: %s", tt.wantLogGroup, statement.Resource)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestCreateFISRolePermissionsBoundary(t *testing.T) {
+	const boundary = "arn:aws:iam::123456789012:policy/org-boundary"
+
+	for _, tt := range []struct {
+		name string
+		opts RolePolicyOptions
+		want string
+	}{
+		{name: "without boundary"},
+		{name: "with boundary", opts: RolePolicyOptions{PermissionsBoundary: boundary}, want: boundary},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			iamAPI := &fake.IAM{}
+			client := NewIAMClientFromAPI(iamAPI)
+
+			if _, err := client.CreateFISRole(context.Background(), "fis-test", "", "test", tt.opts); err != nil {
+				t.Fatalf("CreateFISRole failed: %v", err)
+			}
+			if len(iamAPI.CreateRoleInputs) != 1 {
+				t.Fatalf("Expected 1 CreateRole call, got: %d", len(iamAPI.CreateRoleInputs))
+			}
+			got := iamAPI.CreateRoleInputs[0].PermissionsBoundary
+			if tt.want == "" && got != nil {
+				t.Errorf("Expected no permissions boundary, got: %s", *got)
+			}
+			if tt.want != "" && (got == nil || *got != tt.want) {
+				t.Errorf("Expected permissions boundary %s, got: %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRoleNameTruncatesWithHash(t *testing.T) {
+	long := strings.Repeat("a", 70)
+
+	if got := (RoleNaming{}).RoleName("", "web"); got != "fis-web" {
+		t.Errorf("Expected fis-web, got: %s", got)
+	}
+	if got := (RoleNaming{Prefix: "chaos"}).RoleName("", "web"); got != "chaos-web" {
+		t.Errorf("Expected chaos-web, got: %s", got)
+	}
+
+	first := (RoleNaming{}).RoleName("team-a", long)
+	second := (RoleNaming{}).RoleName("team-a", long+"-canary")
+	if len(first) != 64 || len(second) != 64 {
+		t.Errorf("Expected truncated names of 64 characters, got: %d and %d", len(first), len(second))
+	}
+	if first == second {
+		t.Errorf("Expected long names sharing a prefix to differ, both are: %s", first)
+	}
+	if !strings.HasPrefix(first, "fis-team-a-aaa") {
+		t.Errorf("Expected the truncated name to keep its prefix, got: %s", first)
+	}
+	if again := (RoleNaming{}).RoleName("team-a", long); again != first {
+		t.Errorf("Expected the truncated name to be stable, got: %s and %s", first, again)
+	}
+}
+
+func TestEnsureIAMRoleUsesRoleNaming(t *testing.T) {
+	iamAPI := &fake.IAM{}
+	client := NewIAMClientFromAPI(iamAPI)
+	client.RoleNaming = RoleNaming{Prefix: "chaos", Path: "/chaos/"}
+
+	roleArn, err := EnsureIAMRole(context.Background(), client, "", "web", "", RolePolicyOptions{})
+	if err != nil {
+		t.Fatalf("EnsureIAMRole failed: %v", err)
+	}
+	if roleArn != fake.RolePathArn("/chaos/", "chaos-web") {
+		t.Errorf("Expected the role ARN to include the path, got: %s", roleArn)
+	}
+	if !IsAutoCreatedRole(client, roleArn, "", "web") {
+		t.Errorf("Expected %s to be recognized as auto-created", roleArn)
+	}
+	if IsAutoCreatedRole(client, fake.RoleArn("chaos-web"), "", "web") {
+		t.Error("Expected a same-named role outside the path not to be recognized as auto-created")
+	}
+	if len(iamAPI.CreateRoleInputs) != 1 {
+		t.Fatalf("Expected 1 CreateRole call, got: %d", len(iamAPI.CreateRoleInputs))
+	}
+	input := iamAPI.CreateRoleInputs[0]
+	if got := aws.ToString(input.RoleName); got != "chaos-web" {
+		t.Errorf("Expected role chaos-web, got: %s", got)
+	}
+	if got := aws.ToString(input.Path); got != "/chaos/" {
+		t.Errorf("Expected path /chaos/, got: %s", got)
+	}
+
+	if err := DeleteIAMRole(context.Background(), client, "", "web"); err != nil {
+		t.Fatalf("DeleteIAMRole failed: %v", err)
+	}
+	if _, ok := iamAPI.Roles["chaos-web"]; ok {
+		t.Error("Expected role chaos-web to be deleted")
+	}
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// defaultS3Endpoint answers bucket requests for every region
+const defaultS3Endpoint = "https://s3.amazonaws.com"
+
+// bucketRegionHeader is returned by S3 for any existing bucket, even when access to it is denied
+const bucketRegionHeader = "x-amz-bucket-region"
+
+// BucketRegionResolver looks up the region of S3 buckets
+// It reads the region S3 reports for a HEAD request on the bucket, so it needs neither credentials nor the S3 API
+type BucketRegionResolver struct {
+	// HTTPClient sends the requests; defaults to a client with a 10 second timeout
+	HTTPClient *http.Client
+
+	// Endpoint is the S3 endpoint; defaults to https://s3.amazonaws.com
+	Endpoint string
+}
+
+// BucketRegion returns the region of an S3 bucket
+func (r *BucketRegionResolver) BucketRegion(ctx context.Context, bucket string) (string, error) {
+	endpoint := r.Endpoint
+	if endpoint == "" {
+		endpoint = defaultS3Endpoint
+	}
+	httpClient := r.HTTPClient
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 10 * time.Second}
+	}
+	// S3 redirects requests for buckets in other regions, the redirect already carries the region
+	noRedirects := *httpClient
+	noRedirects.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, endpoint+"/"+url.PathEscape(bucket), nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to build bucket region request: %w", err)
+	}
+	resp, err := noRedirects.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to look up bucket region: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if region := resp.Header.Get(bucketRegionHeader); region != "" {
+		return region, nil
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return "", fmt.Errorf("bucket %s does not exist", bucket)
+	}
+	return "", fmt.Errorf("S3 reported no region for bucket %s: %s", bucket, resp.Status)
+}
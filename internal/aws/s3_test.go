@@ -0,0 +1,55 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestBucketRegion(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/fis-logs":
+			w.Header().Set(bucketRegionHeader, "ap-northeast-2")
+		case "/fis-moved":
+			// S3 redirects buckets of other regions but still reports the region
+			w.Header().Set(bucketRegionHeader, "eu-west-1")
+			http.Redirect(w, r, "/elsewhere", http.StatusMovedPermanently)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	resolver := &BucketRegionResolver{Endpoint: server.URL}
+	for bucket, want := range map[string]string{"fis-logs": "ap-northeast-2", "fis-moved": "eu-west-1"} {
+		region, err := resolver.BucketRegion(context.Background(), bucket)
+		if err != nil {
+			t.Fatalf("Expected region of %s, got error: %v", bucket, err)
+		}
+		if region != want {
+			t.Errorf("Expected %s to be in %s, got: %s", bucket, want, region)
+		}
+	}
+
+	if _, err := resolver.BucketRegion(context.Background(), "missing"); err == nil {
+		t.Error("Expected an error for a missing bucket")
+	}
+}
@@ -18,39 +18,99 @@ package experiment
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	fistypes "github.com/aws/aws-sdk-go-v2/service/fis/types"
 	"github.com/go-logr/logr"
 	"github.com/robfig/cron/v3"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	fisv1alpha1 "fis.dksshddl.dev/fis-controller/api/v1alpha1"
 	awsfis "fis.dksshddl.dev/fis-controller/internal/aws"
+	"fis.dksshddl.dev/fis-controller/internal/validation"
 )
 
 const (
 	experimentFinalizer = "fis.dksshddl.dev/experiment-finalizer"
+
+	// triggerAnnotation runs a scheduled experiment immediately whenever its value changes
+	triggerAnnotation = "fis.dksshddl.dev/trigger"
+
+	// stuckExperimentBuffer is how long an experiment may run past its longest action before it is stopped
+	stuckExperimentBuffer = 30 * time.Minute
+
+	// activeRunPollInterval is how often a scheduled run held back by the concurrency policy is retried
+	activeRunPollInterval = 30 * time.Second
+
+	// freezeReasonAnnotation on the controller's config ConfigMap blocks all new experiment starts,
+	// its value is reported as the reason on every deferred Experiment
+	freezeReasonAnnotation = "fis.dksshddl.dev/freeze-reason"
+
+	// freezePollInterval is how often an experiment deferred by a freeze checks whether it was lifted
+	freezePollInterval = time.Minute
+
+	// maxEndTimePolls is how many times a terminal experiment is synced again waiting for FIS to report its end time
+	maxEndTimePolls = 5
+
+	// maxResolvedTargetArns bounds the resolved target ARNs kept in the status to keep the object small
+	maxResolvedTargetArns = 100
+
+	// endTimePollInterval is how often a terminal experiment without an end time is synced again
+	endTimePollInterval = 5 * time.Second
 )
 
+// scheduleParser is shared with the Experiment webhook, so schedules rejected at admission never reach the controller
+var scheduleParser = validation.ScheduleParser
+
 // Reconciler reconciles a Experiment object
 type Reconciler struct {
 	client.Client
 	Scheme    *runtime.Scheme
 	FISClient *awsfis.FISClient
+	Recorder  record.EventRecorder
+
+	// ConfigMap is the controller's config ConfigMap, checked for the freeze-reason annotation before each start
+	// Freezing is disabled when the name is empty
+	ConfigMap types.NamespacedName
+
+	// APIReader reads the config ConfigMap without a cache; falls back to Client when nil
+	APIReader client.Reader
+
+	// Identity names this controller replica, usually its pod name; it is recorded on every experiment it starts
+	Identity string
+
+	// Validator enforces Experiment policies; one reading templates through Client is used when nil
+	Validator *validation.ExperimentValidator
+
+	// MaxConcurrentExperiments caps how many Experiments may be active across the cluster at once
+	// Starts beyond the cap are deferred until an active experiment finishes; 0 disables the cap
+	MaxConcurrentExperiments int32
 }
 
 // +kubebuilder:rbac:groups=fis.fis.dksshddl.dev,resources=experiments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=fis.fis.dksshddl.dev,resources=experiments/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=fis.fis.dksshddl.dev,resources=experiments/finalizers,verbs=update
 // +kubebuilder:rbac:groups=fis.fis.dksshddl.dev,resources=experimenttemplates,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get
 
 // Reconcile is part of the main kubernetes reconciliation loop
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -84,9 +144,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	}
 
 	// Check if suspended
+	// Un-suspending is a spec change, so it triggers a reconcile without requeueing here
 	if experiment.Spec.Suspend != nil && *experiment.Spec.Suspend {
 		log.Info("Experiment is suspended, skipping")
-		return ctrl.Result{}, nil
+		return ctrl.Result{}, r.setSuspended(ctx, experiment, log)
+	}
+	if err := r.clearSuspended(ctx, experiment, log); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// A stop request aborts the current run and keeps new ones from starting
+	if experiment.Spec.Stop != nil && *experiment.Spec.Stop {
+		return r.handleStop(ctx, experiment, log)
 	}
 
 	// Resolve template ID
@@ -95,6 +164,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		log.Error(err, "Failed to resolve template ID")
 		experiment.Status.State = "failed"
 		experiment.Status.Reason = fmt.Sprintf("Failed to resolve template ID: %v", err)
+		setStateConditions(experiment)
 		if updateErr := r.Status().Update(ctx, experiment); updateErr != nil {
 			log.Error(updateErr, "Failed to update status")
 		}
@@ -112,6 +182,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	// Handle scheduled vs one-time experiments
 	if experiment.Spec.Schedule != "" {
+		if errs := r.validator().Validate(ctx, experiment); len(errs) > 0 {
+			return r.setValidationFailed(ctx, experiment, errs.ToAggregate().Error(), log)
+		}
 		return r.handleScheduledExperiment(ctx, experiment, log)
 	}
 
@@ -151,7 +224,7 @@ func (r *Reconciler) resolveTemplateID(ctx context.Context, experiment *fisv1alp
 func (r *Reconciler) handleOneTimeExperiment(ctx context.Context, experiment *fisv1alpha1.Experiment, log logr.Logger) (ctrl.Result, error) {
 	// If experiment hasn't been started yet, start it
 	if experiment.Status.ExperimentID == "" {
-		return r.startExperiment(ctx, experiment, log)
+		return r.startExperiment(ctx, experiment, "", log)
 	}
 
 	// If experiment is already started, sync its state
@@ -161,38 +234,82 @@ func (r *Reconciler) handleOneTimeExperiment(ctx context.Context, experiment *fi
 // handleScheduledExperiment handles scheduled experiment execution (CronJob mode)
 func (r *Reconciler) handleScheduledExperiment(ctx context.Context, experiment *fisv1alpha1.Experiment, log logr.Logger) (ctrl.Result, error) {
 	// Parse cron schedule
-	schedule, err := cron.ParseStandard(experiment.Spec.Schedule)
+	schedule, err := scheduleParser.Parse(experiment.Spec.Schedule)
 	if err != nil {
 		log.Error(err, "Invalid cron schedule", "schedule", experiment.Spec.Schedule)
 		experiment.Status.State = "failed"
 		experiment.Status.Reason = fmt.Sprintf("Invalid cron schedule: %v", err)
+		setStateConditions(experiment)
 		if updateErr := r.Status().Update(ctx, experiment); updateErr != nil {
 			log.Error(updateErr, "Failed to update status")
 		}
 		return ctrl.Result{}, err
 	}
 
+	// Evaluate the schedule in the requested time zone
+	if experiment.Spec.TimeZone != "" {
+		location, err := time.LoadLocation(experiment.Spec.TimeZone)
+		if err != nil {
+			log.Error(err, "Invalid time zone", "timeZone", experiment.Spec.TimeZone)
+			experiment.Status.State = "failed"
+			experiment.Status.Reason = fmt.Sprintf("Invalid time zone %q: %v", experiment.Spec.TimeZone, err)
+			setStateConditions(experiment)
+			if updateErr := r.Status().Update(ctx, experiment); updateErr != nil {
+				log.Error(updateErr, "Failed to update status")
+			}
+			return ctrl.Result{}, err
+		}
+		schedule = zonedSchedule{Schedule: schedule, location: location}
+	}
+
 	now := time.Now()
 
 	// Determine if we should run now based on LastScheduleTime
 	shouldRun := false
 	var missedRun *time.Time
 
-	if experiment.Status.LastScheduleTime == nil {
-		// Never run before - check if there's a schedule time that has passed
-		// For first run, we start from creation time
-		creationTime := experiment.CreationTimestamp.Time
-		nextAfterCreation := schedule.Next(creationTime)
-		if !nextAfterCreation.After(now) {
-			shouldRun = true
-			missedRun = &nextAfterCreation
-		}
-	} else {
-		// Check if we missed any scheduled runs since last execution
-		nextAfterLast := schedule.Next(experiment.Status.LastScheduleTime.Time)
-		if !nextAfterLast.After(now) {
-			shouldRun = true
-			missedRun = &nextAfterLast
+	// For the first run, we start from creation time
+	reference := experiment.CreationTimestamp.Time
+	if experiment.Status.LastScheduleTime != nil {
+		reference = experiment.Status.LastScheduleTime.Time
+	}
+	// Runs missed while suspended are not caught up after resuming
+	if resumed := resumeTime(experiment); resumed.After(reference) {
+		reference = resumed
+	}
+
+	// Check if we missed any scheduled runs since the reference time
+	nextAfterReference := schedule.Next(reference)
+	if !nextAfterReference.After(now) {
+		shouldRun = true
+		missedRun = &nextAfterReference
+	}
+
+	// Skip missed runs that are older than the starting deadline
+	skippedRun := false
+	var missedDeadlineMessage string
+	if shouldRun && experiment.Spec.StartingDeadlineSeconds != nil {
+		windowStart := now.Add(-time.Duration(*experiment.Spec.StartingDeadlineSeconds) * time.Second)
+		if missedRun.Before(windowStart) {
+			// A later run may still be inside the deadline
+			latest := schedule.Next(windowStart.Add(-time.Second))
+			if latest.After(now) {
+				log.Info("Skipping missed run past its starting deadline",
+					"missedRun", missedRun,
+					"startingDeadlineSeconds", *experiment.Spec.StartingDeadlineSeconds)
+				experiment.Status.Reason = fmt.Sprintf("Skipped run scheduled at %s: missed its starting deadline of %ds",
+					missedRun.Format(time.RFC3339), *experiment.Spec.StartingDeadlineSeconds)
+				// The skipped run stays due until the next one, only report it the first time
+				missed := metav1.NewTime(*missedRun)
+				if experiment.Status.LastMissedScheduleTime == nil || !experiment.Status.LastMissedScheduleTime.Equal(&missed) {
+					experiment.Status.LastMissedScheduleTime = &missed
+					missedDeadlineMessage = experiment.Status.Reason
+				}
+				shouldRun = false
+				skippedRun = true
+			} else {
+				missedRun = &latest
+			}
 		}
 	}
 
@@ -200,7 +317,7 @@ func (r *Reconciler) handleScheduledExperiment(ctx context.Context, experiment *
 	var nextScheduleTime time.Time
 	if shouldRun && missedRun != nil {
 		nextScheduleTime = schedule.Next(*missedRun)
-	} else if experiment.Status.LastScheduleTime != nil {
+	} else if experiment.Status.LastScheduleTime != nil && !skippedRun {
 		nextScheduleTime = schedule.Next(experiment.Status.LastScheduleTime.Time)
 		if !nextScheduleTime.After(now) {
 			nextScheduleTime = schedule.Next(now)
@@ -211,12 +328,17 @@ func (r *Reconciler) handleScheduledExperiment(ctx context.Context, experiment *
 
 	// Update next schedule time in status (don't return, continue processing)
 	nextScheduleTimeMeta := metav1.NewTime(nextScheduleTime)
-	statusChanged := false
+	statusChanged := skippedRun
 	if experiment.Status.NextScheduleTime == nil || !experiment.Status.NextScheduleTime.Equal(&nextScheduleTimeMeta) {
 		experiment.Status.NextScheduleTime = &nextScheduleTimeMeta
 		statusChanged = true
 	}
 
+	// A new trigger token runs the experiment now, independent of the schedule
+	if token := experiment.Annotations[triggerAnnotation]; token != "" && token != experiment.Status.LastTriggerToken {
+		return r.triggerScheduledExperiment(ctx, experiment, token, nextScheduleTimeMeta, log)
+	}
+
 	if !shouldRun {
 		// Not time yet, update status if needed and requeue
 		if statusChanged {
@@ -225,20 +347,54 @@ func (r *Reconciler) handleScheduledExperiment(ctx context.Context, experiment *
 				return ctrl.Result{}, err
 			}
 		}
+		if missedDeadlineMessage != "" {
+			r.recordEvent(experiment, corev1.EventTypeWarning, fisv1alpha1.ReasonMissedStartingDeadline, missedDeadlineMessage)
+		}
 		requeueAfter := nextScheduleTime.Sub(now)
 		log.Info("Experiment scheduled", "nextRun", nextScheduleTime, "requeueAfter", requeueAfter)
 		return ctrl.Result{RequeueAfter: requeueAfter}, nil
 	}
 
+	// Apply the concurrency policy when the previous run may still be active
+	if experiment.Status.Active > 0 && experiment.Status.ExperimentID != "" {
+		result, proceed, err := r.applyConcurrencyPolicy(ctx, experiment, log)
+		if err != nil {
+			return result, err
+		}
+		if !proceed {
+			// Like a CronJob with Forbid, the run is skipped rather than started late
+			log.Info("Previous run is still active, skipping scheduled run",
+				"experimentID", experiment.Status.ExperimentID,
+				"missedRun", missedRun)
+			experiment.Status.Reason = fmt.Sprintf("Skipped run scheduled at %s: previous run %s is still active",
+				missedRun.Format(time.RFC3339), experiment.Status.ExperimentID)
+			skipped := metav1.NewTime(*missedRun)
+			experiment.Status.LastScheduleTime = &skipped
+			experiment.Status.NextScheduleTime = &nextScheduleTimeMeta
+			if err := r.Status().Update(ctx, experiment); err != nil {
+				log.Error(err, "Failed to record skipped run")
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: nextScheduleTime.Sub(now)}, nil
+		}
+	}
+
 	// Time to run the experiment
 	log.Info("Starting scheduled experiment", "schedule", experiment.Spec.Schedule, "missedRun", missedRun)
 
 	// Start the experiment
-	result, err := r.startExperiment(ctx, experiment, log)
-	if err != nil {
+	// A non-zero result without error means the start was deferred (e.g. throttled)
+	runKey := scheduleRunKeyPrefix + missedRun.UTC().Format(time.RFC3339)
+	result, err := r.startExperiment(ctx, experiment, runKey, log)
+	if err != nil || !result.IsZero() {
 		return result, err
 	}
 
+	// Clean up old experiments based on history limits and record the run history
+	if err := r.cleanupExperimentHistory(ctx, experiment, log); err != nil {
+		log.Error(err, "Failed to cleanup experiment history")
+	}
+
 	// Update last schedule time
 	lastScheduleTime := metav1.Now()
 	experiment.Status.LastScheduleTime = &lastScheduleTime
@@ -252,45 +408,242 @@ func (r *Reconciler) handleScheduledExperiment(ctx context.Context, experiment *
 	requeueAfter := nextScheduleTime.Sub(now)
 	log.Info("Scheduled experiment started, waiting for next schedule", "nextRun", nextScheduleTime)
 
-	// Clean up old experiments based on history limits
-	if err := r.cleanupExperimentHistory(ctx, experiment, log); err != nil {
-		log.Error(err, "Failed to cleanup experiment history")
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+}
+
+// triggerScheduledExperiment starts a scheduled experiment on demand and records the trigger token
+// The schedule itself is unaffected, LastScheduleTime only tracks scheduled runs
+func (r *Reconciler) triggerScheduledExperiment(ctx context.Context, experiment *fisv1alpha1.Experiment, token string, nextScheduleTime metav1.Time, log logr.Logger) (ctrl.Result, error) {
+	if experiment.Status.Active > 0 && experiment.Status.ExperimentID != "" {
+		result, proceed, err := r.applyConcurrencyPolicy(ctx, experiment, log)
+		if err != nil {
+			return result, err
+		}
+		if !proceed {
+			// A trigger is not tied to a schedule slot, so it waits for the previous run instead of being dropped
+			log.Info("Previous run is still active, waiting to start triggered run",
+				"experimentID", experiment.Status.ExperimentID, "token", token)
+			if err := r.Status().Update(ctx, experiment); err != nil {
+				log.Error(err, "Failed to update status")
+				return ctrl.Result{}, err
+			}
+			return ctrl.Result{RequeueAfter: activeRunPollInterval}, nil
+		}
 	}
 
-	return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	log.Info("Trigger annotation changed, starting experiment now", "token", token)
+	result, err := r.startExperiment(ctx, experiment, "trigger/"+token, log)
+	if err != nil || !result.IsZero() {
+		return result, err
+	}
+
+	experiment.Status.LastTriggerToken = token
+	experiment.Status.NextScheduleTime = &nextScheduleTime
+	if err := r.Status().Update(ctx, experiment); err != nil {
+		log.Error(err, "Failed to record trigger token")
+		return ctrl.Result{}, err
+	}
+
+	// A scheduled run that was already due is picked up on the next reconcile
+	return ctrl.Result{RequeueAfter: max(time.Until(nextScheduleTime.Time), time.Second)}, nil
+}
+
+// zonedSchedule evaluates a cron schedule in a fixed time zone regardless of the input time's location
+type zonedSchedule struct {
+	cron.Schedule
+	location *time.Location
+}
+
+// Next returns the next activation time after t, in the schedule's time zone
+func (s zonedSchedule) Next(t time.Time) time.Time {
+	return s.Schedule.Next(t.In(s.location))
+}
+
+// applyConcurrencyPolicy decides whether a due run may start while the previous run is active
+// It returns proceed=false when the Forbid policy keeps the run from starting; the caller decides
+// whether to skip or wait, and the returned result only applies together with an error
+func (r *Reconciler) applyConcurrencyPolicy(ctx context.Context, experiment *fisv1alpha1.Experiment, log logr.Logger) (ctrl.Result, bool, error) {
+	policy := experiment.Spec.ConcurrencyPolicy
+	if policy == "" || policy == fisv1alpha1.AllowConcurrent {
+		return ctrl.Result{}, true, nil
+	}
+
+	// Active is only set when a run starts, so confirm with AWS that it is still going
+	active, err := r.refreshActiveRun(ctx, experiment)
+	if err != nil {
+		log.Error(err, "Failed to check the previous run")
+		return ctrl.Result{RequeueAfter: activeRunPollInterval}, false, err
+	}
+	if !active {
+		return ctrl.Result{}, true, nil
+	}
+
+	switch policy {
+	case fisv1alpha1.ForbidConcurrent:
+		return ctrl.Result{}, false, nil
+	case fisv1alpha1.ReplaceConcurrent:
+		log.Info("Stopping previous run to replace it",
+			"experimentID", experiment.Status.ExperimentID,
+			"concurrencyPolicy", policy)
+		if err := r.FISClient.StopExperiment(ctx, experiment.Status.ExperimentID); err != nil {
+			log.Error(err, "Failed to stop previous run")
+			return ctrl.Result{}, false, err
+		}
+	}
+
+	return ctrl.Result{}, true, nil
+}
+
+// refreshActiveRun syncs the last started run from AWS and reports whether it is still active
+func (r *Reconciler) refreshActiveRun(ctx context.Context, experiment *fisv1alpha1.Experiment) (bool, error) {
+	awsExperiment, err := r.FISClient.GetExperiment(ctx, experiment.Status.ExperimentID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get experiment %s: %w", experiment.Status.ExperimentID, err)
+	}
+
+	experiment.Status.State = string(awsExperiment.State.Status)
+	switch awsExperiment.State.Status {
+	case fistypes.ExperimentStatusCompleted, fistypes.ExperimentStatusStopped, fistypes.ExperimentStatusFailed, fistypes.ExperimentStatusCancelled:
+		experiment.Status.Active = 0
+		if awsExperiment.EndTime != nil {
+			endTime := metav1.NewTime(*awsExperiment.EndTime)
+			experiment.Status.EndTime = &endTime
+		}
+		return false, nil
+	default:
+		return true, nil
+	}
+}
+
+// handleStop stops the current run when it is still active and then tracks it until it has stopped
+// Stopping an experiment that never started or already finished is a no-op
+func (r *Reconciler) handleStop(ctx context.Context, experiment *fisv1alpha1.Experiment, log logr.Logger) (ctrl.Result, error) {
+	if experiment.Status.ExperimentID == "" || isTerminalState(experiment.Status.State) {
+		log.Info("Stop requested, no active experiment to stop")
+		return ctrl.Result{}, nil
+	}
+	if experiment.Status.State == "stopping" {
+		return r.syncExperimentState(ctx, experiment, log)
+	}
+
+	// The recorded state may be stale, only stop runs that AWS still reports as active
+	active, err := r.refreshActiveRun(ctx, experiment)
+	if err != nil {
+		log.Error(err, "Failed to check the experiment before stopping it")
+		return ctrl.Result{}, err
+	}
+	if active && experiment.Status.State != "stopping" {
+		log.Info("Stopping experiment on request", "experimentID", experiment.Status.ExperimentID)
+		if err := r.FISClient.StopExperiment(ctx, experiment.Status.ExperimentID); err != nil {
+			log.Error(err, "Failed to stop experiment")
+			return ctrl.Result{}, err
+		}
+		experiment.Status.State = "stopping"
+		experiment.Status.Reason = "Stop requested via spec.stop"
+		r.recordEvent(experiment, corev1.EventTypeNormal, fisv1alpha1.ReasonStopRequested, "Experiment stop requested")
+	}
+	setStateConditions(experiment)
+
+	if err := r.Status().Update(ctx, experiment); err != nil {
+		log.Error(err, "Failed to update status")
+		return ctrl.Result{}, err
+	}
+	if !active {
+		log.Info("Stop requested, experiment already finished", "state", experiment.Status.State)
+		return ctrl.Result{}, nil
+	}
+
+	return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
 }
 
 // startExperiment starts a new AWS FIS experiment
-func (r *Reconciler) startExperiment(ctx context.Context, experiment *fisv1alpha1.Experiment, log logr.Logger) (ctrl.Result, error) {
+// runKey identifies the logical run (e.g. its scheduled time), so retrying a start reuses its client token
+func (r *Reconciler) startExperiment(ctx context.Context, experiment *fisv1alpha1.Experiment, runKey string, log logr.Logger) (ctrl.Result, error) {
 	log.Info("Starting AWS FIS Experiment", "templateID", experiment.Status.TemplateID)
 
+	// A freeze defers the start, it is retried once the freeze is lifted
+	reason, frozen, err := r.freezeReason(ctx)
+	if err != nil {
+		log.Error(err, "Failed to check for a freeze")
+		return ctrl.Result{}, err
+	}
+	if frozen {
+		return r.setFrozen(ctx, experiment, reason, log)
+	}
+
+	// The template may cap how many of its experiments run at the same time
+	active, limit, err := r.templateConcurrency(ctx, experiment)
+	if err != nil {
+		log.Error(err, "Failed to check the template's concurrency limit")
+		return ctrl.Result{}, err
+	}
+	if limit > 0 && active >= limit {
+		return r.setConcurrencyLimited(ctx, experiment, active, limit, log)
+	}
+
+	// The controller may cap how many experiments run at the same time across the cluster
+	if r.MaxConcurrentExperiments > 0 {
+		active, err := r.activeExperiments(ctx)
+		if err != nil {
+			log.Error(err, "Failed to check the global concurrency limit")
+			return ctrl.Result{}, err
+		}
+		if active >= r.MaxConcurrentExperiments {
+			return r.setGlobalConcurrencyLimited(ctx, experiment, active, log)
+		}
+	}
+
+	templateTags, err := r.templateTags(ctx, experiment)
+	if err != nil {
+		log.Error(err, "Failed to get the template's tags")
+		return ctrl.Result{}, err
+	}
+
 	// Start the experiment
-	experimentID, err := r.FISClient.StartExperiment(ctx, experiment)
+	experimentID, err := r.FISClient.StartExperiment(ctx, experiment, templateTags, runClientToken(experiment, runKey), r.Identity)
 	if err != nil {
 		log.Error(err, "Failed to start AWS FIS Experiment")
+		if awsfis.IsRetryableFISError(err) {
+			return r.setThrottled(ctx, experiment, err, log)
+		}
 		// Update status with error
 		experiment.Status.State = "failed"
 		experiment.Status.Reason = err.Error()
+		setStateConditions(experiment)
 		if updateErr := r.Status().Update(ctx, experiment); updateErr != nil {
 			log.Error(updateErr, "Failed to update status")
 		}
+		r.recordEvent(experiment, corev1.EventTypeWarning, fisv1alpha1.ReasonFailed,
+			fmt.Sprintf("Failed to start experiment from template %s: %v", experiment.Status.TemplateID, err))
+		// Permanent validation errors will not succeed on retry
+		if awsfis.IsFISValidationError(err) {
+			return ctrl.Result{}, nil
+		}
 		return ctrl.Result{}, err
 	}
 
 	log.Info("Successfully started AWS FIS Experiment", "experimentID", experimentID)
 
 	// Update status
+	clearThrottled(experiment)
+	r.clearFrozen(experiment)
 	experiment.Status.ExperimentID = experimentID
+	experiment.Status.ConsoleURL = awsfis.ExperimentConsoleURL(r.FISClient.GetAWSConfig().Region, experimentID)
 	experiment.Status.State = "initiating"
 	experiment.Status.Reason = "Experiment is initiating"
 	now := metav1.Now()
 	experiment.Status.StartTime = &now
+	experiment.Status.StartedBy = r.Identity
+	experiment.Status.LastRunOrigin = runOrigin(runKey)
 	experiment.Status.Active = 1
+	setStateConditions(experiment)
 
 	if err := r.Status().Update(ctx, experiment); err != nil {
 		log.Error(err, "Failed to update status")
 		return ctrl.Result{}, err
 	}
+	r.recordEvent(experiment, corev1.EventTypeNormal, fisv1alpha1.ReasonStarted,
+		fmt.Sprintf("Started AWS FIS experiment %s from template %s", experimentID, experiment.Status.TemplateID))
 
 	// For one-time experiments, requeue to check status
 	// For scheduled experiments, this will be handled by the schedule
@@ -301,6 +654,163 @@ func (r *Reconciler) startExperiment(ctx context.Context, experiment *fisv1alpha
 	return ctrl.Result{}, nil
 }
 
+// templateConcurrency returns the number of active experiments of the experiment's template and its
+// MaxConcurrentExperiments limit; limit is 0 when the template sets none or is not managed by an ExperimentTemplate
+func (r *Reconciler) templateConcurrency(ctx context.Context, experiment *fisv1alpha1.Experiment) (active, limit int32, err error) {
+	template, err := r.templateForExperiment(ctx, experiment)
+	if err != nil || template == nil || template.Spec.MaxConcurrentExperiments == nil {
+		return 0, 0, err
+	}
+
+	experiments, err := r.FISClient.ListExperimentsByTemplate(ctx, experiment.Status.TemplateID)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, exp := range experiments {
+		if isActiveState(exp.State) {
+			active++
+		}
+	}
+	return active, *template.Spec.MaxConcurrentExperiments, nil
+}
+
+// templateTags returns the tags of the ExperimentTemplate an experiment runs, or nil when it is not managed in the cluster
+func (r *Reconciler) templateTags(ctx context.Context, experiment *fisv1alpha1.Experiment) ([]fisv1alpha1.Tag, error) {
+	template, err := r.templateForExperiment(ctx, experiment)
+	if err != nil || template == nil {
+		return nil, err
+	}
+	return template.Spec.Tags, nil
+}
+
+// templateForExperiment returns the ExperimentTemplate an experiment runs, looked up by name or by its AWS template ID
+// It returns nil when an ID reference does not belong to any ExperimentTemplate
+func (r *Reconciler) templateForExperiment(ctx context.Context, experiment *fisv1alpha1.Experiment) (*fisv1alpha1.ExperimentTemplate, error) {
+	if name := experiment.Spec.ExperimentTemplate.Name; name != "" {
+		template := &fisv1alpha1.ExperimentTemplate{}
+		if err := r.Get(ctx, types.NamespacedName{Name: name}, template); err != nil {
+			return nil, fmt.Errorf("failed to get ExperimentTemplate %s: %w", name, err)
+		}
+		return template, nil
+	}
+
+	templates := &fisv1alpha1.ExperimentTemplateList{}
+	if err := r.List(ctx, templates); err != nil {
+		return nil, fmt.Errorf("failed to list ExperimentTemplates: %w", err)
+	}
+	for i := range templates.Items {
+		if templates.Items[i].Status.TemplateID == experiment.Status.TemplateID {
+			return &templates.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// activeExperiments counts the Experiments across the cluster whose latest run is active
+// Scheduled Experiments do not sync their state between runs, so a stored active state is confirmed with AWS
+func (r *Reconciler) activeExperiments(ctx context.Context) (int32, error) {
+	experiments := &fisv1alpha1.ExperimentList{}
+	if err := r.List(ctx, experiments); err != nil {
+		return 0, fmt.Errorf("failed to list Experiments: %w", err)
+	}
+
+	var active int32
+	for i := range experiments.Items {
+		exp := &experiments.Items[i]
+		if !isActiveState(exp.Status.State) {
+			continue
+		}
+		if exp.Status.ExperimentID != "" {
+			running, err := r.refreshActiveRun(ctx, exp)
+			if err != nil {
+				return 0, err
+			}
+			if !running {
+				continue
+			}
+		}
+		active++
+	}
+	return active, nil
+}
+
+// setGlobalConcurrencyLimited records that the start waits for the controller's concurrency limit and requeues
+// The event is only emitted when the experiment starts waiting
+func (r *Reconciler) setGlobalConcurrencyLimited(ctx context.Context, experiment *fisv1alpha1.Experiment, active int32, log logr.Logger) (ctrl.Result, error) {
+	const waitingPrefix = "Waiting for a running experiment in the cluster to finish"
+	message := fmt.Sprintf("%s: %d of %d allowed are active", waitingPrefix, active, r.MaxConcurrentExperiments)
+	waiting := strings.HasPrefix(experiment.Status.Reason, waitingPrefix)
+
+	experiment.Status.Reason = message
+	if err := r.Status().Update(ctx, experiment); err != nil {
+		log.Error(err, "Failed to update status")
+		return ctrl.Result{}, err
+	}
+	if !waiting {
+		r.recordEvent(experiment, corev1.EventTypeNormal, fisv1alpha1.ReasonConcurrencyLimited, message)
+	}
+
+	log.Info("Experiment start deferred by the global concurrency limit", "active", active, "limit", r.MaxConcurrentExperiments, "requeueAfter", activeRunPollInterval)
+	return ctrl.Result{RequeueAfter: activeRunPollInterval}, nil
+}
+
+// setConcurrencyLimited records that the start waits for the template's concurrency limit and requeues
+// The event is only emitted when the experiment starts waiting
+func (r *Reconciler) setConcurrencyLimited(ctx context.Context, experiment *fisv1alpha1.Experiment, active, limit int32, log logr.Logger) (ctrl.Result, error) {
+	message := fmt.Sprintf("Waiting for a running experiment of template %s to finish: %d of %d allowed are active",
+		experiment.Status.TemplateID, active, limit)
+	waiting := strings.HasPrefix(experiment.Status.Reason, "Waiting for a running experiment of template")
+
+	experiment.Status.Reason = message
+	if err := r.Status().Update(ctx, experiment); err != nil {
+		log.Error(err, "Failed to update status")
+		return ctrl.Result{}, err
+	}
+	if !waiting {
+		r.recordEvent(experiment, corev1.EventTypeNormal, fisv1alpha1.ReasonConcurrencyLimited, message)
+	}
+
+	log.Info("Experiment start deferred by the template's concurrency limit", "active", active, "limit", limit, "requeueAfter", activeRunPollInterval)
+	return ctrl.Result{RequeueAfter: activeRunPollInterval}, nil
+}
+
+// isActiveState reports whether an experiment in the given state is still running or about to
+func isActiveState(state string) bool {
+	switch state {
+	case "initiating", "pending", "running", "stopping":
+		return true
+	default:
+		return false
+	}
+}
+
+// scheduleRunKeyPrefix starts the run key of runs started by the schedule
+const scheduleRunKeyPrefix = "schedule/"
+
+// runOrigin returns what started the run with the given run key
+func runOrigin(runKey string) fisv1alpha1.RunOrigin {
+	if strings.HasPrefix(runKey, scheduleRunKeyPrefix) {
+		return fisv1alpha1.RunOriginScheduled
+	}
+	return fisv1alpha1.RunOriginManual
+}
+
+// runClientToken derives the StartExperiment client token of a logical run
+// A one-time experiment uses spec.clientToken as is when set; every other run gets a stable
+// hash of the experiment and run key, which fits the 64 character limit of AWS FIS
+func runClientToken(experiment *fisv1alpha1.Experiment, runKey string) string {
+	if runKey == "" && experiment.Spec.ClientToken != "" {
+		return experiment.Spec.ClientToken
+	}
+
+	base := experiment.Spec.ClientToken
+	if base == "" {
+		base = string(experiment.UID) + "/" + experiment.Name
+	}
+	sum := sha256.Sum256([]byte(base + "/" + runKey))
+	return hex.EncodeToString(sum[:])
+}
+
 // syncExperimentState syncs the experiment state from AWS
 func (r *Reconciler) syncExperimentState(ctx context.Context, experiment *fisv1alpha1.Experiment, log logr.Logger) (ctrl.Result, error) {
 	log.Info("Syncing experiment state", "experimentID", experiment.Status.ExperimentID)
@@ -319,14 +829,37 @@ func (r *Reconciler) syncExperimentState(ctx context.Context, experiment *fisv1a
 		experiment.Status.Reason = *awsExperiment.State.Reason
 	}
 
+	// Experiments started before the console link was recorded get it on their next sync
+	if experiment.Status.ConsoleURL == "" {
+		experiment.Status.ConsoleURL = awsfis.ExperimentConsoleURL(r.FISClient.GetAWSConfig().Region, experiment.Status.ExperimentID)
+	}
+
 	// Update timestamps
+	now := metav1.Now()
+	experiment.Status.LastUpdateTime = &now
 	if awsExperiment.StartTime != nil && experiment.Status.StartTime == nil {
 		startTime := metav1.NewTime(*awsExperiment.StartTime)
 		experiment.Status.StartTime = &startTime
 	}
-	if awsExperiment.EndTime != nil {
+	terminal := isTerminalState(experiment.Status.State)
+	awaitingEndTime := false
+	switch {
+	case awsExperiment.EndTime != nil:
 		endTime := metav1.NewTime(*awsExperiment.EndTime)
 		experiment.Status.EndTime = &endTime
+		experiment.Status.EndTimePolls = 0
+	case terminal && experiment.Status.EndTime == nil:
+		// FIS can report a terminal state a poll or two before its end time
+		experiment.Status.EndTimePolls++
+		if experiment.Status.EndTimePolls < maxEndTimePolls {
+			awaitingEndTime = true
+		} else {
+			log.Info("AWS FIS reported no end time for terminal experiment, using the last sync time",
+				"state", experiment.Status.State, "polls", experiment.Status.EndTimePolls)
+			experiment.Status.EndTime = &now
+		}
+	}
+	if terminal || awsExperiment.EndTime != nil {
 		experiment.Status.Active = 0
 	} else {
 		experiment.Status.Active = 1
@@ -337,6 +870,39 @@ func (r *Reconciler) syncExperimentState(ctx context.Context, experiment *fisv1a
 		experiment.Status.TargetAccountConfigurationsCount = *awsExperiment.TargetAccountConfigurationsCount
 	}
 
+	// Targets are resolved once the experiment leaves the initiating/pending states
+	if len(experiment.Status.TargetResolution) == 0 && targetsResolved(experiment.Status.State) {
+		counts, err := r.FISClient.CountResolvedTargets(ctx, experiment.Status.ExperimentID)
+		if err != nil {
+			log.Error(err, "Failed to get resolved targets")
+		} else {
+			experiment.Status.TargetResolution = buildTargetResolution(awsExperiment.Targets, counts)
+		}
+	}
+
+	// The resources FIS acted on are final once the experiment ends
+	if terminal && experiment.Status.ResolvedTargetArns == nil {
+		arns, truncated, err := r.FISClient.ListResolvedTargetArns(ctx, experiment.Status.ExperimentID, maxResolvedTargetArns)
+		if err != nil {
+			log.Error(err, "Failed to get resolved target ARNs")
+		} else {
+			experiment.Status.ResolvedTargetArns = arns
+			experiment.Status.ResolvedTargetArnsTruncated = truncated
+		}
+	}
+
+	// Tags added to the spec after the start, e.g. for cost allocation, are applied while the experiment runs
+	if !terminal {
+		if templateTags, err := r.templateTags(ctx, experiment); err != nil {
+			log.Error(err, "Failed to get the template's tags, not syncing experiment tags")
+		} else if err := r.FISClient.SyncExperimentTags(ctx, experiment, templateTags, awsExperiment); err != nil {
+			log.Error(err, "Failed to sync experiment tags")
+		}
+	}
+
+	experiment.Status.Actions = buildActionStatus(awsExperiment.Actions)
+	setStateConditions(experiment)
+
 	if err := r.Status().Update(ctx, experiment); err != nil {
 		log.Error(err, "Failed to update status")
 		return ctrl.Result{}, err
@@ -348,6 +914,7 @@ func (r *Reconciler) syncExperimentState(ctx context.Context, experiment *fisv1a
 			"previousState", previousState,
 			"newState", experiment.Status.State,
 			"reason", experiment.Status.Reason)
+		r.recordStateTransition(experiment)
 	}
 
 	// Determine requeue behavior based on state
@@ -356,6 +923,9 @@ func (r *Reconciler) syncExperimentState(ctx context.Context, experiment *fisv1a
 		// Still in progress, check again soon
 		return ctrl.Result{RequeueAfter: 10 * time.Second}, nil
 	case "completed", "stopped", "failed":
+		if awaitingEndTime {
+			return ctrl.Result{RequeueAfter: endTimePollInterval}, nil
+		}
 		// Terminal state, no need to requeue
 		log.Info("Experiment reached terminal state", "state", experiment.Status.State)
 		return ctrl.Result{}, nil
@@ -365,6 +935,115 @@ func (r *Reconciler) syncExperimentState(ctx context.Context, experiment *fisv1a
 	}
 }
 
+// isTerminalState reports whether an experiment in the given state has finished
+func isTerminalState(state string) bool {
+	switch state {
+	case "completed", "stopped", "failed":
+		return true
+	default:
+		return false
+	}
+}
+
+// setStateConditions maps the recorded FIS state onto the Running, Succeeded and Failed conditions
+// Stopped and cancelled runs leave all three False
+func setStateConditions(experiment *fisv1alpha1.Experiment) {
+	state := experiment.Status.State
+	if state == "" {
+		return
+	}
+	reason := strings.ToUpper(state[:1]) + state[1:]
+	message := experiment.Status.Reason
+	if message == "" {
+		message = fmt.Sprintf("AWS FIS experiment is %s", state)
+	}
+
+	statuses := map[string]metav1.ConditionStatus{
+		fisv1alpha1.ConditionRunning:   metav1.ConditionFalse,
+		fisv1alpha1.ConditionSucceeded: metav1.ConditionFalse,
+		fisv1alpha1.ConditionFailed:    metav1.ConditionFalse,
+	}
+	switch state {
+	case "initiating", "pending", "running", "stopping":
+		statuses[fisv1alpha1.ConditionRunning] = metav1.ConditionTrue
+	case "completed":
+		statuses[fisv1alpha1.ConditionSucceeded] = metav1.ConditionTrue
+	case "failed":
+		statuses[fisv1alpha1.ConditionFailed] = metav1.ConditionTrue
+	}
+	for _, condType := range []string{fisv1alpha1.ConditionRunning, fisv1alpha1.ConditionSucceeded, fisv1alpha1.ConditionFailed} {
+		meta.SetStatusCondition(&experiment.Status.Conditions, metav1.Condition{
+			Type:               condType,
+			Status:             statuses[condType],
+			ObservedGeneration: experiment.Generation,
+			Reason:             reason,
+			Message:            message,
+		})
+	}
+}
+
+// targetsResolved reports whether FIS has resolved the targets of an experiment in the given state
+func targetsResolved(state string) bool {
+	switch state {
+	case "", "initiating", "pending":
+		return false
+	default:
+		return true
+	}
+}
+
+// buildTargetResolution maps the experiment targets to their resolved resource counts, sorted by name
+func buildTargetResolution(targets map[string]fistypes.ExperimentTarget, counts map[string]int32) []fisv1alpha1.TargetResolution {
+	names := make([]string, 0, len(targets))
+	for name := range targets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	resolution := make([]fisv1alpha1.TargetResolution, 0, len(names))
+	for _, name := range names {
+		resolution = append(resolution, fisv1alpha1.TargetResolution{
+			Name:          name,
+			ResolvedCount: counts[name],
+			Skipped:       counts[name] == 0,
+		})
+	}
+	return resolution
+}
+
+// buildActionStatus maps the experiment actions to their reported states, sorted by name
+func buildActionStatus(actions map[string]fistypes.ExperimentAction) []fisv1alpha1.ActionStatus {
+	if len(actions) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(actions))
+	for name := range actions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	statuses := make([]fisv1alpha1.ActionStatus, 0, len(names))
+	for _, name := range names {
+		action := actions[name]
+		status := fisv1alpha1.ActionStatus{Name: name}
+		if action.State != nil {
+			status.State = string(action.State.Status)
+			status.Reason = aws.ToString(action.State.Reason)
+		}
+		if action.StartTime != nil {
+			startTime := metav1.NewTime(*action.StartTime)
+			status.StartTime = &startTime
+		}
+		if action.EndTime != nil {
+			endTime := metav1.NewTime(*action.EndTime)
+			status.EndTime = &endTime
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
 // handleDeletion handles the deletion of an Experiment
 func (r *Reconciler) handleDeletion(ctx context.Context, experiment *fisv1alpha1.Experiment, log logr.Logger) (ctrl.Result, error) {
 	log.Info("Handling Experiment deletion", "experimentID", experiment.Status.ExperimentID)
@@ -393,10 +1072,226 @@ func (r *Reconciler) handleDeletion(ctx context.Context, experiment *fisv1alpha1
 	return ctrl.Result{}, nil
 }
 
+// setSuspended marks the experiment as suspended and records an event the first time
+func (r *Reconciler) setSuspended(ctx context.Context, experiment *fisv1alpha1.Experiment, log logr.Logger) error {
+	if meta.IsStatusConditionTrue(experiment.Status.Conditions, fisv1alpha1.ConditionSuspended) {
+		return nil
+	}
+
+	meta.SetStatusCondition(&experiment.Status.Conditions, metav1.Condition{
+		Type:               fisv1alpha1.ConditionSuspended,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: experiment.Generation,
+		Reason:             fisv1alpha1.ReasonSuspended,
+		Message:            "Scheduled runs are suspended",
+	})
+	if err := r.Status().Update(ctx, experiment); err != nil {
+		log.Error(err, "Failed to update status")
+		return err
+	}
+	r.recordEvent(experiment, corev1.EventTypeNormal, fisv1alpha1.ReasonSuspended, "Experiment suspended")
+	return nil
+}
+
+// clearSuspended marks a previously suspended experiment as resumed and records an event
+func (r *Reconciler) clearSuspended(ctx context.Context, experiment *fisv1alpha1.Experiment, log logr.Logger) error {
+	if !meta.IsStatusConditionTrue(experiment.Status.Conditions, fisv1alpha1.ConditionSuspended) {
+		return nil
+	}
+
+	meta.SetStatusCondition(&experiment.Status.Conditions, metav1.Condition{
+		Type:               fisv1alpha1.ConditionSuspended,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: experiment.Generation,
+		Reason:             fisv1alpha1.ReasonResumed,
+		Message:            "Scheduled runs resumed",
+	})
+	if err := r.Status().Update(ctx, experiment); err != nil {
+		log.Error(err, "Failed to update status")
+		return err
+	}
+	r.recordEvent(experiment, corev1.EventTypeNormal, fisv1alpha1.ReasonResumed, "Experiment resumed")
+	return nil
+}
+
+// resumeTime returns when the experiment was last resumed, or the zero time
+func resumeTime(experiment *fisv1alpha1.Experiment) time.Time {
+	cond := meta.FindStatusCondition(experiment.Status.Conditions, fisv1alpha1.ConditionSuspended)
+	if cond == nil || cond.Status != metav1.ConditionFalse {
+		return time.Time{}
+	}
+	return cond.LastTransitionTime.Time
+}
+
+// freezeReason reports whether experiment starts are frozen and why
+func (r *Reconciler) freezeReason(ctx context.Context) (string, bool, error) {
+	if r.ConfigMap.Name == "" {
+		return "", false, nil
+	}
+
+	reader := r.APIReader
+	if reader == nil {
+		reader = r.Client
+	}
+	configMap := &corev1.ConfigMap{}
+	if err := reader.Get(ctx, r.ConfigMap, configMap); err != nil {
+		if errors.IsNotFound(err) {
+			return "", false, nil
+		}
+		return "", false, fmt.Errorf("failed to get ConfigMap %s: %w", r.ConfigMap, err)
+	}
+
+	reason, frozen := configMap.Annotations[freezeReasonAnnotation]
+	if frozen && reason == "" {
+		reason = "no reason given"
+	}
+	return reason, frozen, nil
+}
+
+// setFrozen records that the start was deferred by a freeze and requeues until it is lifted
+// The event is only emitted when the freeze, or its reason, is new to this experiment
+func (r *Reconciler) setFrozen(ctx context.Context, experiment *fisv1alpha1.Experiment, reason string, log logr.Logger) (ctrl.Result, error) {
+	message := fmt.Sprintf("Experiment starts are frozen: %s", reason)
+	cond := meta.FindStatusCondition(experiment.Status.Conditions, fisv1alpha1.ConditionFrozen)
+	changed := cond == nil || cond.Status != metav1.ConditionTrue || cond.Message != message
+
+	meta.SetStatusCondition(&experiment.Status.Conditions, metav1.Condition{
+		Type:               fisv1alpha1.ConditionFrozen,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: experiment.Generation,
+		Reason:             fisv1alpha1.ReasonFrozen,
+		Message:            message,
+	})
+	experiment.Status.Reason = message
+	if err := r.Status().Update(ctx, experiment); err != nil {
+		log.Error(err, "Failed to update status")
+		return ctrl.Result{}, err
+	}
+	if changed {
+		r.recordEvent(experiment, corev1.EventTypeWarning, fisv1alpha1.ReasonFrozen, message)
+	}
+
+	log.Info("Experiment start deferred by freeze", "reason", reason, "requeueAfter", freezePollInterval)
+	return ctrl.Result{RequeueAfter: freezePollInterval}, nil
+}
+
+// clearFrozen marks a start that was deferred by a freeze as no longer frozen
+func (r *Reconciler) clearFrozen(experiment *fisv1alpha1.Experiment) {
+	if !meta.IsStatusConditionTrue(experiment.Status.Conditions, fisv1alpha1.ConditionFrozen) {
+		return
+	}
+	meta.SetStatusCondition(&experiment.Status.Conditions, metav1.Condition{
+		Type:               fisv1alpha1.ConditionFrozen,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: experiment.Generation,
+		Reason:             fisv1alpha1.ReasonUnfrozen,
+		Message:            "Freeze lifted, experiment started",
+	})
+	r.recordEvent(experiment, corev1.EventTypeNormal, fisv1alpha1.ReasonUnfrozen, "Freeze lifted, starting experiment")
+}
+
+// validator returns the configured Experiment validator or one reading templates through the client
+func (r *Reconciler) validator() *validation.ExperimentValidator {
+	if r.Validator != nil {
+		return r.Validator
+	}
+	return &validation.ExperimentValidator{Reader: r.Client}
+}
+
+// setValidationFailed marks an Experiment that violates a policy as failed until its spec or annotations change
+func (r *Reconciler) setValidationFailed(ctx context.Context, experiment *fisv1alpha1.Experiment, message string, log logr.Logger) (ctrl.Result, error) {
+	log.Info("Experiment rejected by policy", "reason", message)
+	experiment.Status.State = "failed"
+	experiment.Status.Reason = fmt.Sprintf("Invalid experiment: %s", message)
+	setStateConditions(experiment)
+	meta.SetStatusCondition(&experiment.Status.Conditions, metav1.Condition{
+		Type:               fisv1alpha1.ConditionFailed,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: experiment.Generation,
+		Reason:             fisv1alpha1.ReasonValidationFailed,
+		Message:            message,
+	})
+	if err := r.Status().Update(ctx, experiment); err != nil {
+		log.Error(err, "Failed to update status")
+		return ctrl.Result{}, err
+	}
+	r.recordEvent(experiment, corev1.EventTypeWarning, fisv1alpha1.ReasonValidationFailed, message)
+	return ctrl.Result{}, nil
+}
+
+// recordStateTransition emits an event when an experiment reaches a terminal state
+func (r *Reconciler) recordStateTransition(experiment *fisv1alpha1.Experiment) {
+	id := experiment.Status.ExperimentID
+	switch experiment.Status.State {
+	case "completed":
+		r.recordEvent(experiment, corev1.EventTypeNormal, fisv1alpha1.ReasonCompleted,
+			fmt.Sprintf("AWS FIS experiment %s completed", id))
+	case "stopped":
+		r.recordEvent(experiment, corev1.EventTypeNormal, fisv1alpha1.ReasonStopped,
+			fmt.Sprintf("AWS FIS experiment %s stopped: %s", id, experiment.Status.Reason))
+	case "failed":
+		r.recordEvent(experiment, corev1.EventTypeWarning, fisv1alpha1.ReasonFailed,
+			fmt.Sprintf("AWS FIS experiment %s failed: %s", id, experiment.Status.Reason))
+	}
+}
+
+// recordEvent emits a Kubernetes event for the experiment when a recorder is configured
+func (r *Reconciler) recordEvent(experiment *fisv1alpha1.Experiment, eventType, reason, message string) {
+	if r.Recorder != nil {
+		r.Recorder.Event(experiment, eventType, reason, message)
+	}
+}
+
+// setThrottled records a throttled StartExperiment call in status and requeues with exponential backoff
+func (r *Reconciler) setThrottled(ctx context.Context, experiment *fisv1alpha1.Experiment, err error, log logr.Logger) (ctrl.Result, error) {
+	experiment.Status.ConsecutiveThrottles++
+	backoff := awsfis.ThrottleBackoff(experiment.Status.ConsecutiveThrottles)
+
+	meta.SetStatusCondition(&experiment.Status.Conditions, metav1.Condition{
+		Type:               fisv1alpha1.ConditionThrottled,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: experiment.Generation,
+		Reason:             fisv1alpha1.ReasonThrottled,
+		Message:            fmt.Sprintf("StartExperiment throttled, retrying in %s: %v", backoff, err),
+	})
+	if updateErr := r.Status().Update(ctx, experiment); updateErr != nil {
+		log.Error(updateErr, "Failed to update status")
+		return ctrl.Result{}, updateErr
+	}
+
+	r.recordEvent(experiment, corev1.EventTypeWarning, fisv1alpha1.ReasonThrottled,
+		fmt.Sprintf("StartExperiment for template %s throttled, retrying in %s: %v", experiment.Status.TemplateID, backoff, err))
+
+	log.Info("StartExperiment throttled, backing off", "attempts", experiment.Status.ConsecutiveThrottles, "requeueAfter", backoff)
+	return ctrl.Result{RequeueAfter: backoff}, nil
+}
+
+// clearThrottled resets the throttle backoff after a successful StartExperiment call
+func clearThrottled(experiment *fisv1alpha1.Experiment) {
+	experiment.Status.ConsecutiveThrottles = 0
+	if meta.FindStatusCondition(experiment.Status.Conditions, fisv1alpha1.ConditionThrottled) != nil {
+		meta.SetStatusCondition(&experiment.Status.Conditions, metav1.Condition{
+			Type:               fisv1alpha1.ConditionThrottled,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: experiment.Generation,
+			Reason:             fisv1alpha1.ReasonSucceeded,
+			Message:            "Last StartExperiment call succeeded",
+		})
+	}
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("experiment-controller")
+	}
+
+	// Status-only updates must not retrigger reconciliation, otherwise the
+	// throttle backoff would be bypassed by our own status writes
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&fisv1alpha1.Experiment{}).
+		For(&fisv1alpha1.Experiment{}, builder.WithPredicates(
+			predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}),
+		)).
 		Named("experiment").
 		Complete(r)
 }
@@ -429,14 +1324,22 @@ func (r *Reconciler) cleanupExperimentHistory(ctx context.Context, experiment *f
 		return fmt.Errorf("failed to list experiments: %w", err)
 	}
 
-	// Separate by state
-	var successful, failed []awsfis.ExperimentSummary
+	// Separate by state; a shared template also lists the runs of other Experiments, which are left out
+	var successful, failed, running, active []awsfis.ExperimentSummary
 	for _, exp := range experiments {
+		if !ownRun(experiment, exp) {
+			continue
+		}
 		switch exp.State {
 		case "completed":
 			successful = append(successful, exp)
 		case "failed", "stopped":
 			failed = append(failed, exp)
+		case "running":
+			running = append(running, exp)
+			active = append(active, exp)
+		case "initiating", "pending", "stopping":
+			active = append(active, exp)
 		}
 	}
 
@@ -444,41 +1347,149 @@ func (r *Reconciler) cleanupExperimentHistory(ctx context.Context, experiment *f
 	sortByStartTimeDesc(successful)
 	sortByStartTimeDesc(failed)
 
-	// Log cleanup info
+	// AWS FIS has no DeleteExperiment API and retains finished experiments for 120 days,
+	// so runs past the limits are only dropped from status
+	experiment.Status.ExperimentHistory = buildExperimentHistory(experiment, active, successful, failed, successLimit, failedLimit)
+
 	if len(successful) > int(successLimit) || len(failed) > int(failedLimit) {
-		log.Info("Cleaning up experiment history",
+		log.Info("Dropped experiments exceeding history limits from status",
 			"successfulCount", len(successful),
 			"successfulLimit", successLimit,
 			"failedCount", len(failed),
 			"failedLimit", failedLimit)
 	}
 
-	// Note: AWS FIS doesn't provide DeleteExperiment API
-	// Experiments are automatically retained for 120 days
-	// We log which experiments would be cleaned up for visibility
-	if len(successful) > int(successLimit) {
-		toCleanup := successful[successLimit:]
-		for _, exp := range toCleanup {
-			log.Info("Experiment exceeds history limit (auto-cleanup by AWS after 120 days)",
-				"experimentID", exp.ID,
-				"state", exp.State,
-				"startTime", exp.StartTime)
+	// Active runs that did not fit in the history would otherwise keep running unseen
+	// Limits of 0 only keep finished runs out of status, they never stop runs
+	stopped := map[string]bool{}
+	if successLimit+failedLimit > 0 {
+		stopped = r.stopOverLimitExperiments(ctx, experiment, active, log)
+	}
+
+	var stillRunning []awsfis.ExperimentSummary
+	for _, exp := range running {
+		if !stopped[exp.ID] {
+			stillRunning = append(stillRunning, exp)
+		}
+	}
+	if len(stillRunning) > 0 {
+		if err := r.stopStuckExperiments(ctx, templateID, stillRunning, log); err != nil {
+			log.Error(err, "Failed to stop stuck experiments")
 		}
 	}
 
-	if len(failed) > int(failedLimit) {
-		toCleanup := failed[failedLimit:]
-		for _, exp := range toCleanup {
-			log.Info("Experiment exceeds history limit (auto-cleanup by AWS after 120 days)",
-				"experimentID", exp.ID,
-				"state", exp.State,
-				"startTime", exp.StartTime)
+	return nil
+}
+
+// stopOverLimitExperiments stops active runs of this Experiment left out of the recorded history and returns the IDs it stopped
+// Runs started for other Experiments or outside the controller, and the current run, are never stopped; failures are logged only
+func (r *Reconciler) stopOverLimitExperiments(ctx context.Context, experiment *fisv1alpha1.Experiment, active []awsfis.ExperimentSummary, log logr.Logger) map[string]bool {
+	recorded := make(map[string]bool, len(experiment.Status.ExperimentHistory))
+	for _, ref := range experiment.Status.ExperimentHistory {
+		recorded[ref.ID] = true
+	}
+
+	stopped := make(map[string]bool)
+	for _, exp := range active {
+		if !ownRun(experiment, exp) || recorded[exp.ID] || exp.ID == experiment.Status.ExperimentID || exp.State == "stopping" {
+			continue
+		}
+
+		log.Info("Stopping active experiment exceeding history limits", "experimentID", exp.ID, "state", exp.State, "startTime", exp.StartTime)
+		if err := r.FISClient.StopExperiment(ctx, exp.ID); err != nil {
+			log.Error(err, "Failed to stop experiment exceeding history limits", "experimentID", exp.ID)
+			continue
+		}
+		stopped[exp.ID] = true
+	}
+	return stopped
+}
+
+// stopStuckExperiments stops experiments that are still running long after their longest action should have finished
+func (r *Reconciler) stopStuckExperiments(ctx context.Context, templateID string, running []awsfis.ExperimentSummary, log logr.Logger) error {
+	template, err := r.FISClient.GetExperimentTemplate(ctx, templateID)
+	if err != nil {
+		return fmt.Errorf("failed to get experiment template: %w", err)
+	}
+
+	maxRuntime := awsfis.MaxActionDuration(template) + stuckExperimentBuffer
+	for _, exp := range running {
+		if exp.StartTime == nil || time.Since(*exp.StartTime) <= maxRuntime {
+			continue
+		}
+
+		log.Info("Stopping experiment running past its expected duration",
+			"experimentID", exp.ID,
+			"startTime", exp.StartTime,
+			"maxRuntime", maxRuntime)
+		if err := r.FISClient.StopExperiment(ctx, exp.ID); err != nil {
+			return fmt.Errorf("failed to stop experiment %s: %w", exp.ID, err)
 		}
 	}
 
 	return nil
 }
 
+// ownRun reports whether a run was started for the experiment, judged by the Experiment tags set at start
+func ownRun(experiment *fisv1alpha1.Experiment, run awsfis.ExperimentSummary) bool {
+	return run.ExperimentName == experiment.Name && run.ExperimentNamespace == experiment.Namespace
+}
+
+// buildExperimentHistory returns the runs to record in status, newest first: active runs plus the
+// finished runs within the history limits, capped at the sum of both limits
+// successful and failed must already be sorted newest first
+func buildExperimentHistory(experiment *fisv1alpha1.Experiment, active, successful, failed []awsfis.ExperimentSummary, successLimit, failedLimit int32) []fisv1alpha1.ExperimentRef {
+	var runs []awsfis.ExperimentSummary
+	runs = append(runs, active...)
+	runs = append(runs, successful[:min(len(successful), int(successLimit))]...)
+	runs = append(runs, failed[:min(len(failed), int(failedLimit))]...)
+	sortByStartTimeDesc(runs)
+	runs = dedupeByClientToken(runs)
+	runs = runs[:min(len(runs), int(successLimit+failedLimit))]
+
+	// ListExperiments does not report end times, keep the ones already known
+	endTimes := make(map[string]*metav1.Time)
+	for _, ref := range experiment.Status.ExperimentHistory {
+		endTimes[ref.ID] = ref.EndTime
+	}
+	if experiment.Status.ExperimentID != "" && experiment.Status.EndTime != nil {
+		endTimes[experiment.Status.ExperimentID] = experiment.Status.EndTime
+	}
+
+	history := make([]fisv1alpha1.ExperimentRef, 0, len(runs))
+	for _, run := range runs {
+		ref := fisv1alpha1.ExperimentRef{
+			ID:          run.ID,
+			State:       run.State,
+			EndTime:     endTimes[run.ID],
+			ClientToken: run.ClientToken,
+		}
+		if run.StartTime != nil {
+			startTime := metav1.NewTime(*run.StartTime)
+			ref.StartTime = &startTime
+		}
+		history = append(history, ref)
+	}
+	return history
+}
+
+// dedupeByClientToken keeps only the newest attempt of each logical run, runs must be sorted newest first
+// Runs without a client token were started before tokens were recorded and are all kept
+func dedupeByClientToken(runs []awsfis.ExperimentSummary) []awsfis.ExperimentSummary {
+	seen := make(map[string]bool, len(runs))
+	deduped := runs[:0]
+	for _, run := range runs {
+		if run.ClientToken != "" {
+			if seen[run.ClientToken] {
+				continue
+			}
+			seen[run.ClientToken] = true
+		}
+		deduped = append(deduped, run)
+	}
+	return deduped
+}
+
 // sortByStartTimeDesc sorts experiments by start time in descending order (newest first)
 func sortByStartTimeDesc(experiments []awsfis.ExperimentSummary) {
 	for i := 0; i < len(experiments)-1; i++ {
@@ -17,12 +17,30 @@ limitations under the License.
 package experiment
 
 import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/fis"
+	"github.com/aws/aws-sdk-go-v2/service/fis/types"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	fisv1alpha1 "fis.dksshddl.dev/fis-controller/api/v1alpha1"
+	awsfis "fis.dksshddl.dev/fis-controller/internal/aws"
+	awsfake "fis.dksshddl.dev/fis-controller/internal/aws/fake"
 )
 
 func TestReconciler(t *testing.T) {
@@ -53,3 +71,1666 @@ func TestReconciler(t *testing.T) {
 		t.Error("Scheme should not be nil")
 	}
 }
+
+// newTestReconciler returns a reconciler backed by a fake Kubernetes client and a fake FIS API
+func newTestReconciler(fisAPI *awsfake.FIS, objs ...*fisv1alpha1.Experiment) *Reconciler {
+	scheme := runtime.NewScheme()
+	_ = fisv1alpha1.AddToScheme(scheme)
+
+	builder := fake.NewClientBuilder().WithScheme(scheme)
+	for _, obj := range objs {
+		builder = builder.WithObjects(obj).WithStatusSubresource(obj)
+	}
+
+	return &Reconciler{
+		Client:    builder.Build(),
+		Scheme:    scheme,
+		FISClient: awsfis.NewFISClientFromAPI(fisAPI, aws.Config{Region: "ap-northeast-2"}),
+	}
+}
+
+// newScheduledExperiment returns a scheduled Experiment that already resolved its template
+func newScheduledExperiment(name string) *fisv1alpha1.Experiment {
+	return &fisv1alpha1.Experiment{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: fisv1alpha1.ExperimentSpec{
+			ExperimentTemplate: fisv1alpha1.ExperimentTemplateRef{ID: "EXT1234567890abcdef"},
+			Schedule:           "0 2 * * *",
+		},
+		Status: fisv1alpha1.ExperimentStatus{TemplateID: "EXT1234567890abcdef"},
+	}
+}
+
+func TestCleanupStopsStuckExperiments(t *testing.T) {
+	now := time.Now()
+	stuckStart := now.Add(-3 * time.Hour)
+	recentStart := now.Add(-10 * time.Minute)
+	oldCompleted := now.Add(-5 * time.Hour)
+	ownTags := map[string]string{awsfis.ExperimentNameTag: "stuck-test", awsfis.ExperimentNamespaceTag: ""}
+	otherTags := map[string]string{awsfis.ExperimentNameTag: "other-test", awsfis.ExperimentNamespaceTag: ""}
+
+	fisAPI := &awsfake.FIS{
+		ListExperimentsFunc: func(params *fis.ListExperimentsInput) (*fis.ListExperimentsOutput, error) {
+			templateID := params.ExperimentTemplateId
+			return &fis.ListExperimentsOutput{
+				Experiments: []types.ExperimentSummary{
+					{Id: aws.String("EXPstuck"), ExperimentTemplateId: templateID, State: &types.ExperimentState{Status: types.ExperimentStatusRunning}, CreationTime: &stuckStart, Tags: ownTags},
+					{Id: aws.String("EXPrecent"), ExperimentTemplateId: templateID, State: &types.ExperimentState{Status: types.ExperimentStatusRunning}, CreationTime: &recentStart, Tags: ownTags},
+					{Id: aws.String("EXPdone"), ExperimentTemplateId: templateID, State: &types.ExperimentState{Status: types.ExperimentStatusCompleted}, CreationTime: &oldCompleted, Tags: ownTags},
+					// Equally long runs started by another Experiment and outside the controller are left alone
+					{Id: aws.String("EXPotherstuck"), ExperimentTemplateId: templateID, State: &types.ExperimentState{Status: types.ExperimentStatusRunning}, CreationTime: &stuckStart, Tags: otherTags},
+					{Id: aws.String("EXPmanualstuck"), ExperimentTemplateId: templateID, State: &types.ExperimentState{Status: types.ExperimentStatusRunning}, CreationTime: &stuckStart},
+				},
+			}, nil
+		},
+		GetExperimentTemplateFunc: func(params *fis.GetExperimentTemplateInput) (*fis.GetExperimentTemplateOutput, error) {
+			return &fis.GetExperimentTemplateOutput{
+				ExperimentTemplate: &types.ExperimentTemplate{
+					Id: params.Id,
+					Actions: map[string]types.ExperimentTemplateAction{
+						"cpu-stress":    {Parameters: map[string]string{"duration": "PT5M"}},
+						"memory-stress": {Parameters: map[string]string{"duration": "PT1H"}},
+					},
+				},
+			}, nil
+		},
+	}
+	experiment := newScheduledExperiment("stuck-test")
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	if err := reconciler.cleanupExperimentHistory(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("cleanupExperimentHistory failed: %v", err)
+	}
+
+	// Longest action is 1h, so only the experiment running for 3h is past 1h + buffer
+	if len(fisAPI.StopExperimentInputs) != 1 {
+		t.Fatalf("Expected 1 StopExperiment call, got: %d", len(fisAPI.StopExperimentInputs))
+	}
+	if got := aws.ToString(fisAPI.StopExperimentInputs[0].Id); got != "EXPstuck" {
+		t.Errorf("Expected stuck experiment to be stopped, got: %s", got)
+	}
+}
+
+func TestCleanupSkipsTemplateLookupWithoutRunningExperiments(t *testing.T) {
+	fisAPI := &awsfake.FIS{}
+	experiment := newScheduledExperiment("idle-test")
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	if err := reconciler.cleanupExperimentHistory(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("cleanupExperimentHistory failed: %v", err)
+	}
+
+	if len(fisAPI.GetExperimentTemplateInputs) != 0 || len(fisAPI.StopExperimentInputs) != 0 {
+		t.Errorf("Expected no template lookups or stops, got: %d and %d", len(fisAPI.GetExperimentTemplateInputs), len(fisAPI.StopExperimentInputs))
+	}
+}
+
+func TestSyncExperimentStateRecordsTargetResolution(t *testing.T) {
+	fisAPI := &awsfake.FIS{
+		GetExperimentFunc: func(params *fis.GetExperimentInput) (*fis.GetExperimentOutput, error) {
+			return &fis.GetExperimentOutput{
+				Experiment: &types.Experiment{
+					Id:    params.Id,
+					State: &types.ExperimentState{Status: types.ExperimentStatusRunning},
+					Targets: map[string]types.ExperimentTarget{
+						"web-pods":   {ResourceType: aws.String("aws:eks:pod")},
+						"cache-pods": {ResourceType: aws.String("aws:eks:pod")},
+					},
+				},
+			}, nil
+		},
+		ListExperimentResolvedTargetsFunc: func(*fis.ListExperimentResolvedTargetsInput) (*fis.ListExperimentResolvedTargetsOutput, error) {
+			return &fis.ListExperimentResolvedTargetsOutput{
+				ResolvedTargets: []types.ResolvedTarget{
+					{TargetName: aws.String("web-pods")},
+					{TargetName: aws.String("web-pods")},
+					{TargetName: aws.String("web-pods")},
+				},
+			}, nil
+		},
+	}
+	experiment := newScheduledExperiment("resolution-test")
+	experiment.Spec.Schedule = ""
+	experiment.Status.ExperimentID = "EXP1234567890abcdef"
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	if _, err := reconciler.syncExperimentState(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("syncExperimentState failed: %v", err)
+	}
+
+	want := []fisv1alpha1.TargetResolution{
+		{Name: "cache-pods", ResolvedCount: 0, Skipped: true},
+		{Name: "web-pods", ResolvedCount: 3, Skipped: false},
+	}
+	got := experiment.Status.TargetResolution
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d target resolutions, got: %v", len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("TargetResolution[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	// Resolution is recorded once and not fetched again on later syncs
+	if _, err := reconciler.syncExperimentState(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("syncExperimentState failed: %v", err)
+	}
+	if len(fisAPI.ListExperimentResolvedTargetsInputs) != 1 {
+		t.Errorf("Expected 1 ListExperimentResolvedTargets call, got: %d", len(fisAPI.ListExperimentResolvedTargetsInputs))
+	}
+}
+
+func TestSyncExperimentStateRecordsResolvedTargetArnsOnTerminalState(t *testing.T) {
+	podArn := "arn:aws:eks:us-east-1:123456789012:pod/prod/default/web-0"
+	nodeArn := "arn:aws:ec2:us-east-1:123456789012:instance/i-0123456789abcdef0"
+	fisAPI := &awsfake.FIS{
+		GetExperimentFunc: func(params *fis.GetExperimentInput) (*fis.GetExperimentOutput, error) {
+			return &fis.GetExperimentOutput{
+				Experiment: &types.Experiment{
+					Id:      params.Id,
+					State:   &types.ExperimentState{Status: types.ExperimentStatusCompleted},
+					EndTime: aws.Time(time.Now()),
+				},
+			}, nil
+		},
+		ListExperimentResolvedTargetsFunc: func(*fis.ListExperimentResolvedTargetsInput) (*fis.ListExperimentResolvedTargetsOutput, error) {
+			return &fis.ListExperimentResolvedTargetsOutput{
+				ResolvedTargets: []types.ResolvedTarget{
+					{TargetName: aws.String("web-pods"), TargetInformation: map[string]string{"arn": podArn}},
+					{TargetName: aws.String("nodes"), TargetInformation: map[string]string{"arn": nodeArn}},
+				},
+			}, nil
+		},
+	}
+	experiment := newScheduledExperiment("resolved-arns-test")
+	experiment.Spec.Schedule = ""
+	experiment.Status.ExperimentID = "EXP1234567890abcdef"
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	if _, err := reconciler.syncExperimentState(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("syncExperimentState failed: %v", err)
+	}
+
+	got := experiment.Status.ResolvedTargetArns
+	if len(got) != 2 || got[0] != podArn || got[1] != nodeArn {
+		t.Errorf("Expected resolved target ARNs [%s %s], got: %v", podArn, nodeArn, got)
+	}
+	if experiment.Status.ResolvedTargetArnsTruncated {
+		t.Error("Expected resolved target ARNs not to be truncated")
+	}
+}
+
+func TestSyncExperimentStateSkipsResolutionWhileInitiating(t *testing.T) {
+	fisAPI := &awsfake.FIS{
+		GetExperimentFunc: func(params *fis.GetExperimentInput) (*fis.GetExperimentOutput, error) {
+			return &fis.GetExperimentOutput{
+				Experiment: &types.Experiment{
+					Id:    params.Id,
+					State: &types.ExperimentState{Status: types.ExperimentStatusInitiating},
+				},
+			}, nil
+		},
+	}
+	experiment := newScheduledExperiment("initiating-test")
+	experiment.Status.ExperimentID = "EXP1234567890abcdef"
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	if _, err := reconciler.syncExperimentState(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("syncExperimentState failed: %v", err)
+	}
+	if len(fisAPI.ListExperimentResolvedTargetsInputs) != 0 || len(experiment.Status.TargetResolution) != 0 {
+		t.Errorf("Expected no target resolution while initiating, got: %v", experiment.Status.TargetResolution)
+	}
+}
+
+func TestSyncExperimentStateRecordsActionStates(t *testing.T) {
+	start := time.Date(2026, 3, 1, 2, 0, 0, 0, time.UTC)
+	end := start.Add(5 * time.Minute)
+	fisAPI := &awsfake.FIS{
+		GetExperimentFunc: func(params *fis.GetExperimentInput) (*fis.GetExperimentOutput, error) {
+			return &fis.GetExperimentOutput{
+				Experiment: &types.Experiment{
+					Id:    params.Id,
+					State: &types.ExperimentState{Status: types.ExperimentStatusRunning},
+					Actions: map[string]types.ExperimentAction{
+						"network-latency": {
+							State: &types.ExperimentActionState{
+								Status: types.ExperimentActionStatusFailed,
+								Reason: aws.String("Target pod has no network namespace"),
+							},
+							StartTime: aws.Time(start),
+							EndTime:   aws.Time(end),
+						},
+						"cpu-stress": {
+							State:     &types.ExperimentActionState{Status: types.ExperimentActionStatusRunning},
+							StartTime: aws.Time(start),
+						},
+					},
+				},
+			}, nil
+		},
+	}
+	experiment := newScheduledExperiment("actions-test")
+	experiment.Spec.Schedule = ""
+	experiment.Status.ExperimentID = "EXP1234567890abcdef"
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	if _, err := reconciler.syncExperimentState(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("syncExperimentState failed: %v", err)
+	}
+
+	actions := experiment.Status.Actions
+	if len(actions) != 2 {
+		t.Fatalf("Expected 2 action statuses, got: %v", actions)
+	}
+	if actions[0].Name != "cpu-stress" || actions[0].State != "running" || actions[0].EndTime != nil {
+		t.Errorf("Unexpected cpu-stress status: %+v", actions[0])
+	}
+	failed := actions[1]
+	if failed.Name != "network-latency" || failed.State != "failed" {
+		t.Errorf("Unexpected network-latency status: %+v", failed)
+	}
+	if failed.Reason != "Target pod has no network namespace" {
+		t.Errorf("Expected failure reason to be recorded, got: %q", failed.Reason)
+	}
+	if failed.StartTime == nil || !failed.StartTime.Time.Equal(start) || failed.EndTime == nil || !failed.EndTime.Time.Equal(end) {
+		t.Errorf("Expected start %v and end %v, got: %v - %v", start, end, failed.StartTime, failed.EndTime)
+	}
+}
+
+func TestSyncExperimentStateRecordsActionTimings(t *testing.T) {
+	// memory-stress starts after cpu-stress, so each action has its own window within the experiment
+	cpuStart := time.Date(2026, 3, 1, 2, 0, 5, 0, time.UTC)
+	cpuEnd := cpuStart.Add(5 * time.Minute)
+	memoryStart := cpuEnd.Add(2 * time.Second)
+	memoryEnd := memoryStart.Add(10 * time.Minute)
+	fisAPI := &awsfake.FIS{
+		GetExperimentFunc: func(params *fis.GetExperimentInput) (*fis.GetExperimentOutput, error) {
+			return &fis.GetExperimentOutput{
+				Experiment: &types.Experiment{
+					Id:        params.Id,
+					State:     &types.ExperimentState{Status: types.ExperimentStatusCompleted},
+					StartTime: aws.Time(cpuStart.Add(-5 * time.Second)),
+					EndTime:   aws.Time(memoryEnd.Add(time.Second)),
+					Actions: map[string]types.ExperimentAction{
+						"cpu-stress": {
+							State:     &types.ExperimentActionState{Status: types.ExperimentActionStatusCompleted},
+							StartTime: aws.Time(cpuStart),
+							EndTime:   aws.Time(cpuEnd),
+						},
+						"memory-stress": {
+							State:     &types.ExperimentActionState{Status: types.ExperimentActionStatusCompleted},
+							StartTime: aws.Time(memoryStart),
+							EndTime:   aws.Time(memoryEnd),
+						},
+					},
+				},
+			}, nil
+		},
+	}
+	experiment := newScheduledExperiment("action-timings-test")
+	experiment.Spec.Schedule = ""
+	experiment.Status.ExperimentID = "EXP1234567890abcdef"
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	if _, err := reconciler.syncExperimentState(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("syncExperimentState failed: %v", err)
+	}
+
+	want := map[string][2]time.Time{
+		"cpu-stress":    {cpuStart, cpuEnd},
+		"memory-stress": {memoryStart, memoryEnd},
+	}
+	if len(experiment.Status.Actions) != len(want) {
+		t.Fatalf("Expected %d action statuses, got: %+v", len(want), experiment.Status.Actions)
+	}
+	for _, action := range experiment.Status.Actions {
+		timing, ok := want[action.Name]
+		if !ok {
+			t.Errorf("Unexpected action %s", action.Name)
+			continue
+		}
+		if action.State != "completed" {
+			t.Errorf("Expected %s to be completed, got: %s", action.Name, action.State)
+		}
+		if action.StartTime == nil || !action.StartTime.Time.Equal(timing[0]) {
+			t.Errorf("Expected %s to start at %v, got: %v", action.Name, timing[0], action.StartTime)
+		}
+		if action.EndTime == nil || !action.EndTime.Time.Equal(timing[1]) {
+			t.Errorf("Expected %s to end at %v, got: %v", action.Name, timing[1], action.EndTime)
+		}
+	}
+}
+
+func TestSyncExperimentStateWaitsForDelayedEndTime(t *testing.T) {
+	end := time.Date(2026, 3, 1, 2, 5, 0, 0, time.UTC)
+	polls := 0
+	fisAPI := &awsfake.FIS{
+		GetExperimentFunc: func(params *fis.GetExperimentInput) (*fis.GetExperimentOutput, error) {
+			polls++
+			experiment := &types.Experiment{
+				Id:    params.Id,
+				State: &types.ExperimentState{Status: types.ExperimentStatusStopped},
+			}
+			// The end time only shows up on the second poll
+			if polls > 1 {
+				experiment.EndTime = aws.Time(end)
+			}
+			return &fis.GetExperimentOutput{Experiment: experiment}, nil
+		},
+	}
+	experiment := newScheduledExperiment("delayed-end-test")
+	experiment.Spec.Schedule = ""
+	experiment.Status.ExperimentID = "EXP1234567890abcdef"
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	result, err := reconciler.syncExperimentState(context.Background(), experiment, logr.Discard())
+	if err != nil {
+		t.Fatalf("syncExperimentState failed: %v", err)
+	}
+	if experiment.Status.EndTime != nil {
+		t.Fatalf("Expected no end time yet, got: %v", experiment.Status.EndTime)
+	}
+	if result.RequeueAfter == 0 {
+		t.Errorf("Expected a requeue while waiting for the end time")
+	}
+	if experiment.Status.LastUpdateTime == nil {
+		t.Errorf("Expected LastUpdateTime to be stamped")
+	}
+	if experiment.Status.Active != 0 {
+		t.Errorf("Expected no active runs for a stopped experiment, got: %d", experiment.Status.Active)
+	}
+
+	result, err = reconciler.syncExperimentState(context.Background(), experiment, logr.Discard())
+	if err != nil {
+		t.Fatalf("syncExperimentState failed: %v", err)
+	}
+	if experiment.Status.EndTime == nil || !experiment.Status.EndTime.Time.Equal(end) {
+		t.Errorf("Expected end time %v, got: %v", end, experiment.Status.EndTime)
+	}
+	if result.RequeueAfter != 0 {
+		t.Errorf("Expected no requeue once the end time is known, got: %v", result.RequeueAfter)
+	}
+}
+
+func TestSyncExperimentStateGivesUpWaitingForEndTime(t *testing.T) {
+	fisAPI := &awsfake.FIS{
+		GetExperimentFunc: func(params *fis.GetExperimentInput) (*fis.GetExperimentOutput, error) {
+			return &fis.GetExperimentOutput{
+				Experiment: &types.Experiment{
+					Id:    params.Id,
+					State: &types.ExperimentState{Status: types.ExperimentStatusFailed},
+				},
+			}, nil
+		},
+	}
+	experiment := newScheduledExperiment("missing-end-test")
+	experiment.Spec.Schedule = ""
+	experiment.Status.ExperimentID = "EXP1234567890abcdef"
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	for i := 0; i < maxEndTimePolls; i++ {
+		if _, err := reconciler.syncExperimentState(context.Background(), experiment, logr.Discard()); err != nil {
+			t.Fatalf("syncExperimentState failed: %v", err)
+		}
+	}
+	if experiment.Status.EndTime == nil {
+		t.Errorf("Expected the end time to fall back to the last sync time after %d polls", maxEndTimePolls)
+	}
+}
+
+// newOverlappingExperiment returns a scheduled Experiment whose next run is due while the previous run is still active
+// Its last run was 25 hours ago, so exactly one daily run is due
+func newOverlappingExperiment(name string, policy fisv1alpha1.ConcurrencyPolicy) *fisv1alpha1.Experiment {
+	experiment := newScheduledExperiment(name)
+	experiment.Spec.ConcurrencyPolicy = policy
+	lastSchedule := metav1.NewTime(time.Now().Add(-25 * time.Hour).Truncate(time.Second))
+	experiment.Status.LastScheduleTime = &lastSchedule
+	experiment.Status.ExperimentID = "EXPprevious"
+	experiment.Status.State = "running"
+	experiment.Status.Active = 1
+	return experiment
+}
+
+func TestScheduledExperimentConcurrencyAllow(t *testing.T) {
+	fisAPI := &awsfake.FIS{}
+	experiment := newOverlappingExperiment("allow-test", fisv1alpha1.AllowConcurrent)
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	if _, err := reconciler.handleScheduledExperiment(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("handleScheduledExperiment failed: %v", err)
+	}
+
+	if len(fisAPI.StartExperimentInputs) != 1 {
+		t.Fatalf("Expected 1 StartExperiment call, got: %d", len(fisAPI.StartExperimentInputs))
+	}
+	if len(fisAPI.GetExperimentInputs) != 0 || len(fisAPI.StopExperimentInputs) != 0 {
+		t.Errorf("Expected Allow to leave the previous run alone, got %d gets and %d stops", len(fisAPI.GetExperimentInputs), len(fisAPI.StopExperimentInputs))
+	}
+}
+
+func TestScheduledExperimentConcurrencyForbid(t *testing.T) {
+	fisAPI := &awsfake.FIS{}
+	experiment := newOverlappingExperiment("forbid-test", fisv1alpha1.ForbidConcurrent)
+	dueRun, err := scheduleParser.Parse(experiment.Spec.Schedule)
+	if err != nil {
+		t.Fatalf("failed to parse schedule: %v", err)
+	}
+	skippedRun := dueRun.Next(experiment.Status.LastScheduleTime.Time)
+	nextRun := dueRun.Next(skippedRun)
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	before := time.Now()
+	result, err := reconciler.handleScheduledExperiment(context.Background(), experiment, logr.Discard())
+	if err != nil {
+		t.Fatalf("handleScheduledExperiment failed: %v", err)
+	}
+
+	if len(fisAPI.StartExperimentInputs) != 0 {
+		t.Errorf("Expected no StartExperiment call while the previous run is active, got: %d", len(fisAPI.StartExperimentInputs))
+	}
+	if experiment.Status.ExperimentID != "EXPprevious" {
+		t.Errorf("Expected previous experiment ID to be kept, got: %s", experiment.Status.ExperimentID)
+	}
+	if last := experiment.Status.LastScheduleTime; last == nil || !last.Time.Equal(skippedRun) {
+		t.Errorf("Expected lastScheduleTime to record the skipped run %s, got: %v", skippedRun, last)
+	}
+	if next := experiment.Status.NextScheduleTime; next == nil || !next.Time.Equal(nextRun) {
+		t.Errorf("Expected nextScheduleTime %s, got: %v", nextRun, next)
+	}
+	if result.RequeueAfter <= activeRunPollInterval || result.RequeueAfter > nextRun.Sub(before) {
+		t.Errorf("Expected requeue at the next run %s, got: %s", nextRun, result.RequeueAfter)
+	}
+
+	// The previous run finishing does not start the skipped run late
+	endTime := time.Now()
+	fisAPI.GetExperimentFunc = func(params *fis.GetExperimentInput) (*fis.GetExperimentOutput, error) {
+		return &fis.GetExperimentOutput{
+			Experiment: &types.Experiment{
+				Id:      params.Id,
+				State:   &types.ExperimentState{Status: types.ExperimentStatusCompleted},
+				EndTime: &endTime,
+			},
+		}, nil
+	}
+	if _, err := reconciler.handleScheduledExperiment(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("handleScheduledExperiment failed: %v", err)
+	}
+	if len(fisAPI.StartExperimentInputs) != 0 {
+		t.Errorf("Expected the skipped run not to start after the previous run ended, got %d StartExperiment calls", len(fisAPI.StartExperimentInputs))
+	}
+}
+
+func TestScheduledExperimentConcurrencyForbidStartsAfterPreviousRunEnds(t *testing.T) {
+	endTime := time.Now().Add(-time.Minute)
+	fisAPI := &awsfake.FIS{
+		GetExperimentFunc: func(params *fis.GetExperimentInput) (*fis.GetExperimentOutput, error) {
+			return &fis.GetExperimentOutput{
+				Experiment: &types.Experiment{
+					Id:      params.Id,
+					State:   &types.ExperimentState{Status: types.ExperimentStatusCompleted},
+					EndTime: &endTime,
+				},
+			}, nil
+		},
+	}
+	experiment := newOverlappingExperiment("forbid-ended-test", fisv1alpha1.ForbidConcurrent)
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	if _, err := reconciler.handleScheduledExperiment(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("handleScheduledExperiment failed: %v", err)
+	}
+
+	if len(fisAPI.StartExperimentInputs) != 1 {
+		t.Errorf("Expected 1 StartExperiment call once the previous run ended, got: %d", len(fisAPI.StartExperimentInputs))
+	}
+}
+
+func TestScheduledExperimentConcurrencyReplace(t *testing.T) {
+	fisAPI := &awsfake.FIS{}
+	experiment := newOverlappingExperiment("replace-test", fisv1alpha1.ReplaceConcurrent)
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	if _, err := reconciler.handleScheduledExperiment(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("handleScheduledExperiment failed: %v", err)
+	}
+
+	if len(fisAPI.StopExperimentInputs) != 1 {
+		t.Fatalf("Expected 1 StopExperiment call, got: %d", len(fisAPI.StopExperimentInputs))
+	}
+	if got := aws.ToString(fisAPI.StopExperimentInputs[0].Id); got != "EXPprevious" {
+		t.Errorf("Expected previous run to be stopped, got: %s", got)
+	}
+	if len(fisAPI.StartExperimentInputs) != 1 {
+		t.Fatalf("Expected 1 StartExperiment call, got: %d", len(fisAPI.StartExperimentInputs))
+	}
+	if experiment.Status.ExperimentID == "EXPprevious" {
+		t.Error("Expected status to track the replacement run")
+	}
+}
+
+func TestScheduledExperimentSkipsRunPastStartingDeadline(t *testing.T) {
+	fisAPI := &awsfake.FIS{}
+	experiment := newScheduledExperiment("deadline-test")
+	// Yearly schedule, so the only missed runs are well outside the deadline
+	experiment.Spec.Schedule = "0 2 1 1 *"
+	experiment.Spec.StartingDeadlineSeconds = aws.Int64(300)
+	lastSchedule := metav1.NewTime(time.Now().AddDate(-2, 0, 0).Truncate(time.Second))
+	experiment.Status.LastScheduleTime = &lastSchedule
+	reconciler := newTestReconciler(fisAPI, experiment)
+	recorder := record.NewFakeRecorder(10)
+	reconciler.Recorder = recorder
+
+	result, err := reconciler.handleScheduledExperiment(context.Background(), experiment, logr.Discard())
+	if err != nil {
+		t.Fatalf("handleScheduledExperiment failed: %v", err)
+	}
+
+	if len(fisAPI.StartExperimentInputs) != 0 {
+		t.Errorf("Expected missed run to be skipped, got %d StartExperiment calls", len(fisAPI.StartExperimentInputs))
+	}
+	if !strings.Contains(experiment.Status.Reason, "starting deadline") {
+		t.Errorf("Expected skip to be recorded in status, got reason: %q", experiment.Status.Reason)
+	}
+	missed := experiment.Status.LastMissedScheduleTime
+	if missed == nil || !missed.After(lastSchedule.Time) {
+		t.Fatalf("Expected the skipped run time in lastMissedScheduleTime, got: %v", missed)
+	}
+	select {
+	case event := <-recorder.Events:
+		if !strings.Contains(event, corev1.EventTypeWarning) || !strings.Contains(event, fisv1alpha1.ReasonMissedStartingDeadline) {
+			t.Errorf("Expected a MissedStartingDeadline warning, got: %s", event)
+		}
+	default:
+		t.Error("Expected a MissedStartingDeadline event")
+	}
+
+	// The same skipped run is only reported once
+	if _, err := reconciler.handleScheduledExperiment(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("handleScheduledExperiment failed: %v", err)
+	}
+	select {
+	case event := <-recorder.Events:
+		t.Errorf("Expected no second event, got: %s", event)
+	default:
+	}
+	if !experiment.Status.LastMissedScheduleTime.Equal(missed) {
+		t.Errorf("Expected lastMissedScheduleTime to be kept, got: %v", experiment.Status.LastMissedScheduleTime)
+	}
+	if !experiment.Status.LastScheduleTime.Equal(&lastSchedule) {
+		t.Errorf("Expected LastScheduleTime to be unchanged, got: %v", experiment.Status.LastScheduleTime)
+	}
+	if experiment.Status.NextScheduleTime == nil || !experiment.Status.NextScheduleTime.After(time.Now()) {
+		t.Errorf("Expected next schedule time in the future, got: %v", experiment.Status.NextScheduleTime)
+	}
+	if result.RequeueAfter <= 0 {
+		t.Errorf("Expected requeue for the next schedule, got: %v", result)
+	}
+}
+
+func TestScheduledExperimentRunsMissedRunWithinStartingDeadline(t *testing.T) {
+	fisAPI := &awsfake.FIS{}
+	experiment := newScheduledExperiment("within-deadline-test")
+	experiment.Spec.Schedule = "* * * * *"
+	experiment.Spec.StartingDeadlineSeconds = aws.Int64(300)
+	lastSchedule := metav1.NewTime(time.Now().Add(-time.Hour))
+	experiment.Status.LastScheduleTime = &lastSchedule
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	if _, err := reconciler.handleScheduledExperiment(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("handleScheduledExperiment failed: %v", err)
+	}
+
+	// The oldest missed run is past the deadline but the most recent one is not
+	if len(fisAPI.StartExperimentInputs) != 1 {
+		t.Errorf("Expected the latest missed run to start, got %d StartExperiment calls", len(fisAPI.StartExperimentInputs))
+	}
+}
+
+func TestZonedScheduleAcrossDSTBoundary(t *testing.T) {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	standard, err := scheduleParser.Parse("0 2 * * *")
+	if err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+	schedule := zonedSchedule{Schedule: standard, location: location}
+
+	// 2am EDT is 06:00 UTC, 2am EST after the fall-back on 2026-11-01 is 07:00 UTC
+	tests := []struct {
+		from time.Time
+		want time.Time
+	}{
+		{from: time.Date(2026, 10, 30, 12, 0, 0, 0, time.UTC), want: time.Date(2026, 10, 31, 6, 0, 0, 0, time.UTC)},
+		{from: time.Date(2026, 10, 31, 12, 0, 0, 0, time.UTC), want: time.Date(2026, 11, 1, 7, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		if got := schedule.Next(tt.from); !got.Equal(tt.want) {
+			t.Errorf("Next(%s) = %s, want %s", tt.from, got.UTC(), tt.want)
+		}
+	}
+}
+
+func TestScheduledExperimentInvalidTimeZone(t *testing.T) {
+	fisAPI := &awsfake.FIS{}
+	experiment := newScheduledExperiment("invalid-zone-test")
+	experiment.Spec.TimeZone = "Mars/Olympus_Mons"
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	if _, err := reconciler.handleScheduledExperiment(context.Background(), experiment, logr.Discard()); err == nil {
+		t.Fatal("Expected error for an invalid time zone")
+	}
+
+	if experiment.Status.State != "failed" {
+		t.Errorf("Expected state failed, got: %s", experiment.Status.State)
+	}
+	if !strings.Contains(experiment.Status.Reason, `Invalid time zone "Mars/Olympus_Mons"`) {
+		t.Errorf("Expected reason to name the invalid time zone, got: %s", experiment.Status.Reason)
+	}
+	if len(fisAPI.StartExperimentInputs) != 0 {
+		t.Errorf("Expected no StartExperiment calls, got: %d", len(fisAPI.StartExperimentInputs))
+	}
+}
+
+func TestScheduleParserFormats(t *testing.T) {
+	from := time.Date(2026, 10, 16, 10, 20, 0, 0, time.UTC)
+	tests := []struct {
+		schedule string
+		want     time.Time
+	}{
+		{schedule: "0 2 * * *", want: time.Date(2026, 10, 17, 2, 0, 0, 0, time.UTC)},
+		{schedule: "@daily", want: time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)},
+		{schedule: "@every 15m", want: from.Add(15 * time.Minute)},
+		{schedule: "30 0 2 * * *", want: time.Date(2026, 10, 17, 2, 0, 30, 0, time.UTC)},
+		{schedule: "CRON_TZ=UTC 0 2 * * *", want: time.Date(2026, 10, 17, 2, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		schedule, err := scheduleParser.Parse(tt.schedule)
+		if err != nil {
+			t.Errorf("Parse(%q) failed: %v", tt.schedule, err)
+			continue
+		}
+		if got := schedule.Next(from); !got.Equal(tt.want) {
+			t.Errorf("Parse(%q).Next = %s, want %s", tt.schedule, got, tt.want)
+		}
+	}
+}
+
+func TestScheduledExperimentEveryRequeuesUntilNextRun(t *testing.T) {
+	fisAPI := &awsfake.FIS{}
+	experiment := newScheduledExperiment("every-test")
+	experiment.Spec.Schedule = "@every 30m"
+	lastSchedule := metav1.NewTime(time.Now().Add(-10 * time.Minute).Truncate(time.Second))
+	experiment.Status.LastScheduleTime = &lastSchedule
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	result, err := reconciler.handleScheduledExperiment(context.Background(), experiment, logr.Discard())
+	if err != nil {
+		t.Fatalf("handleScheduledExperiment failed: %v", err)
+	}
+
+	if len(fisAPI.StartExperimentInputs) != 0 {
+		t.Errorf("Expected no run before 30m elapsed, got %d StartExperiment calls", len(fisAPI.StartExperimentInputs))
+	}
+	// The next run is 30m after the last one, i.e. about 20m from now
+	if result.RequeueAfter < 19*time.Minute || result.RequeueAfter > 20*time.Minute {
+		t.Errorf("Expected requeue in about 20m, got: %s", result.RequeueAfter)
+	}
+	want := lastSchedule.Add(30 * time.Minute)
+	if experiment.Status.NextScheduleTime == nil || !experiment.Status.NextScheduleTime.Time.Equal(want) {
+		t.Errorf("Expected next schedule time %s, got: %v", want, experiment.Status.NextScheduleTime)
+	}
+}
+
+func TestScheduledExperimentTriggerAnnotation(t *testing.T) {
+	fisAPI := &awsfake.FIS{}
+	experiment := newScheduledExperiment("trigger-test")
+	// Not due: the daily run already happened less than a day ago
+	lastSchedule := metav1.NewTime(time.Now().Add(-time.Minute).Truncate(time.Second))
+	experiment.Status.LastScheduleTime = &lastSchedule
+	experiment.Annotations = map[string]string{triggerAnnotation: "run-1"}
+	reconciler := newTestReconciler(fisAPI, experiment)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if _, err := reconciler.handleScheduledExperiment(ctx, experiment, logr.Discard()); err != nil {
+			t.Fatalf("handleScheduledExperiment failed: %v", err)
+		}
+	}
+	if len(fisAPI.StartExperimentInputs) != 1 {
+		t.Fatalf("Expected 1 StartExperiment call for one token, got: %d", len(fisAPI.StartExperimentInputs))
+	}
+	if experiment.Status.LastTriggerToken != "run-1" {
+		t.Errorf("Expected LastTriggerToken run-1, got: %s", experiment.Status.LastTriggerToken)
+	}
+	if !experiment.Status.LastScheduleTime.Equal(&lastSchedule) {
+		t.Errorf("Expected a manual trigger to leave LastScheduleTime alone, got: %v", experiment.Status.LastScheduleTime)
+	}
+
+	experiment.Annotations[triggerAnnotation] = "run-2"
+	if _, err := reconciler.handleScheduledExperiment(ctx, experiment, logr.Discard()); err != nil {
+		t.Fatalf("handleScheduledExperiment failed: %v", err)
+	}
+	if len(fisAPI.StartExperimentInputs) != 2 {
+		t.Errorf("Expected a second StartExperiment call for a new token, got: %d", len(fisAPI.StartExperimentInputs))
+	}
+}
+
+func TestStartExperimentRecordsConsoleURL(t *testing.T) {
+	fisAPI := &awsfake.FIS{}
+	experiment := newScheduledExperiment("console-test")
+	experiment.Spec.Schedule = ""
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	if _, err := reconciler.startExperiment(context.Background(), experiment, "", logr.Discard()); err != nil {
+		t.Fatalf("startExperiment failed: %v", err)
+	}
+
+	want := "https://ap-northeast-2.console.aws.amazon.com/fis/home?region=ap-northeast-2#ExperimentDetails:ExperimentId=EXPfake1"
+	if experiment.Status.ConsoleURL != want {
+		t.Errorf("Expected console URL %s, got: %s", want, experiment.Status.ConsoleURL)
+	}
+}
+
+func TestCleanupRecordsExperimentHistory(t *testing.T) {
+	now := time.Now()
+	// owner is the Experiment the run was started for
+	summary := func(templateID *string, owner, id string, status types.ExperimentStatus, age time.Duration) types.ExperimentSummary {
+		created := now.Add(-age)
+		return types.ExperimentSummary{
+			Id:                   aws.String(id),
+			ExperimentTemplateId: templateID,
+			State:                &types.ExperimentState{Status: status},
+			CreationTime:         &created,
+			Tags:                 map[string]string{awsfis.ExperimentNameTag: owner, awsfis.ExperimentNamespaceTag: ""},
+		}
+	}
+	fisAPI := &awsfake.FIS{
+		ListExperimentsFunc: func(params *fis.ListExperimentsInput) (*fis.ListExperimentsOutput, error) {
+			templateID := params.ExperimentTemplateId
+			return &fis.ListExperimentsOutput{
+				Experiments: []types.ExperimentSummary{
+					summary(templateID, "history-test", "EXPold", types.ExperimentStatusCompleted, 72*time.Hour),
+					summary(templateID, "history-test", "EXPfailed", types.ExperimentStatusFailed, 48*time.Hour),
+					summary(templateID, "history-test", "EXPolderfailed", types.ExperimentStatusFailed, 96*time.Hour),
+					summary(templateID, "history-test", "EXPdone", types.ExperimentStatusCompleted, 24*time.Hour),
+					summary(templateID, "history-test", "EXPnew", types.ExperimentStatusInitiating, time.Minute),
+					// Newer runs of another Experiment sharing the template are not part of this history
+					summary(templateID, "other-experiment", "EXPforeigndone", types.ExperimentStatusCompleted, time.Hour),
+					summary(templateID, "other-experiment", "EXPforeignnew", types.ExperimentStatusRunning, time.Second),
+				},
+			}, nil
+		},
+	}
+	experiment := newScheduledExperiment("history-test")
+	experiment.Spec.SuccessfulExperimentsHistoryLimit = aws.Int32(1)
+	experiment.Spec.FailedExperimentsHistoryLimit = aws.Int32(1)
+	endTime := metav1.NewTime(now.Add(-23 * time.Hour))
+	experiment.Status.ExperimentHistory = []fisv1alpha1.ExperimentRef{{ID: "EXPdone", State: "running", EndTime: &endTime}}
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	if err := reconciler.cleanupExperimentHistory(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("cleanupExperimentHistory failed: %v", err)
+	}
+
+	// Capped at 1 + 1 entries: the new run and the latest completed one
+	history := experiment.Status.ExperimentHistory
+	if len(history) != 2 {
+		t.Fatalf("Expected 2 history entries, got: %+v", history)
+	}
+	if history[0].ID != "EXPnew" || history[1].ID != "EXPdone" {
+		t.Errorf("Expected [EXPnew EXPdone], got: [%s %s]", history[0].ID, history[1].ID)
+	}
+	if history[1].State != "completed" {
+		t.Errorf("Expected the completed run to be recorded with its final state, got: %s", history[1].State)
+	}
+	if history[1].EndTime == nil || !history[1].EndTime.Equal(&endTime) {
+		t.Errorf("Expected the known end time to be kept, got: %v", history[1].EndTime)
+	}
+}
+
+func TestCleanupStopsActiveExperimentsOverHistoryLimit(t *testing.T) {
+	now := time.Now()
+	// owner is the Experiment the run was started for, empty for runs started outside the controller
+	summary := func(templateID *string, owner, id string, status types.ExperimentStatus, age time.Duration) types.ExperimentSummary {
+		created := now.Add(-age)
+		run := types.ExperimentSummary{Id: aws.String(id), ExperimentTemplateId: templateID, State: &types.ExperimentState{Status: status}, CreationTime: &created}
+		if owner != "" {
+			run.Tags = map[string]string{awsfis.ExperimentNameTag: owner, awsfis.ExperimentNamespaceTag: ""}
+		}
+		return run
+	}
+	listRuns := func(params *fis.ListExperimentsInput) (*fis.ListExperimentsOutput, error) {
+		templateID := params.ExperimentTemplateId
+		return &fis.ListExperimentsOutput{
+			Experiments: []types.ExperimentSummary{
+				summary(templateID, "over-limit-a", "EXPnew", types.ExperimentStatusRunning, time.Minute),
+				summary(templateID, "over-limit-a", "EXPmid", types.ExperimentStatusInitiating, 2*time.Minute),
+				summary(templateID, "over-limit-a", "EXPold", types.ExperimentStatusRunning, 3*time.Minute),
+				summary(templateID, "over-limit-a", "EXPstopping", types.ExperimentStatusStopping, 4*time.Minute),
+				summary(templateID, "over-limit-a", "EXPcurrent", types.ExperimentStatusRunning, 5*time.Minute),
+				summary(templateID, "over-limit-b", "EXPother", types.ExperimentStatusRunning, 6*time.Minute),
+				summary(templateID, "over-limit-b", "EXPotherold", types.ExperimentStatusRunning, 7*time.Minute),
+				summary(templateID, "over-limit-b", "EXPothernew", types.ExperimentStatusRunning, 30*time.Second),
+				summary(templateID, "", "EXPmanual", types.ExperimentStatusRunning, 8*time.Minute),
+				summary(templateID, "over-limit-a", "EXPdone", types.ExperimentStatusCompleted, time.Hour),
+			},
+		}, nil
+	}
+
+	tests := []struct {
+		name        string
+		experiment  string
+		current     string
+		limit       int32
+		wantStopped []string
+	}{
+		// Only the two newest runs fit in the history; of the older active runs, the current run,
+		// the one already stopping and the runs of other Experiments or started manually are kept
+		{name: "own runs only", experiment: "over-limit-a", current: "EXPcurrent", limit: 1, wantStopped: []string{"EXPold"}},
+		{name: "other experiment sharing the template", experiment: "over-limit-b", current: "EXPother", limit: 1, wantStopped: []string{"EXPotherold"}},
+		{name: "zero limits", experiment: "over-limit-a", current: "EXPcurrent", limit: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fisAPI := &awsfake.FIS{ListExperimentsFunc: listRuns}
+			experiment := newScheduledExperiment(tt.experiment)
+			experiment.Spec.SuccessfulExperimentsHistoryLimit = aws.Int32(tt.limit)
+			experiment.Spec.FailedExperimentsHistoryLimit = aws.Int32(tt.limit)
+			experiment.Status.ExperimentID = tt.current
+			reconciler := newTestReconciler(fisAPI, experiment)
+
+			if err := reconciler.cleanupExperimentHistory(context.Background(), experiment, logr.Discard()); err != nil {
+				t.Fatalf("cleanupExperimentHistory failed: %v", err)
+			}
+
+			var stopped []string
+			for _, input := range fisAPI.StopExperimentInputs {
+				stopped = append(stopped, aws.ToString(input.Id))
+			}
+			if !slices.Equal(stopped, tt.wantStopped) {
+				t.Errorf("Expected stopped runs %v, got: %v", tt.wantStopped, stopped)
+			}
+		})
+	}
+}
+
+func TestCleanupReplacesRetriedRunInHistory(t *testing.T) {
+	now := time.Now()
+	summary := func(templateID *string, id, token string, status types.ExperimentStatus, age time.Duration) types.ExperimentSummary {
+		created := now.Add(-age)
+		return types.ExperimentSummary{
+			Id:                   aws.String(id),
+			ExperimentTemplateId: templateID,
+			State:                &types.ExperimentState{Status: status},
+			CreationTime:         &created,
+			Tags: map[string]string{
+				awsfis.ClientTokenTag:         token,
+				awsfis.ExperimentNameTag:      "retry-history-test",
+				awsfis.ExperimentNamespaceTag: "",
+			},
+		}
+	}
+	fisAPI := &awsfake.FIS{
+		ListExperimentsFunc: func(params *fis.ListExperimentsInput) (*fis.ListExperimentsOutput, error) {
+			templateID := params.ExperimentTemplateId
+			return &fis.ListExperimentsOutput{
+				Experiments: []types.ExperimentSummary{
+					summary(templateID, "EXPyesterday", "token-yesterday", types.ExperimentStatusCompleted, 24*time.Hour),
+					summary(templateID, "EXPfirst", "token-today", types.ExperimentStatusFailed, 10*time.Minute),
+					summary(templateID, "EXPretry", "token-today", types.ExperimentStatusRunning, time.Minute),
+				},
+			}, nil
+		},
+	}
+	experiment := newScheduledExperiment("retry-history-test")
+	experiment.Status.ExperimentHistory = []fisv1alpha1.ExperimentRef{
+		{ID: "EXPfirst", State: "failed", ClientToken: "token-today"},
+		{ID: "EXPyesterday", State: "completed", ClientToken: "token-yesterday"},
+	}
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	if err := reconciler.cleanupExperimentHistory(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("cleanupExperimentHistory failed: %v", err)
+	}
+
+	history := experiment.Status.ExperimentHistory
+	if len(history) != 2 {
+		t.Fatalf("Expected the retry to replace the first attempt, got: %+v", history)
+	}
+	if history[0].ID != "EXPretry" || history[0].State != "running" || history[0].ClientToken != "token-today" {
+		t.Errorf("Expected the retried run to be recorded under its token, got: %+v", history[0])
+	}
+	if history[1].ID != "EXPyesterday" {
+		t.Errorf("Expected the previous run to be kept, got: %+v", history[1])
+	}
+}
+
+func TestRunClientTokenIsStablePerRun(t *testing.T) {
+	experiment := newScheduledExperiment("token-test")
+	experiment.UID = "d6f1c2a0-1111-2222-3333-444455556666"
+
+	first := runClientToken(experiment, "schedule/2026-03-01T02:00:00Z")
+	if retry := runClientToken(experiment, "schedule/2026-03-01T02:00:00Z"); retry != first {
+		t.Errorf("Expected a retry of the same run to reuse token %s, got: %s", first, retry)
+	}
+	if next := runClientToken(experiment, "schedule/2026-03-02T02:00:00Z"); next == first {
+		t.Errorf("Expected the next run to get a new token")
+	}
+	if len(first) > 64 {
+		t.Errorf("Expected a token of at most 64 characters, got %d", len(first))
+	}
+
+	fisAPI := &awsfake.FIS{}
+	experiment.Spec.Schedule = ""
+	reconciler := newTestReconciler(fisAPI, experiment)
+	if _, err := reconciler.startExperiment(context.Background(), experiment, "schedule/2026-03-01T02:00:00Z", logr.Discard()); err != nil {
+		t.Fatalf("startExperiment failed: %v", err)
+	}
+	input := fisAPI.StartExperimentInputs[0]
+	if aws.ToString(input.ClientToken) != first || input.Tags[awsfis.ClientTokenTag] != first {
+		t.Errorf("Expected client token and tag %s, got: %s / %s", first, aws.ToString(input.ClientToken), input.Tags[awsfis.ClientTokenTag])
+	}
+}
+
+func TestSuspendAndResumeRearmsSchedule(t *testing.T) {
+	fisAPI := &awsfake.FIS{}
+	experiment := newScheduledExperiment("suspend-test")
+	experiment.Finalizers = []string{experimentFinalizer}
+	experiment.Spec.Suspend = aws.Bool(true)
+	lastSchedule := metav1.NewTime(time.Now().Add(-72 * time.Hour))
+	experiment.Status.LastScheduleTime = &lastSchedule
+	reconciler := newTestReconciler(fisAPI, experiment)
+	recorder := record.NewFakeRecorder(10)
+	reconciler.Recorder = recorder
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: client.ObjectKeyFromObject(experiment)}
+
+	result, err := reconciler.Reconcile(ctx, req)
+	if err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if !result.IsZero() {
+		t.Errorf("Expected no requeue while suspended, got: %+v", result)
+	}
+
+	current := &fisv1alpha1.Experiment{}
+	if err := reconciler.Get(ctx, req.NamespacedName, current); err != nil {
+		t.Fatalf("Failed to get Experiment: %v", err)
+	}
+	if !meta.IsStatusConditionTrue(current.Status.Conditions, fisv1alpha1.ConditionSuspended) {
+		t.Errorf("Expected Suspended condition to be True, got: %v", current.Status.Conditions)
+	}
+	if event := <-recorder.Events; !strings.Contains(event, fisv1alpha1.ReasonSuspended) {
+		t.Errorf("Expected a Suspended event, got: %s", event)
+	}
+
+	// Un-suspend, as kubectl patch would
+	current.Spec.Suspend = aws.Bool(false)
+	if err := reconciler.Update(ctx, current); err != nil {
+		t.Fatalf("Failed to update Experiment: %v", err)
+	}
+	result, err = reconciler.Reconcile(ctx, req)
+	if err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+
+	if err := reconciler.Get(ctx, req.NamespacedName, current); err != nil {
+		t.Fatalf("Failed to get Experiment: %v", err)
+	}
+	cond := meta.FindStatusCondition(current.Status.Conditions, fisv1alpha1.ConditionSuspended)
+	if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != fisv1alpha1.ReasonResumed {
+		t.Errorf("Expected Suspended condition False with reason Resumed, got: %+v", cond)
+	}
+	if event := <-recorder.Events; !strings.Contains(event, fisv1alpha1.ReasonResumed) {
+		t.Errorf("Expected a Resumed event, got: %s", event)
+	}
+
+	// Runs missed while suspended are skipped and the next run is armed
+	if len(fisAPI.StartExperimentInputs) != 0 {
+		t.Errorf("Expected no catch-up run after resuming, got %d StartExperiment calls", len(fisAPI.StartExperimentInputs))
+	}
+	if result.RequeueAfter <= 0 || result.RequeueAfter > 24*time.Hour {
+		t.Errorf("Expected requeue for the next daily run, got: %+v", result)
+	}
+	if current.Status.NextScheduleTime == nil || !current.Status.NextScheduleTime.After(time.Now()) {
+		t.Errorf("Expected next schedule time in the future, got: %v", current.Status.NextScheduleTime)
+	}
+}
+
+func TestStartExperimentDeferredByFreeze(t *testing.T) {
+	fisAPI := &awsfake.FIS{}
+	experiment := newScheduledExperiment("freeze-test")
+	experiment.Spec.Schedule = ""
+	reconciler := newTestReconciler(fisAPI, experiment)
+	recorder := record.NewFakeRecorder(10)
+	reconciler.Recorder = recorder
+
+	coreScheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(coreScheme)
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "fis-controller-config",
+			Namespace:   "fis-system",
+			Annotations: map[string]string{freezeReasonAnnotation: "Black Friday change freeze"},
+		},
+	}
+	apiReader := fake.NewClientBuilder().WithScheme(coreScheme).WithObjects(configMap).Build()
+	reconciler.APIReader = apiReader
+	reconciler.ConfigMap = client.ObjectKeyFromObject(configMap)
+	ctx := context.Background()
+
+	result, err := reconciler.startExperiment(ctx, experiment, "", logr.Discard())
+	if err != nil {
+		t.Fatalf("startExperiment failed: %v", err)
+	}
+	if result.RequeueAfter != freezePollInterval {
+		t.Errorf("Expected requeue after %v, got: %+v", freezePollInterval, result)
+	}
+	if len(fisAPI.StartExperimentInputs) != 0 {
+		t.Fatalf("Expected no StartExperiment calls while frozen, got: %d", len(fisAPI.StartExperimentInputs))
+	}
+
+	cond := meta.FindStatusCondition(experiment.Status.Conditions, fisv1alpha1.ConditionFrozen)
+	if cond == nil || cond.Status != metav1.ConditionTrue || !strings.Contains(cond.Message, "Black Friday change freeze") {
+		t.Errorf("Expected Frozen condition with the freeze reason, got: %+v", cond)
+	}
+	if !strings.Contains(experiment.Status.Reason, "Black Friday change freeze") {
+		t.Errorf("Expected status reason to carry the freeze reason, got: %q", experiment.Status.Reason)
+	}
+	select {
+	case event := <-recorder.Events:
+		if !strings.Contains(event, fisv1alpha1.ReasonFrozen) || !strings.Contains(event, "Black Friday change freeze") {
+			t.Errorf("Unexpected event: %s", event)
+		}
+	default:
+		t.Errorf("Expected a Frozen event")
+	}
+
+	// A repeated check with the same reason does not emit another event
+	if _, err := reconciler.startExperiment(ctx, experiment, "", logr.Discard()); err != nil {
+		t.Fatalf("startExperiment failed: %v", err)
+	}
+	if len(recorder.Events) != 0 {
+		t.Errorf("Expected no duplicate Frozen event, got: %s", <-recorder.Events)
+	}
+
+	// Lifting the freeze lets the deferred start through
+	configMap.Annotations = nil
+	if err := apiReader.Update(ctx, configMap); err != nil {
+		t.Fatalf("Failed to lift freeze: %v", err)
+	}
+	if _, err := reconciler.startExperiment(ctx, experiment, "", logr.Discard()); err != nil {
+		t.Fatalf("startExperiment failed: %v", err)
+	}
+	if len(fisAPI.StartExperimentInputs) != 1 {
+		t.Errorf("Expected 1 StartExperiment call after the freeze, got: %d", len(fisAPI.StartExperimentInputs))
+	}
+	if meta.IsStatusConditionTrue(experiment.Status.Conditions, fisv1alpha1.ConditionFrozen) {
+		t.Errorf("Expected Frozen condition to be cleared after starting")
+	}
+}
+
+func TestReconcileStopRequested(t *testing.T) {
+	tests := []struct {
+		name      string
+		awsState  types.ExperimentStatus
+		wantStops int
+		wantState string
+	}{
+		{name: "running experiment is stopped", awsState: types.ExperimentStatusRunning, wantStops: 1, wantState: "stopping"},
+		{name: "completed experiment is left alone", awsState: types.ExperimentStatusCompleted, wantStops: 0, wantState: "completed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fisAPI := &awsfake.FIS{
+				GetExperimentFunc: func(params *fis.GetExperimentInput) (*fis.GetExperimentOutput, error) {
+					return &fis.GetExperimentOutput{
+						Experiment: &types.Experiment{Id: params.Id, State: &types.ExperimentState{Status: tt.awsState}},
+					}, nil
+				},
+			}
+			experiment := newScheduledExperiment("stop-test")
+			experiment.Spec.Schedule = ""
+			experiment.Spec.Stop = aws.Bool(true)
+			experiment.Finalizers = []string{experimentFinalizer}
+			experiment.Status.ExperimentID = "EXP1234567890abcdef"
+			// The recorded state lags behind AWS for the completed case
+			experiment.Status.State = "running"
+			experiment.Status.Active = 1
+			reconciler := newTestReconciler(fisAPI, experiment)
+			ctx := context.Background()
+
+			if _, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(experiment)}); err != nil {
+				t.Fatalf("Reconcile failed: %v", err)
+			}
+
+			if len(fisAPI.StopExperimentInputs) != tt.wantStops {
+				t.Errorf("Expected %d StopExperiment calls, got: %d", tt.wantStops, len(fisAPI.StopExperimentInputs))
+			}
+			if len(fisAPI.StartExperimentInputs) != 0 {
+				t.Errorf("Expected no StartExperiment calls while stop is set, got: %d", len(fisAPI.StartExperimentInputs))
+			}
+			updated := &fisv1alpha1.Experiment{}
+			if err := reconciler.Get(ctx, client.ObjectKeyFromObject(experiment), updated); err != nil {
+				t.Fatalf("Failed to get Experiment: %v", err)
+			}
+			if updated.Status.State != tt.wantState {
+				t.Errorf("Expected state %s, got: %s", tt.wantState, updated.Status.State)
+			}
+		})
+	}
+}
+
+func TestStartExperimentRecordsStartedEvent(t *testing.T) {
+	fisAPI := &awsfake.FIS{}
+	experiment := newScheduledExperiment("started-event-test")
+	experiment.Spec.Schedule = ""
+	reconciler := newTestReconciler(fisAPI, experiment)
+	recorder := record.NewFakeRecorder(10)
+	reconciler.Recorder = recorder
+
+	if _, err := reconciler.startExperiment(context.Background(), experiment, "", logr.Discard()); err != nil {
+		t.Fatalf("startExperiment failed: %v", err)
+	}
+
+	select {
+	case event := <-recorder.Events:
+		if !strings.HasPrefix(event, "Normal "+fisv1alpha1.ReasonStarted) || !strings.Contains(event, "EXPfake1") {
+			t.Errorf("Expected a Started event with the experiment ID, got: %s", event)
+		}
+	default:
+		t.Fatalf("Expected a Started event")
+	}
+}
+
+func TestSyncExperimentStateRecordsFailedEvent(t *testing.T) {
+	fisAPI := &awsfake.FIS{
+		GetExperimentFunc: func(params *fis.GetExperimentInput) (*fis.GetExperimentOutput, error) {
+			return &fis.GetExperimentOutput{
+				Experiment: &types.Experiment{
+					Id: params.Id,
+					State: &types.ExperimentState{
+						Status: types.ExperimentStatusFailed,
+						Reason: aws.String("Stop condition triggered"),
+					},
+					EndTime: aws.Time(time.Now()),
+				},
+			}, nil
+		},
+	}
+	experiment := newScheduledExperiment("failed-event-test")
+	experiment.Spec.Schedule = ""
+	experiment.Status.ExperimentID = "EXP1234567890abcdef"
+	experiment.Status.State = "running"
+	reconciler := newTestReconciler(fisAPI, experiment)
+	recorder := record.NewFakeRecorder(10)
+	reconciler.Recorder = recorder
+
+	if _, err := reconciler.syncExperimentState(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("syncExperimentState failed: %v", err)
+	}
+
+	select {
+	case event := <-recorder.Events:
+		if !strings.HasPrefix(event, "Warning "+fisv1alpha1.ReasonFailed) ||
+			!strings.Contains(event, "EXP1234567890abcdef") || !strings.Contains(event, "Stop condition triggered") {
+			t.Errorf("Expected a Failed warning with the experiment ID and reason, got: %s", event)
+		}
+	default:
+		t.Fatalf("Expected a Failed event")
+	}
+
+	// An unchanged state does not emit the event again
+	if _, err := reconciler.syncExperimentState(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("syncExperimentState failed: %v", err)
+	}
+	if len(recorder.Events) != 0 {
+		t.Errorf("Expected no repeated event, got: %s", <-recorder.Events)
+	}
+}
+
+func TestSyncExperimentStateSetsStateConditions(t *testing.T) {
+	status := types.ExperimentStatusRunning
+	fisAPI := &awsfake.FIS{
+		GetExperimentFunc: func(params *fis.GetExperimentInput) (*fis.GetExperimentOutput, error) {
+			experiment := &types.Experiment{Id: params.Id, State: &types.ExperimentState{Status: status}}
+			if status == types.ExperimentStatusCompleted {
+				experiment.EndTime = aws.Time(time.Now())
+			}
+			return &fis.GetExperimentOutput{Experiment: experiment}, nil
+		},
+	}
+	experiment := newScheduledExperiment("conditions-test")
+	experiment.Spec.Schedule = ""
+	experiment.Generation = 1
+	experiment.Status.ExperimentID = "EXP1234567890abcdef"
+	experiment.Status.State = "initiating"
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	if _, err := reconciler.syncExperimentState(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("syncExperimentState failed: %v", err)
+	}
+	running := meta.FindStatusCondition(experiment.Status.Conditions, fisv1alpha1.ConditionRunning)
+	if running == nil || running.Status != metav1.ConditionTrue || running.Reason != "Running" || running.ObservedGeneration != 1 {
+		t.Errorf("Expected Running=True with reason Running for generation 1, got: %+v", running)
+	}
+	if meta.IsStatusConditionTrue(experiment.Status.Conditions, fisv1alpha1.ConditionSucceeded) {
+		t.Error("Expected Succeeded to be False while running")
+	}
+
+	status = types.ExperimentStatusCompleted
+	if _, err := reconciler.syncExperimentState(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("syncExperimentState failed: %v", err)
+	}
+	if meta.IsStatusConditionTrue(experiment.Status.Conditions, fisv1alpha1.ConditionRunning) {
+		t.Error("Expected Running to be False once completed")
+	}
+	succeeded := meta.FindStatusCondition(experiment.Status.Conditions, fisv1alpha1.ConditionSucceeded)
+	if succeeded == nil || succeeded.Status != metav1.ConditionTrue || succeeded.Reason != "Completed" {
+		t.Errorf("Expected Succeeded=True with reason Completed, got: %+v", succeeded)
+	}
+	if meta.IsStatusConditionTrue(experiment.Status.Conditions, fisv1alpha1.ConditionFailed) {
+		t.Error("Expected Failed to be False once completed")
+	}
+}
+
+func TestStartExperimentPassesExperimentOptions(t *testing.T) {
+	fisAPI := &awsfake.FIS{}
+	experiment := newScheduledExperiment("options-test")
+	experiment.Spec.Schedule = ""
+	experiment.Spec.ExperimentOptions = &fisv1alpha1.ExperimentRunOptions{ActionsMode: "skip-all"}
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	if _, err := reconciler.startExperiment(context.Background(), experiment, "", logr.Discard()); err != nil {
+		t.Fatalf("startExperiment failed: %v", err)
+	}
+
+	if len(fisAPI.StartExperimentInputs) != 1 {
+		t.Fatalf("Expected 1 StartExperiment call, got: %d", len(fisAPI.StartExperimentInputs))
+	}
+	opts := fisAPI.StartExperimentInputs[0].ExperimentOptions
+	if opts == nil || opts.ActionsMode != types.ActionsModeSkipAll {
+		t.Errorf("Expected actionsMode skip-all in StartExperimentInput, got: %+v", opts)
+	}
+}
+
+func TestStartExperimentMergesTemplateTags(t *testing.T) {
+	fisAPI := &awsfake.FIS{}
+	experiment := newScheduledExperiment("tags-test")
+	experiment.Spec.Schedule = ""
+	experiment.Spec.ExperimentTemplate = fisv1alpha1.ExperimentTemplateRef{Name: "tagged-template"}
+	experiment.Spec.Tags = []fisv1alpha1.Tag{
+		{Key: "env", Value: "staging"},
+		{Key: "run", Value: "adhoc"},
+	}
+	reconciler := newTestReconciler(fisAPI, experiment)
+	ctx := context.Background()
+
+	template := &fisv1alpha1.ExperimentTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "tagged-template"},
+		Spec: fisv1alpha1.ExperimentTemplateSpec{Tags: []fisv1alpha1.Tag{
+			{Key: "team", Value: "sre"},
+			{Key: "env", Value: "prod"},
+		}},
+		Status: fisv1alpha1.ExperimentTemplateStatus{TemplateID: "EXT1234567890abcdef"},
+	}
+	if err := reconciler.Create(ctx, template); err != nil {
+		t.Fatalf("Failed to create template: %v", err)
+	}
+
+	if _, err := reconciler.startExperiment(ctx, experiment, "", logr.Discard()); err != nil {
+		t.Fatalf("startExperiment failed: %v", err)
+	}
+	if len(fisAPI.StartExperimentInputs) != 1 {
+		t.Fatalf("Expected 1 StartExperiment call, got: %d", len(fisAPI.StartExperimentInputs))
+	}
+
+	tags := fisAPI.StartExperimentInputs[0].Tags
+	for key, want := range map[string]string{
+		"team":                    "sre",
+		"env":                     "staging",
+		"run":                     "adhoc",
+		"ManagedBy":               "aws-fis-controller",
+		"kubernetes.io/name":      "tags-test",
+		"kubernetes.io/namespace": "",
+	} {
+		if got, ok := tags[key]; !ok || got != want {
+			t.Errorf("Expected tag %s=%q, got: %q (present %v)", key, want, got, ok)
+		}
+	}
+	if _, ok := tags[awsfis.ClientTokenTag]; !ok {
+		t.Errorf("Expected the %s tag to be kept, got: %v", awsfis.ClientTokenTag, tags)
+	}
+}
+
+func TestStartExperimentRecordsRunOrigin(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule string
+		runKey   string
+		want     fisv1alpha1.RunOrigin
+	}{
+		{name: "scheduled run", schedule: "0 2 * * *", runKey: "schedule/2026-03-01T02:00:00Z", want: fisv1alpha1.RunOriginScheduled},
+		{name: "triggered run", schedule: "0 2 * * *", runKey: "trigger/rerun-1", want: fisv1alpha1.RunOriginManual},
+		{name: "one-time run", want: fisv1alpha1.RunOriginManual},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			experiment := newScheduledExperiment("origin-test")
+			experiment.Spec.Schedule = tt.schedule
+			reconciler := newTestReconciler(&awsfake.FIS{}, experiment)
+
+			if _, err := reconciler.startExperiment(context.Background(), experiment, tt.runKey, logr.Discard()); err != nil {
+				t.Fatalf("startExperiment failed: %v", err)
+			}
+
+			updated := &fisv1alpha1.Experiment{}
+			if err := reconciler.Get(context.Background(), client.ObjectKeyFromObject(experiment), updated); err != nil {
+				t.Fatalf("Failed to get experiment: %v", err)
+			}
+			if updated.Status.LastRunOrigin != tt.want {
+				t.Errorf("Expected lastRunOrigin %q, got %q", tt.want, updated.Status.LastRunOrigin)
+			}
+		})
+	}
+}
+
+func TestStartExperimentRecordsControllerIdentity(t *testing.T) {
+	fisAPI := &awsfake.FIS{}
+	experiment := newScheduledExperiment("identity-test")
+	experiment.Spec.Schedule = ""
+	reconciler := newTestReconciler(fisAPI, experiment)
+	reconciler.Identity = "aws-fis-controller-manager-7d9f8-abcde"
+
+	if _, err := reconciler.startExperiment(context.Background(), experiment, "", logr.Discard()); err != nil {
+		t.Fatalf("startExperiment failed: %v", err)
+	}
+
+	if len(fisAPI.StartExperimentInputs) != 1 {
+		t.Fatalf("Expected 1 StartExperiment call, got: %d", len(fisAPI.StartExperimentInputs))
+	}
+	if got := fisAPI.StartExperimentInputs[0].Tags[awsfis.StartedByTag]; got != reconciler.Identity {
+		t.Errorf("Expected tag %s=%s, got: %q", awsfis.StartedByTag, reconciler.Identity, got)
+	}
+
+	updated := &fisv1alpha1.Experiment{}
+	if err := reconciler.Get(context.Background(), client.ObjectKeyFromObject(experiment), updated); err != nil {
+		t.Fatalf("Failed to get experiment: %v", err)
+	}
+	if updated.Status.StartedBy != reconciler.Identity {
+		t.Errorf("Expected status.startedBy %s, got: %q", reconciler.Identity, updated.Status.StartedBy)
+	}
+}
+
+func TestSyncExperimentStateReconcilesTagsOnRunningExperiment(t *testing.T) {
+	arn := "arn:aws:fis:ap-northeast-2:123456789012:experiment/EXP1234567890abcdef"
+	fisAPI := &awsfake.FIS{
+		GetExperimentFunc: func(params *fis.GetExperimentInput) (*fis.GetExperimentOutput, error) {
+			return &fis.GetExperimentOutput{
+				Experiment: &types.Experiment{
+					Id:    params.Id,
+					Arn:   aws.String(arn),
+					State: &types.ExperimentState{Status: types.ExperimentStatusRunning},
+					Tags: map[string]string{
+						"ManagedBy":               "aws-fis-controller",
+						"kubernetes.io/name":      "tags-test",
+						"kubernetes.io/namespace": "",
+						awsfis.ClientTokenTag:     "token-1",
+						"team":                    "platform",
+						"obsolete":                "true",
+					},
+				},
+			}, nil
+		},
+	}
+	experiment := newScheduledExperiment("tags-test")
+	experiment.Spec.Schedule = ""
+	experiment.Spec.Tags = []fisv1alpha1.Tag{
+		{Key: "team", Value: "platform"},
+		{Key: "cost-center", Value: "1234"},
+	}
+	experiment.Status.ExperimentID = "EXP1234567890abcdef"
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	if _, err := reconciler.syncExperimentState(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("syncExperimentState failed: %v", err)
+	}
+
+	if len(fisAPI.TagResourceInputs) != 1 {
+		t.Fatalf("Expected 1 TagResource call, got: %d", len(fisAPI.TagResourceInputs))
+	}
+	tagged := fisAPI.TagResourceInputs[0]
+	if aws.ToString(tagged.ResourceArn) != arn {
+		t.Errorf("Expected experiment ARN %s to be tagged, got: %s", arn, aws.ToString(tagged.ResourceArn))
+	}
+	if len(tagged.Tags) != 1 || tagged.Tags["cost-center"] != "1234" {
+		t.Errorf("Expected only the added cost-center tag, got: %v", tagged.Tags)
+	}
+
+	if len(fisAPI.UntagResourceInputs) != 1 {
+		t.Fatalf("Expected 1 UntagResource call, got: %d", len(fisAPI.UntagResourceInputs))
+	}
+	if keys := fisAPI.UntagResourceInputs[0].TagKeys; len(keys) != 1 || keys[0] != "obsolete" {
+		t.Errorf("Expected only the obsolete tag to be removed, got: %v", keys)
+	}
+}
+
+func TestSyncExperimentStateLeavesTagsOfFinishedExperiment(t *testing.T) {
+	fisAPI := &awsfake.FIS{
+		GetExperimentFunc: func(params *fis.GetExperimentInput) (*fis.GetExperimentOutput, error) {
+			return &fis.GetExperimentOutput{
+				Experiment: &types.Experiment{
+					Id:      params.Id,
+					Arn:     aws.String("arn:aws:fis:ap-northeast-2:123456789012:experiment/" + aws.ToString(params.Id)),
+					State:   &types.ExperimentState{Status: types.ExperimentStatusCompleted},
+					EndTime: aws.Time(time.Now()),
+				},
+			}, nil
+		},
+	}
+	experiment := newScheduledExperiment("finished-tags-test")
+	experiment.Spec.Schedule = ""
+	experiment.Spec.Tags = []fisv1alpha1.Tag{{Key: "cost-center", Value: "1234"}}
+	experiment.Status.ExperimentID = "EXP1234567890abcdef"
+	reconciler := newTestReconciler(fisAPI, experiment)
+
+	if _, err := reconciler.syncExperimentState(context.Background(), experiment, logr.Discard()); err != nil {
+		t.Fatalf("syncExperimentState failed: %v", err)
+	}
+	if len(fisAPI.TagResourceInputs) != 0 || len(fisAPI.UntagResourceInputs) != 0 {
+		t.Errorf("Expected no tag changes on a finished experiment, got %d tag and %d untag calls",
+			len(fisAPI.TagResourceInputs), len(fisAPI.UntagResourceInputs))
+	}
+}
+
+func TestStartExperimentHonorsTemplateConcurrencyLimit(t *testing.T) {
+	tests := []struct {
+		name        string
+		states      []types.ExperimentStatus
+		wantStarted bool
+	}{
+		{name: "below the limit", states: []types.ExperimentStatus{types.ExperimentStatusRunning, types.ExperimentStatusCompleted}, wantStarted: true},
+		{name: "at the limit", states: []types.ExperimentStatus{types.ExperimentStatusRunning, types.ExperimentStatusInitiating}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fisAPI := &awsfake.FIS{
+				ListExperimentsFunc: func(*fis.ListExperimentsInput) (*fis.ListExperimentsOutput, error) {
+					output := &fis.ListExperimentsOutput{}
+					for i, state := range tt.states {
+						output.Experiments = append(output.Experiments, types.ExperimentSummary{
+							Id:                   aws.String(fmt.Sprintf("EXPother%d", i)),
+							ExperimentTemplateId: aws.String("EXT1234567890abcdef"),
+							State:                &types.ExperimentState{Status: state},
+						})
+					}
+					return output, nil
+				},
+			}
+			experiment := newScheduledExperiment("concurrency-limit-test")
+			experiment.Spec.Schedule = ""
+			experiment.Spec.ExperimentTemplate = fisv1alpha1.ExperimentTemplateRef{Name: "limited-template"}
+			reconciler := newTestReconciler(fisAPI, experiment)
+			recorder := record.NewFakeRecorder(10)
+			reconciler.Recorder = recorder
+			ctx := context.Background()
+
+			limit := int32(2)
+			template := &fisv1alpha1.ExperimentTemplate{
+				ObjectMeta: metav1.ObjectMeta{Name: "limited-template"},
+				Spec:       fisv1alpha1.ExperimentTemplateSpec{MaxConcurrentExperiments: &limit},
+				Status:     fisv1alpha1.ExperimentTemplateStatus{TemplateID: "EXT1234567890abcdef"},
+			}
+			if err := reconciler.Create(ctx, template); err != nil {
+				t.Fatalf("Failed to create template: %v", err)
+			}
+
+			result, err := reconciler.startExperiment(ctx, experiment, "", logr.Discard())
+			if err != nil {
+				t.Fatalf("startExperiment failed: %v", err)
+			}
+
+			started := len(fisAPI.StartExperimentInputs) == 1
+			if started != tt.wantStarted {
+				t.Fatalf("Expected started %v, got %d StartExperiment calls", tt.wantStarted, len(fisAPI.StartExperimentInputs))
+			}
+			if tt.wantStarted {
+				return
+			}
+			if result.RequeueAfter != activeRunPollInterval {
+				t.Errorf("Expected requeue after %s, got: %+v", activeRunPollInterval, result)
+			}
+			if experiment.Status.ExperimentID != "" {
+				t.Errorf("Expected no experiment ID while waiting, got: %s", experiment.Status.ExperimentID)
+			}
+			if !strings.Contains(experiment.Status.Reason, "2 of 2 allowed are active") {
+				t.Errorf("Expected the limit in the reason, got: %q", experiment.Status.Reason)
+			}
+			select {
+			case event := <-recorder.Events:
+				if !strings.Contains(event, fisv1alpha1.ReasonConcurrencyLimited) {
+					t.Errorf("Expected a ConcurrencyLimited event, got: %s", event)
+				}
+			default:
+				t.Error("Expected a ConcurrencyLimited event")
+			}
+		})
+	}
+}
+
+func TestScheduledExperimentDoesNotBlockItsOwnNextRunUnderGlobalLimit(t *testing.T) {
+	// The first run has finished in AWS by the time the next run is due
+	fisAPI := &awsfake.FIS{
+		GetExperimentFunc: func(params *fis.GetExperimentInput) (*fis.GetExperimentOutput, error) {
+			return &fis.GetExperimentOutput{Experiment: &types.Experiment{
+				Id:    params.Id,
+				State: &types.ExperimentState{Status: types.ExperimentStatusCompleted},
+			}}, nil
+		},
+	}
+	experiment := newScheduledExperiment("global-limit-schedule-test")
+	experiment.Spec.Schedule = "*/5 * * * *"
+	experiment.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+	reconciler := newTestReconciler(fisAPI, experiment)
+	reconciler.MaxConcurrentExperiments = 1
+	ctx := context.Background()
+	key := client.ObjectKeyFromObject(experiment)
+
+	// Two schedule ticks, each with its run due
+	for tick := 1; tick <= 2; tick++ {
+		current := &fisv1alpha1.Experiment{}
+		if err := reconciler.Get(ctx, key, current); err != nil {
+			t.Fatalf("Failed to get experiment: %v", err)
+		}
+		if tick > 1 {
+			if current.Status.State != "initiating" {
+				t.Fatalf("Expected the stored state to still be initiating, got: %q", current.Status.State)
+			}
+			lastScheduleTime := metav1.NewTime(time.Now().Add(-10 * time.Minute))
+			current.Status.LastScheduleTime = &lastScheduleTime
+		}
+
+		if _, err := reconciler.handleScheduledExperiment(ctx, current, logr.Discard()); err != nil {
+			t.Fatalf("tick %d: handleScheduledExperiment failed: %v", tick, err)
+		}
+		if len(fisAPI.StartExperimentInputs) != tick {
+			t.Fatalf("tick %d: expected %d StartExperiment calls, got: %d (reason %q)", tick, tick, len(fisAPI.StartExperimentInputs), current.Status.Reason)
+		}
+	}
+}
+
+func TestStartExperimentHonorsGlobalConcurrencyLimit(t *testing.T) {
+	tests := []struct {
+		name        string
+		states      []string
+		wantStarted bool
+	}{
+		{name: "below the limit", states: []string{"running", "completed", "failed"}, wantStarted: true},
+		{name: "at the limit", states: []string{"running", "pending", "completed"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fisAPI := &awsfake.FIS{}
+			experiment := newScheduledExperiment("global-limit-test")
+			experiment.Spec.Schedule = ""
+			objs := []*fisv1alpha1.Experiment{experiment}
+			for i, state := range tt.states {
+				other := newScheduledExperiment(fmt.Sprintf("other-%d", i))
+				other.Status.State = state
+				objs = append(objs, other)
+			}
+			reconciler := newTestReconciler(fisAPI, objs...)
+			reconciler.MaxConcurrentExperiments = 2
+			recorder := record.NewFakeRecorder(10)
+			reconciler.Recorder = recorder
+			ctx := context.Background()
+
+			result, err := reconciler.startExperiment(ctx, experiment, "", logr.Discard())
+			if err != nil {
+				t.Fatalf("startExperiment failed: %v", err)
+			}
+
+			started := len(fisAPI.StartExperimentInputs) == 1
+			if started != tt.wantStarted {
+				t.Fatalf("Expected started %v, got %d StartExperiment calls", tt.wantStarted, len(fisAPI.StartExperimentInputs))
+			}
+			if tt.wantStarted {
+				return
+			}
+			if result.RequeueAfter != activeRunPollInterval {
+				t.Errorf("Expected requeue after %s, got: %+v", activeRunPollInterval, result)
+			}
+			if !strings.Contains(experiment.Status.Reason, "in the cluster to finish: 2 of 2 allowed are active") {
+				t.Errorf("Expected the global limit in the reason, got: %q", experiment.Status.Reason)
+			}
+			select {
+			case event := <-recorder.Events:
+				if !strings.Contains(event, fisv1alpha1.ReasonConcurrencyLimited) {
+					t.Errorf("Expected a ConcurrencyLimited event, got: %s", event)
+				}
+			default:
+				t.Error("Expected a ConcurrencyLimited event")
+			}
+
+			// Waiting again does not repeat the event
+			if _, err := reconciler.startExperiment(ctx, experiment, "", logr.Discard()); err != nil {
+				t.Fatalf("startExperiment failed: %v", err)
+			}
+			select {
+			case event := <-recorder.Events:
+				t.Errorf("Expected no second event, got: %s", event)
+			default:
+			}
+		})
+	}
+}
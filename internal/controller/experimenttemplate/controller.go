@@ -18,20 +18,46 @@ package experimenttemplate
 
 import (
 	"context"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	fisv1alpha1 "fis.dksshddl.dev/fis-controller/api/v1alpha1"
 	awsfis "fis.dksshddl.dev/fis-controller/internal/aws"
+	"fis.dksshddl.dev/fis-controller/internal/metrics"
+	"fis.dksshddl.dev/fis-controller/internal/validation"
 )
 
 const (
 	finalizerName = "fis.fis.dksshddl.dev/finalizer"
+
+	// forceSyncAnnotation triggers a full re-sync with AWS whenever its value changes
+	forceSyncAnnotation = "fis.dksshddl.dev/force-sync"
+
+	// diffOnlyAnnotation set to "true" reports pending template updates in status instead of applying them
+	diffOnlyAnnotation = "fis.dksshddl.dev/diff-only"
+
+	// startAfterAnnotation defers creating the AWS FIS template until an RFC3339 time, or for a duration
+	// after the ExperimentTemplate was created, so related resources applied alongside it can settle
+	startAfterAnnotation = "fis.dksshddl.dev/start-after"
+
+	// roleAccessDeniedRetryInterval is how often a template whose IAM role could not be created is retried,
+	// in case the controller is granted the permissions instead of the user providing a role
+	roleAccessDeniedRetryInterval = 10 * time.Minute
+
+	// dryRunRoleArnPlaceholder stands in for an IAM role that would be auto-created, since dry runs create none
+	dryRunRoleArnPlaceholder = "<auto-created-role>"
+
+	// dryRunSecretRoleArnPlaceholder stands in for a role ARN read from spec.roleArnFrom, which stays out of status
+	dryRunSecretRoleArnPlaceholder = "<role-arn-from-secret>"
 )
 
 // Reconciler reconciles a ExperimentTemplate object
@@ -43,6 +69,63 @@ type Reconciler struct {
 	EKSClient   *awsfis.EKSClient
 	ClusterARN  string
 	ClusterName string
+	Validator   *validation.TemplateValidator
+
+	// ServiceAccountNameTemplate names the per-template RBAC resources, see utils.ExperimentTemplateRBACName
+	ServiceAccountNameTemplate string
+
+	// AccessPolicyArn is an EKS access policy associated with each template's access entry,
+	// scoped to the template's target namespaces; empty disables the association
+	AccessPolicyArn string
+
+	// RolePolicyArns are managed IAM policies attached to every auto-created role
+	RolePolicyArns []string
+
+	// SkipInlineRolePolicy creates roles without the inline FIS policy, relying on the managed policies
+	SkipInlineRolePolicy bool
+
+	// PermissionsBoundary is the IAM policy ARN set as the permissions boundary of auto-created roles
+	PermissionsBoundary string
+
+	// ServiceAccountRoleArn is the IAM role annotated on every template ServiceAccount for IRSA,
+	// unless the template sets spec.serviceAccountRoleArn; empty leaves the annotation off
+	ServiceAccountRoleArn string
+
+	// RBACNamespace centralizes the RBAC of every template in one namespace: a single ServiceAccount there,
+	// bound cluster-wide through a ClusterRole and ClusterRoleBinding; empty uses the target namespaces
+	RBACNamespace string
+
+	// RoleArnSecretNamespace is the only namespace spec.roleArnFrom may read; empty rejects every spec.roleArnFrom
+	RoleArnSecretNamespace string
+
+	// DryRun renders every template into status instead of creating AWS or Kubernetes resources
+	DryRun bool
+
+	// SkipAccessEntries leaves EKS access entries to the user unless a template sets spec.manageAccessEntry
+	SkipAccessEntries bool
+
+	// CleanupStaleAccessEntries deletes the access entry of the previous role when a template's role ARN changes
+	CleanupStaleAccessEntries bool
+
+	// CleanupReplacedRoles deletes a template's auto-created IAM role once the template switches to a provided role
+	CleanupReplacedRoles bool
+
+	// DriftCheckInterval is how often a synced template is compared with its AWS FIS template, which is
+	// re-applied when it was changed outside the controller; 0 disables drift detection
+	DriftCheckInterval time.Duration
+
+	// RecordResolvedConfig stores the selection modes and durations AWS FIS returns for a template in
+	// status.resolvedConfig, to debug values AWS FIS coerced
+	RecordResolvedConfig bool
+
+	// Inventory counts the AWS and Kubernetes resources managed per template; nil disables the metrics
+	Inventory *metrics.Inventory
+
+	// APIReader reads Secrets referenced by templates without caching them; defaults to the client
+	APIReader client.Reader
+
+	// Recorder emits lifecycle events; SetupWithManager creates one when nil
+	Recorder record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=fis.fis.dksshddl.dev,resources=experimenttemplates,verbs=get;list;watch;create;update;patch;delete
@@ -50,12 +133,17 @@ type Reconciler struct {
 // +kubebuilder:rbac:groups=fis.fis.dksshddl.dev,resources=experimenttemplates/finalizers,verbs=update
 // +kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;create;patch;delete
+// +kubebuilder:rbac:groups="",namespace=system,resources=secrets,verbs=get
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
 // +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;create;delete;deletecollection
 // +kubebuilder:rbac:groups="",resources=pods/ephemeralcontainers,verbs=update
 // +kubebuilder:rbac:groups="",resources=pods/exec,verbs=create
 // +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=roles,verbs=get;list;watch;create;update;patch;delete;escalate;bind
 // +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=rolebindings,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterroles,verbs=get;list;watch;create;update;patch;delete;escalate;bind
+// +kubebuilder:rbac:groups=rbac.authorization.k8s.io,resources=clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
 
 // Reconcile is part of the main kubernetes reconciliation loop
 func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -65,8 +153,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	experimentTemplate := &fisv1alpha1.ExperimentTemplate{}
 	if err := r.Get(ctx, req.NamespacedName, experimentTemplate); err != nil {
 		if errors.IsNotFound(err) {
-			log.Info("ExperimentTemplate resource not found. Ignoring since object must be deleted")
-			return ctrl.Result{}, nil
+			log.Info("ExperimentTemplate resource not found, cleaning up orphaned RBAC resources")
+			r.forgetInventory(req.Name)
+			return ctrl.Result{}, r.deleteOrphanedRBAC(ctx, req.Name, log)
 		}
 		log.Error(err, "Failed to get ExperimentTemplate")
 		return ctrl.Result{}, err
@@ -102,9 +191,23 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 				return ctrl.Result{}, err
 			}
 		}
+		r.forgetInventory(experimentTemplate.Name)
 		return ctrl.Result{}, nil
 	}
 
+	// Count what the template manages once this reconcile has updated its status
+	defer r.recordInventory(experimentTemplate)
+
+	// Dry runs never create anything that would need cleanup, so they get no finalizer
+	// A template that already exists in AWS is left untouched while dry-run is enabled
+	if r.dryRunRequested(experimentTemplate) {
+		rendered := experimentTemplate.Generation == experimentTemplate.Status.ObservedGeneration && experimentTemplate.Status.RenderedTemplate != ""
+		if rendered || validationFailedForGeneration(experimentTemplate) {
+			return ctrl.Result{}, nil
+		}
+		return r.renderFISExperimentTemplate(ctx, experimentTemplate, log)
+	}
+
 	// Add finalizer if not present
 	if !controllerutil.ContainsFinalizer(experimentTemplate, finalizerName) {
 		controllerutil.AddFinalizer(experimentTemplate, finalizerName)
@@ -115,6 +218,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	// A spec rejected as invalid is not retried until it changes
+	if validationFailedForGeneration(experimentTemplate) && !forceSyncRequested(experimentTemplate) {
+		log.Info("ExperimentTemplate spec was rejected as invalid, waiting for a spec change", "generation", experimentTemplate.Generation)
+		return ctrl.Result{}, nil
+	}
+
 	// Check if AWS FIS ExperimentTemplate already exists
 	if experimentTemplate.Status.TemplateID != "" {
 		log.Info("AWS FIS ExperimentTemplate already exists", "templateID", experimentTemplate.Status.TemplateID)
@@ -125,18 +234,42 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			return r.updateFISExperimentTemplate(ctx, experimentTemplate, log)
 		}
 
+		// Re-sync on demand, e.g. after fixing the IAM role outside the cluster
+		if forceSyncRequested(experimentTemplate) {
+			log.Info("Force sync requested, updating AWS FIS ExperimentTemplate", "token", experimentTemplate.Annotations[forceSyncAnnotation])
+			return r.updateFISExperimentTemplate(ctx, experimentTemplate, log)
+		}
+
+		// Correct changes made to the AWS template outside the controller
+		if r.DriftCheckInterval > 0 {
+			return r.checkDrift(ctx, experimentTemplate, log)
+		}
+
 		// No changes, nothing to do
 		return ctrl.Result{}, nil
 	}
 
+	// Wait for the start-after grace period before managing the template in AWS
+	if result, deferred, err := r.deferCreation(ctx, experimentTemplate, log); deferred || err != nil {
+		return result, err
+	}
+
 	// Create AWS FIS ExperimentTemplate
 	return r.createFISExperimentTemplate(ctx, experimentTemplate, log)
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	if r.Recorder == nil {
+		r.Recorder = mgr.GetEventRecorderFor("experimenttemplate-controller")
+	}
+
+	// Status-only updates must not retrigger reconciliation, otherwise the
+	// throttle backoff would be bypassed by our own status writes
 	return ctrl.NewControllerManagedBy(mgr).
-		For(&fisv1alpha1.ExperimentTemplate{}).
+		For(&fisv1alpha1.ExperimentTemplate{}, builder.WithPredicates(
+			predicate.Or(predicate.GenerationChangedPredicate{}, predicate.AnnotationChangedPredicate{}),
+		)).
 		Named("experimenttemplate").
 		Complete(r)
 }
@@ -18,13 +18,43 @@ package experimenttemplate
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"os"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	ekstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/aws/aws-sdk-go-v2/service/fis"
+	fistypes "github.com/aws/aws-sdk-go-v2/service/fis/types"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/smithy-go"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	fisv1alpha1 "fis.dksshddl.dev/fis-controller/api/v1alpha1"
+	awsfis "fis.dksshddl.dev/fis-controller/internal/aws"
+	awsfake "fis.dksshddl.dev/fis-controller/internal/aws/fake"
+	"fis.dksshddl.dev/fis-controller/internal/metrics"
 )
 
 func TestReconciler(t *testing.T) {
@@ -222,3 +252,1598 @@ func TestGetRequiredParametersWithDefaults(t *testing.T) {
 		t.Errorf("Expected clusterIdentifier 'arn:aws:eks:ap-northeast-2:123456789012:cluster/test-cluster', got: %s", clusterIdentifier)
 	}
 }
+
+// newTestScheme returns a scheme with the core Kubernetes and FIS types registered
+func newTestScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	_ = fisv1alpha1.AddToScheme(scheme)
+	return scheme
+}
+
+// newTestTemplate returns an ExperimentTemplate that already has an AWS template and role
+func newTestTemplate(name string) *fisv1alpha1.ExperimentTemplate {
+	return &fisv1alpha1.ExperimentTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Annotations: map[string]string{
+				"fis.dksshddl.dev/cluster-identifier": "arn:aws:eks:ap-northeast-2:123456789012:cluster/test-cluster",
+			},
+		},
+		Spec: fisv1alpha1.ExperimentTemplateSpec{
+			Description: "test template",
+			Targets: []fisv1alpha1.TargetSpec{
+				{
+					Name:          "nginx-pods",
+					Namespace:     "default",
+					LabelSelector: map[string]string{"app": "nginx"},
+				},
+			},
+			Actions: []fisv1alpha1.ActionSpec{
+				{
+					Name:     "cpu-stress",
+					Type:     "pod-cpu-stress",
+					Duration: "5m",
+					Target:   "nginx-pods",
+				},
+			},
+		},
+		Status: fisv1alpha1.ExperimentTemplateStatus{
+			TemplateID: "EXT1234567890abcdef",
+			RoleArn:    "arn:aws:iam::123456789012:role/test-role",
+		},
+	}
+}
+
+// newTestReconciler returns a reconciler backed by a fake Kubernetes client and a fake FIS API
+func newTestReconciler(fisAPI *awsfake.FIS, objs ...*fisv1alpha1.ExperimentTemplate) *Reconciler {
+	scheme := newTestScheme()
+	builder := fake.NewClientBuilder().WithScheme(scheme)
+	for _, obj := range objs {
+		builder = builder.WithObjects(obj).WithStatusSubresource(obj)
+	}
+
+	return &Reconciler{
+		Client:    builder.Build(),
+		Scheme:    scheme,
+		FISClient: awsfis.NewFISClientFromAPI(fisAPI, aws.Config{Region: "ap-northeast-2"}),
+	}
+}
+
+func TestUpdateIncrementsTemplateVersion(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	fisAPI := &awsfake.FIS{}
+	template := newTestTemplate("version-test")
+	template.Status.TemplateVersion = 1
+	reconciler := newTestReconciler(fisAPI, template)
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		current := &fisv1alpha1.ExperimentTemplate{}
+		if err := reconciler.Get(ctx, types.NamespacedName{Name: template.Name}, current); err != nil {
+			t.Fatalf("Failed to get template: %v", err)
+		}
+		if _, err := reconciler.updateFISExperimentTemplate(ctx, current, logr.Discard()); err != nil {
+			t.Fatalf("Update %d failed: %v", i+1, err)
+		}
+	}
+
+	updated := &fisv1alpha1.ExperimentTemplate{}
+	if err := reconciler.Get(ctx, types.NamespacedName{Name: template.Name}, updated); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+
+	if len(fisAPI.UpdateExperimentTemplateInputs) != 2 {
+		t.Errorf("Expected 2 UpdateExperimentTemplate calls, got: %d", len(fisAPI.UpdateExperimentTemplateInputs))
+	}
+	if updated.Status.TemplateVersion != 3 {
+		t.Errorf("Expected template version 3 after two updates, got: %d", updated.Status.TemplateVersion)
+	}
+}
+
+func TestForceSyncAnnotationTriggersUpdate(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	fisAPI := &awsfake.FIS{}
+	template := newTestTemplate("force-sync-test")
+	template.Finalizers = []string{finalizerName}
+	template.Status.TemplateVersion = 1
+	reconciler := newTestReconciler(fisAPI, template)
+	ctx := context.Background()
+	key := types.NamespacedName{Name: template.Name}
+
+	// Mark the current generation as synced so only the annotation can trigger an update
+	current := &fisv1alpha1.ExperimentTemplate{}
+	if err := reconciler.Get(ctx, key, current); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	current.Status.ObservedGeneration = current.Generation
+	if err := reconciler.Status().Update(ctx, current); err != nil {
+		t.Fatalf("Failed to update status: %v", err)
+	}
+
+	if _, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if len(fisAPI.UpdateExperimentTemplateInputs) != 0 {
+		t.Fatalf("Expected no update without spec or annotation change, got: %d", len(fisAPI.UpdateExperimentTemplateInputs))
+	}
+
+	// Setting the annotation triggers exactly one update
+	if err := reconciler.Get(ctx, key, current); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	current.Annotations[forceSyncAnnotation] = "1"
+	if err := reconciler.Update(ctx, current); err != nil {
+		t.Fatalf("Failed to set force-sync annotation: %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if _, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+			t.Fatalf("Reconcile failed: %v", err)
+		}
+	}
+	if len(fisAPI.UpdateExperimentTemplateInputs) != 1 {
+		t.Errorf("Expected 1 update after setting force-sync, got: %d", len(fisAPI.UpdateExperimentTemplateInputs))
+	}
+
+	// Changing the value triggers another update
+	if err := reconciler.Get(ctx, key, current); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	if current.Status.LastForceSync != "1" {
+		t.Errorf("Expected LastForceSync '1', got: %s", current.Status.LastForceSync)
+	}
+	current.Annotations[forceSyncAnnotation] = "2"
+	if err := reconciler.Update(ctx, current); err != nil {
+		t.Fatalf("Failed to change force-sync annotation: %v", err)
+	}
+
+	if _, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if len(fisAPI.UpdateExperimentTemplateInputs) != 2 {
+		t.Errorf("Expected 2 updates after changing force-sync, got: %d", len(fisAPI.UpdateExperimentTemplateInputs))
+	}
+}
+
+func TestCreateForwardsRBACUsernameToAccessEntry(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	eksAPI := &awsfake.EKS{}
+	template := newTestTemplate("username-test")
+	template.Status.TemplateID = ""
+	reconciler := newTestReconciler(&awsfake.FIS{}, template)
+	reconciler.EKSClient = awsfis.NewEKSClientFromAPI(eksAPI)
+	reconciler.ClusterName = "test-cluster"
+
+	if _, err := reconciler.createFISExperimentTemplate(context.Background(), template, logr.Discard()); err != nil {
+		t.Fatalf("createFISExperimentTemplate failed: %v", err)
+	}
+
+	if len(eksAPI.CreateAccessEntryInputs) != 1 {
+		t.Fatalf("Expected 1 CreateAccessEntry call, got: %d", len(eksAPI.CreateAccessEntryInputs))
+	}
+	if got := aws.ToString(eksAPI.CreateAccessEntryInputs[0].Username); got != "fis-username-test" {
+		t.Errorf("Expected access entry username 'fis-username-test', got: %s", got)
+	}
+
+	roleBinding := &rbacv1.RoleBinding{}
+	if err := reconciler.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "fis-username-test"}, roleBinding); err != nil {
+		t.Fatalf("Failed to get RoleBinding: %v", err)
+	}
+	if roleBinding.Subjects[1].Name != "fis-username-test" {
+		t.Errorf("Expected RoleBinding user subject to match the access entry username, got: %s", roleBinding.Subjects[1].Name)
+	}
+}
+
+func TestValidationErrorWaitsForSpecChange(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	fisAPI := &awsfake.FIS{
+		UpdateExperimentTemplateFunc: func(*fis.UpdateExperimentTemplateInput) (*fis.UpdateExperimentTemplateOutput, error) {
+			return nil, &fistypes.ValidationException{Message: aws.String("invalid target")}
+		},
+	}
+	template := newTestTemplate("validation-test")
+	template.Finalizers = []string{finalizerName}
+	template.Generation = 2
+	template.Status.ObservedGeneration = 1
+	reconciler := newTestReconciler(fisAPI, template)
+	ctx := context.Background()
+	key := types.NamespacedName{Name: template.Name}
+
+	for i := 0; i < 3; i++ {
+		result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+		if err != nil {
+			t.Fatalf("Reconcile %d returned error: %v", i+1, err)
+		}
+		if !result.IsZero() {
+			t.Errorf("Reconcile %d requeued: %+v", i+1, result)
+		}
+	}
+
+	if len(fisAPI.UpdateExperimentTemplateInputs) != 1 {
+		t.Errorf("Expected 1 UpdateExperimentTemplate call before the spec changes, got: %d", len(fisAPI.UpdateExperimentTemplateInputs))
+	}
+
+	current := &fisv1alpha1.ExperimentTemplate{}
+	if err := reconciler.Get(ctx, key, current); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	if current.Status.Phase != "Failed" {
+		t.Errorf("Expected phase Failed, got: %s", current.Status.Phase)
+	}
+	cond := meta.FindStatusCondition(current.Status.Conditions, fisv1alpha1.ConditionFailed)
+	if cond == nil || cond.Reason != fisv1alpha1.ReasonValidationFailed || cond.ObservedGeneration != 2 {
+		t.Fatalf("Expected Failed condition with reason ValidationFailed for generation 2, got: %+v", cond)
+	}
+
+	// A spec change retries the update
+	current.Spec.Description = "fixed template"
+	current.Generation = 3
+	if err := reconciler.Update(ctx, current); err != nil {
+		t.Fatalf("Failed to update template: %v", err)
+	}
+	fisAPI.UpdateExperimentTemplateFunc = nil
+
+	if _, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile after spec change failed: %v", err)
+	}
+	if len(fisAPI.UpdateExperimentTemplateInputs) != 2 {
+		t.Errorf("Expected the spec change to retry the update, got %d calls", len(fisAPI.UpdateExperimentTemplateInputs))
+	}
+
+	if err := reconciler.Get(ctx, key, current); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	if current.Status.Phase != "Ready" {
+		t.Errorf("Expected phase Ready after a successful update, got: %s", current.Status.Phase)
+	}
+	if meta.IsStatusConditionTrue(current.Status.Conditions, fisv1alpha1.ConditionFailed) {
+		t.Error("Expected Failed condition to be cleared after a successful update")
+	}
+}
+
+func TestCreateAssociatesAccessPolicyWithTargetNamespaces(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	eksAPI := &awsfake.EKS{}
+	template := newTestTemplate("access-policy-test")
+	template.Status.TemplateID = ""
+	template.Spec.Targets = append(template.Spec.Targets, fisv1alpha1.TargetSpec{
+		Name:          "api-pods",
+		Namespace:     "payments",
+		LabelSelector: map[string]string{"app": "api"},
+	})
+	reconciler := newTestReconciler(&awsfake.FIS{}, template)
+	reconciler.EKSClient = awsfis.NewEKSClientFromAPI(eksAPI)
+	reconciler.ClusterName = "test-cluster"
+	reconciler.AccessPolicyArn = "arn:aws:eks::aws:cluster-access-policy/AmazonEKSEditPolicy"
+
+	if _, err := reconciler.createFISExperimentTemplate(context.Background(), template, logr.Discard()); err != nil {
+		t.Fatalf("createFISExperimentTemplate failed: %v", err)
+	}
+
+	if len(eksAPI.AssociateAccessPolicyInputs) != 1 {
+		t.Fatalf("Expected 1 AssociateAccessPolicy call, got: %d", len(eksAPI.AssociateAccessPolicyInputs))
+	}
+	scope := eksAPI.AssociateAccessPolicyInputs[0].AccessScope
+	if len(scope.Namespaces) != 2 || scope.Namespaces[0] != "default" || scope.Namespaces[1] != "payments" {
+		t.Errorf("Expected access policy scoped to [default payments], got: %v", scope.Namespaces)
+	}
+}
+
+func TestGetRequiredParametersWithRoleArnFromSecret(t *testing.T) {
+	// The Secret wins even over the environment variable
+	t.Setenv("FIS_ROLE_ARN", "arn:aws:iam::123456789012:role/env-role")
+	t.Setenv("CLUSTER_IDENTIFIER", "arn:aws:eks:ap-northeast-2:123456789012:cluster/env-cluster")
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "fis-role", Namespace: "chaos"},
+		Data: map[string][]byte{
+			"roleArn":  []byte("arn:aws:iam::123456789012:role/secret-role\n"),
+			"password": []byte("hunter2"),
+		},
+	}
+	otherSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "fis-role", Namespace: "kube-system"},
+		Data:       map[string][]byte{"roleArn": []byte("arn:aws:iam::123456789012:role/other-role")},
+	}
+	template := newTestTemplate("secret-role-test")
+	template.Spec.RoleArnFrom = &fisv1alpha1.SecretKeySelector{Name: "fis-role", Namespace: "chaos", Key: "roleArn"}
+	reconciler := newTestReconciler(&awsfake.FIS{}, template)
+	reconciler.APIReader = fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(secret, otherSecret).Build()
+	reconciler.RoleArnSecretNamespace = "chaos"
+
+	roleArn, _, err := reconciler.getRequiredParameters(context.Background(), template)
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if roleArn != "arn:aws:iam::123456789012:role/secret-role" {
+		t.Errorf("Expected roleArn from secret, got: %s", roleArn)
+	}
+
+	// A missing key is an error rather than a silent fallback
+	template.Spec.RoleArnFrom.Key = "missing"
+	if _, _, err := reconciler.getRequiredParameters(context.Background(), template); err == nil {
+		t.Error("Expected error for a missing secret key")
+	}
+
+	// A value that is not a role ARN is rejected without echoing it
+	template.Spec.RoleArnFrom.Key = "password"
+	_, _, err = reconciler.getRequiredParameters(context.Background(), template)
+	if err == nil {
+		t.Error("Expected error for a secret value that is not a role ARN")
+	} else if strings.Contains(err.Error(), "hunter2") {
+		t.Errorf("Expected the secret value to stay out of the error, got: %v", err)
+	}
+
+	// Secrets outside the controller's namespace are never read
+	template.Spec.RoleArnFrom = &fisv1alpha1.SecretKeySelector{Name: "fis-role", Namespace: "kube-system", Key: "roleArn"}
+	if _, _, err := reconciler.getRequiredParameters(context.Background(), template); err == nil {
+		t.Error("Expected error for a secret outside the controller's namespace")
+	}
+	reconciler.RoleArnSecretNamespace = ""
+	template.Spec.RoleArnFrom.Namespace = "chaos"
+	if _, _, err := reconciler.getRequiredParameters(context.Background(), template); err == nil {
+		t.Error("Expected roleArnFrom to be rejected without a configured namespace")
+	}
+}
+
+func TestUpdateReplacesAccessEntryOnRoleChange(t *testing.T) {
+	t.Setenv("FIS_ROLE_ARN", "arn:aws:iam::123456789012:role/user-provided")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	staleRoleArn := "arn:aws:iam::123456789012:role/test-role"
+	for _, cleanup := range []bool{true, false} {
+		eksAPI := &awsfake.EKS{AccessEntries: map[string]ekstypes.AccessEntry{
+			staleRoleArn: {PrincipalArn: aws.String(staleRoleArn)},
+		}}
+		template := newTestTemplate("role-change-test")
+		reconciler := newTestReconciler(&awsfake.FIS{}, template)
+		reconciler.EKSClient = awsfis.NewEKSClientFromAPI(eksAPI)
+		reconciler.ClusterName = "test-cluster"
+		reconciler.CleanupStaleAccessEntries = cleanup
+
+		if _, err := reconciler.updateFISExperimentTemplate(context.Background(), template, logr.Discard()); err != nil {
+			t.Fatalf("updateFISExperimentTemplate failed: %v", err)
+		}
+
+		if _, ok := eksAPI.AccessEntries["arn:aws:iam::123456789012:role/user-provided"]; !ok {
+			t.Errorf("cleanup=%t: expected access entry for the new role", cleanup)
+		}
+		if _, ok := eksAPI.AccessEntries[staleRoleArn]; ok == cleanup {
+			t.Errorf("cleanup=%t: stale access entry present=%t", cleanup, ok)
+		}
+		if template.Status.RoleArn != "arn:aws:iam::123456789012:role/user-provided" {
+			t.Errorf("cleanup=%t: expected status to record the new role, got: %s", cleanup, template.Status.RoleArn)
+		}
+	}
+}
+
+func TestUpdateDeletesAutoCreatedRoleOnSwitchToProvidedRole(t *testing.T) {
+	t.Setenv("FIS_ROLE_ARN", "arn:aws:iam::123456789012:role/user-provided")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	tests := []struct {
+		name          string
+		statusRole    string
+		rolePath      string
+		cleanup       bool
+		expectDeleted bool
+	}{
+		{name: "auto-created role", statusRole: "fis-role-switch-test", cleanup: true, expectDeleted: true},
+		{name: "auto-created role under a path", statusRole: "fis-role-switch-test", rolePath: "/chaos/", cleanup: true, expectDeleted: true},
+		{name: "cleanup disabled", statusRole: "fis-role-switch-test", cleanup: false, expectDeleted: false},
+		{name: "previously provided role", statusRole: "test-role", cleanup: true, expectDeleted: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statusRoleArn := awsfake.RolePathArn(tt.rolePath, tt.statusRole)
+			iamAPI := &awsfake.IAM{Roles: map[string]iamtypes.Role{
+				tt.statusRole: {RoleName: aws.String(tt.statusRole), Path: aws.String(tt.rolePath), Arn: aws.String(statusRoleArn)},
+			}}
+			template := newTestTemplate("role-switch-test")
+			template.Status.RoleArn = statusRoleArn
+			reconciler := newTestReconciler(&awsfake.FIS{}, template)
+			reconciler.IAMClient = awsfis.NewIAMClientFromAPI(iamAPI)
+			reconciler.IAMClient.RoleNaming.Path = tt.rolePath
+			reconciler.CleanupReplacedRoles = tt.cleanup
+
+			if _, err := reconciler.updateFISExperimentTemplate(context.Background(), template, logr.Discard()); err != nil {
+				t.Fatalf("updateFISExperimentTemplate failed: %v", err)
+			}
+
+			if _, exists := iamAPI.Roles[tt.statusRole]; exists == tt.expectDeleted {
+				t.Errorf("Expected role %s deleted=%t, still exists=%t", tt.statusRole, tt.expectDeleted, exists)
+			}
+			if template.Status.RoleArn != "arn:aws:iam::123456789012:role/user-provided" {
+				t.Errorf("Expected status to record the provided role, got: %s", template.Status.RoleArn)
+			}
+		})
+	}
+}
+
+func TestUpdateWithDiffOnlySkipsAWSUpdate(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	fisAPI := &awsfake.FIS{}
+	template := newTestTemplate("diff-only-test")
+	template.Annotations[diffOnlyAnnotation] = "true"
+	template.Spec.Description = "nightly CPU stress"
+	reconciler := newTestReconciler(fisAPI, template)
+
+	if _, err := reconciler.updateFISExperimentTemplate(context.Background(), template, logr.Discard()); err != nil {
+		t.Fatalf("updateFISExperimentTemplate failed: %v", err)
+	}
+
+	if len(fisAPI.UpdateExperimentTemplateInputs) != 0 {
+		t.Errorf("Expected no UpdateExperimentTemplate calls in diff-only mode, got: %d", len(fisAPI.UpdateExperimentTemplateInputs))
+	}
+	if !strings.Contains(template.Status.Message, `description: "" -> "nightly CPU stress"`) {
+		t.Errorf("Expected description diff in status message, got: %s", template.Status.Message)
+	}
+	if template.Status.TemplateVersion != 0 {
+		t.Errorf("Expected template version to be unchanged, got: %d", template.Status.TemplateVersion)
+	}
+}
+
+func TestCreateSetsReadinessConditions(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	tests := []struct {
+		name       string
+		createErr  error
+		wantReady  metav1.ConditionStatus
+		wantFailed bool
+		wantPhase  string
+		wantReason string
+	}{
+		{name: "success", wantReady: metav1.ConditionTrue, wantPhase: "Ready", wantReason: fisv1alpha1.ReasonSucceeded},
+		{name: "failure", createErr: errors.New("internal error"), wantReady: metav1.ConditionFalse, wantFailed: true, wantPhase: "Failed", wantReason: fisv1alpha1.ReasonReconcileFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fisAPI := &awsfake.FIS{}
+			if tt.createErr != nil {
+				fisAPI.CreateExperimentTemplateFunc = func(*fis.CreateExperimentTemplateInput) (*fis.CreateExperimentTemplateOutput, error) {
+					return nil, tt.createErr
+				}
+			}
+			template := newTestTemplate("conditions-" + tt.name)
+			template.Generation = 1
+			template.Status.TemplateID = ""
+			reconciler := newTestReconciler(fisAPI, template)
+			reconciler.EKSClient = awsfis.NewEKSClientFromAPI(&awsfake.EKS{})
+			reconciler.ClusterName = "test-cluster"
+			ctx := context.Background()
+
+			current := &fisv1alpha1.ExperimentTemplate{}
+			if err := reconciler.Get(ctx, types.NamespacedName{Name: template.Name}, current); err != nil {
+				t.Fatalf("Failed to get template: %v", err)
+			}
+			_, err := reconciler.createFISExperimentTemplate(ctx, current, logr.Discard())
+			if (err != nil) != (tt.createErr != nil) {
+				t.Fatalf("createFISExperimentTemplate error = %v, want error %v", err, tt.createErr != nil)
+			}
+
+			if err := reconciler.Get(ctx, types.NamespacedName{Name: template.Name}, current); err != nil {
+				t.Fatalf("Failed to get template: %v", err)
+			}
+			if current.Status.Phase != tt.wantPhase {
+				t.Errorf("Expected phase %s, got: %s", tt.wantPhase, current.Status.Phase)
+			}
+			ready := meta.FindStatusCondition(current.Status.Conditions, fisv1alpha1.ConditionReady)
+			if ready == nil || ready.Status != tt.wantReady || ready.Reason != tt.wantReason || ready.ObservedGeneration != 1 {
+				t.Errorf("Expected Ready=%s with reason %s for generation 1, got: %+v", tt.wantReady, tt.wantReason, ready)
+			}
+			if meta.IsStatusConditionTrue(current.Status.Conditions, fisv1alpha1.ConditionProgressing) {
+				t.Error("Expected Progressing to be False once the create call returned")
+			}
+			if got := meta.IsStatusConditionTrue(current.Status.Conditions, fisv1alpha1.ConditionFailed); got != tt.wantFailed {
+				t.Errorf("Expected Failed=%v, got: %v", tt.wantFailed, got)
+			}
+		})
+	}
+}
+
+func TestCreateWithIAMAccessDeniedAsksForRoleArn(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	fisAPI := &awsfake.FIS{}
+	iamAPI := &awsfake.IAM{
+		CreateRoleFunc: func(*iam.CreateRoleInput) (*iam.CreateRoleOutput, error) {
+			return nil, &smithy.GenericAPIError{Code: "AccessDenied", Message: "not authorized to perform: iam:CreateRole"}
+		},
+	}
+	template := newTestTemplate("access-denied-test")
+	template.Status.TemplateID = ""
+	template.Status.RoleArn = ""
+	reconciler := newTestReconciler(fisAPI, template)
+	reconciler.IAMClient = awsfis.NewIAMClientFromAPI(iamAPI)
+	reconciler.IAMClient.RoleNaming.Prefix = "chaos"
+	reconciler.EKSClient = awsfis.NewEKSClientFromAPI(&awsfake.EKS{})
+	reconciler.ClusterName = "test-cluster"
+	recorder := record.NewFakeRecorder(10)
+	reconciler.Recorder = recorder
+	ctx := context.Background()
+
+	current := &fisv1alpha1.ExperimentTemplate{}
+	if err := reconciler.Get(ctx, types.NamespacedName{Name: template.Name}, current); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	result, err := reconciler.createFISExperimentTemplate(ctx, current, logr.Discard())
+	if err != nil {
+		t.Fatalf("Expected access denied to be reported in status, got error: %v", err)
+	}
+	if result.RequeueAfter != roleAccessDeniedRetryInterval {
+		t.Errorf("Expected requeue after %s, got: %+v", roleAccessDeniedRetryInterval, result)
+	}
+	if len(fisAPI.CreateExperimentTemplateInputs) != 0 {
+		t.Errorf("Expected no CreateExperimentTemplate call without a role, got: %d", len(fisAPI.CreateExperimentTemplateInputs))
+	}
+
+	if err := reconciler.Get(ctx, types.NamespacedName{Name: template.Name}, current); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	if current.Status.Phase != "Failed" {
+		t.Errorf("Expected phase Failed, got: %s", current.Status.Phase)
+	}
+	cond := meta.FindStatusCondition(current.Status.Conditions, fisv1alpha1.ConditionRoleReady)
+	if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != fisv1alpha1.ReasonIAMAccessDenied {
+		t.Fatalf("Expected RoleReady=False with reason IAMAccessDenied, got: %+v", cond)
+	}
+	if !strings.Contains(cond.Message, "fis.dksshddl.dev/role-arn") || !strings.Contains(cond.Message, "chaos-access-denied-test") {
+		t.Errorf("Expected the message to name the role and how to provide one, got: %s", cond.Message)
+	}
+
+	select {
+	case event := <-recorder.Events:
+		if !strings.HasPrefix(event, "Warning "+fisv1alpha1.ReasonIAMAccessDenied) {
+			t.Errorf("Expected an IAMAccessDenied warning, got: %s", event)
+		}
+	default:
+		t.Error("Expected an IAMAccessDenied event")
+	}
+}
+
+func TestDeletionSucceedsWhenAWSTemplateIsAlreadyGone(t *testing.T) {
+	fisAPI := &awsfake.FIS{
+		DeleteExperimentTemplateFunc: func(*fis.DeleteExperimentTemplateInput) (*fis.DeleteExperimentTemplateOutput, error) {
+			return nil, &fistypes.ResourceNotFoundException{Message: aws.String("experiment template not found")}
+		},
+	}
+	template := newTestTemplate("already-deleted")
+	template.Finalizers = []string{finalizerName}
+	now := metav1.Now()
+	template.DeletionTimestamp = &now
+	reconciler := newTestReconciler(fisAPI, template)
+	reconciler.IAMClient = awsfis.NewIAMClientFromAPI(&awsfake.IAM{})
+	ctx := context.Background()
+	key := types.NamespacedName{Name: template.Name}
+
+	if _, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if len(fisAPI.DeleteExperimentTemplateInputs) != 1 {
+		t.Errorf("Expected 1 DeleteExperimentTemplate call, got: %d", len(fisAPI.DeleteExperimentTemplateInputs))
+	}
+
+	// The fake client removes the object once its last finalizer is gone
+	remaining := &fisv1alpha1.ExperimentTemplate{}
+	if err := reconciler.Get(ctx, key, remaining); err == nil {
+		t.Errorf("Expected the finalizer to be removed and the template deleted, finalizers: %v", remaining.Finalizers)
+	}
+}
+
+func TestTargetAccountConfigurationsCreatedAndCleanedUp(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	fisAPI := &awsfake.FIS{}
+	template := newTestTemplate("multi-account-test")
+	template.Status.TemplateID = ""
+	template.Finalizers = []string{finalizerName}
+	template.Spec.ExperimentOptions = &fisv1alpha1.ExperimentOptions{AccountTargeting: "multi-account"}
+	template.Spec.TargetAccountConfigurations = []fisv1alpha1.TargetAccountConfiguration{
+		{AccountID: "111111111111", RoleArn: "arn:aws:iam::111111111111:role/fis-target", Description: "staging"},
+		{AccountID: "222222222222", RoleArn: "arn:aws:iam::222222222222:role/fis-target"},
+	}
+	reconciler := newTestReconciler(fisAPI, template)
+	reconciler.IAMClient = awsfis.NewIAMClientFromAPI(&awsfake.IAM{})
+	ctx := context.Background()
+	key := types.NamespacedName{Name: template.Name}
+
+	current := &fisv1alpha1.ExperimentTemplate{}
+	if err := reconciler.Get(ctx, key, current); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	if _, err := reconciler.createFISExperimentTemplate(ctx, current, logr.Discard()); err != nil {
+		t.Fatalf("createFISExperimentTemplate failed: %v", err)
+	}
+
+	templateID := current.Status.TemplateID
+	configs := fisAPI.TargetAccountConfigurations[templateID]
+	if len(configs) != 2 {
+		t.Fatalf("Expected 2 target account configurations, got: %v", configs)
+	}
+	if got := aws.ToString(configs["111111111111"].Description); got != "staging" {
+		t.Errorf("Expected description 'staging', got: %s", got)
+	}
+
+	// Deleting the template removes its target account configurations before the template itself
+	if err := reconciler.Get(ctx, key, current); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	if err := reconciler.Delete(ctx, current); err != nil {
+		t.Fatalf("Failed to delete template: %v", err)
+	}
+	if _, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if len(fisAPI.TargetAccountConfigurations[templateID]) != 0 {
+		t.Errorf("Expected target account configurations to be deleted, got: %v", fisAPI.TargetAccountConfigurations[templateID])
+	}
+	if len(fisAPI.DeleteExperimentTemplateInputs) != 1 {
+		t.Errorf("Expected 1 DeleteExperimentTemplate call, got: %d", len(fisAPI.DeleteExperimentTemplateInputs))
+	}
+}
+
+func TestDryRunRendersTemplateWithoutCreatingResources(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	fisAPI := &awsfake.FIS{}
+	iamAPI := &awsfake.IAM{}
+	eksAPI := &awsfake.EKS{}
+	template := newTestTemplate("dry-run-test")
+	template.Spec.DryRun = true
+	template.Status.TemplateID = ""
+	template.Status.RoleArn = ""
+	reconciler := newTestReconciler(fisAPI, template)
+	reconciler.IAMClient = awsfis.NewIAMClientFromAPI(iamAPI)
+	reconciler.EKSClient = awsfis.NewEKSClientFromAPI(eksAPI)
+	reconciler.ClusterName = "test-cluster"
+	reconciler.Recorder = record.NewFakeRecorder(10)
+	ctx := context.Background()
+	key := types.NamespacedName{Name: template.Name}
+
+	if _, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+
+	if len(fisAPI.CreateExperimentTemplateInputs) != 0 {
+		t.Errorf("Expected no CreateExperimentTemplate call in dry-run, got: %d", len(fisAPI.CreateExperimentTemplateInputs))
+	}
+	if len(iamAPI.CreateRoleInputs) != 0 {
+		t.Errorf("Expected no IAM role to be created in dry-run, got: %d", len(iamAPI.CreateRoleInputs))
+	}
+	if len(eksAPI.AssociateAccessPolicyInputs) != 0 {
+		t.Errorf("Expected no access entry changes in dry-run, got: %d", len(eksAPI.AssociateAccessPolicyInputs))
+	}
+	serviceAccounts := &corev1.ServiceAccountList{}
+	if err := reconciler.List(ctx, serviceAccounts); err != nil {
+		t.Fatalf("Failed to list service accounts: %v", err)
+	}
+	roles := &rbacv1.RoleList{}
+	if err := reconciler.List(ctx, roles); err != nil {
+		t.Fatalf("Failed to list roles: %v", err)
+	}
+	if len(serviceAccounts.Items) != 0 || len(roles.Items) != 0 {
+		t.Errorf("Expected no RBAC resources in dry-run, got %d service accounts and %d roles", len(serviceAccounts.Items), len(roles.Items))
+	}
+
+	current := &fisv1alpha1.ExperimentTemplate{}
+	if err := reconciler.Get(ctx, key, current); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	if len(current.Finalizers) != 0 {
+		t.Errorf("Expected no finalizer in dry-run, got: %v", current.Finalizers)
+	}
+	if current.Status.TemplateID != "" {
+		t.Errorf("Expected no template ID in dry-run, got: %s", current.Status.TemplateID)
+	}
+
+	rendered := &fis.CreateExperimentTemplateInput{}
+	if err := json.Unmarshal([]byte(current.Status.RenderedTemplate), rendered); err != nil {
+		t.Fatalf("Expected rendered template JSON in status, got %q: %v", current.Status.RenderedTemplate, err)
+	}
+	if got := aws.ToString(rendered.RoleArn); got != dryRunRoleArnPlaceholder {
+		t.Errorf("Expected placeholder role ARN, got: %s", got)
+	}
+	if _, ok := rendered.Actions["cpu-stress"]; !ok {
+		t.Errorf("Expected action cpu-stress in rendered template, got: %v", rendered.Actions)
+	}
+	if _, ok := rendered.Targets["nginx-pods"]; !ok {
+		t.Errorf("Expected target nginx-pods in rendered template, got: %v", rendered.Targets)
+	}
+	cond := meta.FindStatusCondition(current.Status.Conditions, fisv1alpha1.ConditionReady)
+	if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != fisv1alpha1.ReasonDryRun {
+		t.Errorf("Expected Ready=False with reason DryRun, got: %+v", cond)
+	}
+}
+
+func TestDryRunRedactsRoleArnFromSecret(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "fis-role", Namespace: "chaos"},
+		Data:       map[string][]byte{"roleArn": []byte("arn:aws:iam::123456789012:role/secret-role")},
+	}
+	template := newTestTemplate("dry-run-secret-test")
+	template.Spec.DryRun = true
+	template.Spec.RoleArnFrom = &fisv1alpha1.SecretKeySelector{Name: "fis-role", Namespace: "chaos", Key: "roleArn"}
+	template.Status.TemplateID = ""
+	template.Status.RoleArn = ""
+	reconciler := newTestReconciler(&awsfake.FIS{}, template)
+	reconciler.APIReader = fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(secret).Build()
+	reconciler.RoleArnSecretNamespace = "chaos"
+	reconciler.Recorder = record.NewFakeRecorder(10)
+	ctx := context.Background()
+	key := types.NamespacedName{Name: template.Name}
+
+	if _, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+
+	current := &fisv1alpha1.ExperimentTemplate{}
+	if err := reconciler.Get(ctx, key, current); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	if current.Status.RenderedTemplate == "" {
+		t.Fatal("Expected a rendered template in status")
+	}
+	if strings.Contains(current.Status.RenderedTemplate, "secret-role") {
+		t.Errorf("Expected the secret role ARN to be redacted, got: %s", current.Status.RenderedTemplate)
+	}
+	rendered := &fis.CreateExperimentTemplateInput{}
+	if err := json.Unmarshal([]byte(current.Status.RenderedTemplate), rendered); err != nil {
+		t.Fatalf("Expected rendered template JSON in status: %v", err)
+	}
+	if got := aws.ToString(rendered.RoleArn); got != dryRunSecretRoleArnPlaceholder {
+		t.Errorf("Expected placeholder role ARN, got: %s", got)
+	}
+}
+
+func TestUpdateSetsValidatedFromAWS(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	tests := []struct {
+		name       string
+		updateErr  error
+		getErr     error
+		wantStatus metav1.ConditionStatus
+		wantReason string
+	}{
+		{name: "confirmed", wantStatus: metav1.ConditionTrue, wantReason: fisv1alpha1.ReasonSucceeded},
+		{
+			name:       "not retrievable",
+			getErr:     &fistypes.ResourceNotFoundException{Message: aws.String("template not found")},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: fisv1alpha1.ReasonNotRetrievable,
+		},
+		{
+			name:       "rejected",
+			updateErr:  &fistypes.ValidationException{Message: aws.String("invalid target")},
+			wantStatus: metav1.ConditionFalse,
+			wantReason: fisv1alpha1.ReasonValidationFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fisAPI := &awsfake.FIS{
+				UpdateExperimentTemplateFunc: func(*fis.UpdateExperimentTemplateInput) (*fis.UpdateExperimentTemplateOutput, error) {
+					if tt.updateErr != nil {
+						return nil, tt.updateErr
+					}
+					return &fis.UpdateExperimentTemplateOutput{}, nil
+				},
+				GetExperimentTemplateFunc: func(params *fis.GetExperimentTemplateInput) (*fis.GetExperimentTemplateOutput, error) {
+					if tt.getErr != nil {
+						return nil, tt.getErr
+					}
+					return &fis.GetExperimentTemplateOutput{ExperimentTemplate: &fistypes.ExperimentTemplate{Id: params.Id}}, nil
+				},
+			}
+			template := newTestTemplate("validated-" + strings.ReplaceAll(tt.name, " ", "-"))
+			template.Generation = 1
+			reconciler := newTestReconciler(fisAPI, template)
+			ctx := context.Background()
+			key := types.NamespacedName{Name: template.Name}
+
+			current := &fisv1alpha1.ExperimentTemplate{}
+			if err := reconciler.Get(ctx, key, current); err != nil {
+				t.Fatalf("Failed to get template: %v", err)
+			}
+			if _, err := reconciler.updateFISExperimentTemplate(ctx, current, logr.Discard()); err != nil {
+				t.Fatalf("updateFISExperimentTemplate failed: %v", err)
+			}
+
+			if err := reconciler.Get(ctx, key, current); err != nil {
+				t.Fatalf("Failed to get template: %v", err)
+			}
+			cond := meta.FindStatusCondition(current.Status.Conditions, fisv1alpha1.ConditionValidated)
+			if cond == nil || cond.Status != tt.wantStatus || cond.Reason != tt.wantReason || cond.ObservedGeneration != 1 {
+				t.Fatalf("Expected Validated=%s with reason %s for generation 1, got: %+v", tt.wantStatus, tt.wantReason, cond)
+			}
+			// A template that could not be read back is still considered in sync by the controller
+			if tt.getErr != nil && !meta.IsStatusConditionTrue(current.Status.Conditions, fisv1alpha1.ConditionReady) {
+				t.Error("Expected Ready to stay True when only the read back failed")
+			}
+		})
+	}
+}
+
+func TestProvidedRoleAccessEntryManagement(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	enabled, disabled := true, false
+	tests := []struct {
+		name              string
+		skipAccessEntries bool
+		manageAccessEntry *bool
+		wantManaged       bool
+	}{
+		{name: "controller default", wantManaged: true},
+		{name: "disabled by template", manageAccessEntry: &disabled},
+		{name: "disabled by controller", skipAccessEntries: true},
+		{name: "enabled by template", skipAccessEntries: true, manageAccessEntry: &enabled, wantManaged: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			eksAPI := &awsfake.EKS{}
+			iamAPI := &awsfake.IAM{}
+			template := newTestTemplate("access-entry-" + strings.ReplaceAll(tt.name, " ", "-"))
+			template.Status.TemplateID = ""
+			template.Spec.ManageAccessEntry = tt.manageAccessEntry
+			reconciler := newTestReconciler(&awsfake.FIS{}, template)
+			reconciler.EKSClient = awsfis.NewEKSClientFromAPI(eksAPI)
+			reconciler.IAMClient = awsfis.NewIAMClientFromAPI(iamAPI)
+			reconciler.ClusterName = "test-cluster"
+			reconciler.SkipAccessEntries = tt.skipAccessEntries
+			ctx := context.Background()
+
+			if _, err := reconciler.createFISExperimentTemplate(ctx, template, logr.Discard()); err != nil {
+				t.Fatalf("createFISExperimentTemplate failed: %v", err)
+			}
+			if len(iamAPI.CreateRoleInputs) != 0 {
+				t.Errorf("Expected the provided role to be used without creating one, got %d CreateRole calls", len(iamAPI.CreateRoleInputs))
+			}
+			if got := len(eksAPI.CreateAccessEntryInputs) == 1; got != tt.wantManaged {
+				t.Errorf("Expected access entry created=%v, got %d CreateAccessEntry calls", tt.wantManaged, len(eksAPI.CreateAccessEntryInputs))
+			}
+
+			current := &fisv1alpha1.ExperimentTemplate{}
+			if err := reconciler.Get(ctx, types.NamespacedName{Name: template.Name}, current); err != nil {
+				t.Fatalf("Failed to get template: %v", err)
+			}
+			if _, err := reconciler.handleDeletion(ctx, current, logr.Discard()); err != nil {
+				t.Fatalf("handleDeletion failed: %v", err)
+			}
+			if got := len(eksAPI.DeleteAccessEntryInputs) == 1; got != tt.wantManaged {
+				t.Errorf("Expected access entry deleted=%v, got %d DeleteAccessEntry calls", tt.wantManaged, len(eksAPI.DeleteAccessEntryInputs))
+			}
+		})
+	}
+}
+
+func TestAccessEntryFailureSetsAccessEntryReadyFalse(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	eksAPI := &awsfake.EKS{
+		CreateAccessEntryFunc: func(*eks.CreateAccessEntryInput) (*eks.CreateAccessEntryOutput, error) {
+			return nil, errors.New("AccessDeniedException: not authorized to perform eks:CreateAccessEntry")
+		},
+	}
+	template := newTestTemplate("access-entry-failure")
+	template.Status.TemplateID = ""
+	reconciler := newTestReconciler(&awsfake.FIS{}, template)
+	reconciler.EKSClient = awsfis.NewEKSClientFromAPI(eksAPI)
+	reconciler.ClusterName = "test-cluster"
+	ctx := context.Background()
+	key := types.NamespacedName{Name: template.Name}
+
+	if _, err := reconciler.createFISExperimentTemplate(ctx, template, logr.Discard()); err != nil {
+		t.Fatalf("createFISExperimentTemplate failed: %v", err)
+	}
+
+	current := &fisv1alpha1.ExperimentTemplate{}
+	if err := reconciler.Get(ctx, key, current); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	if current.Status.Phase != "Ready" {
+		t.Errorf("Expected the template to stay Ready, got: %s", current.Status.Phase)
+	}
+	cond := meta.FindStatusCondition(current.Status.Conditions, fisv1alpha1.ConditionAccessEntryReady)
+	if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != fisv1alpha1.ReasonAccessEntryFailed {
+		t.Fatalf("Expected AccessEntryReady=False with reason AccessEntryFailed, got: %+v", cond)
+	}
+
+	// The failure persists across updates until the access entry can be created
+	if _, err := reconciler.updateFISExperimentTemplate(ctx, current, logr.Discard()); err != nil {
+		t.Fatalf("updateFISExperimentTemplate failed: %v", err)
+	}
+	if err := reconciler.Get(ctx, key, current); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	if meta.IsStatusConditionTrue(current.Status.Conditions, fisv1alpha1.ConditionAccessEntryReady) {
+		t.Error("Expected AccessEntryReady to stay False while creation keeps failing")
+	}
+
+	eksAPI.CreateAccessEntryFunc = nil
+	if _, err := reconciler.updateFISExperimentTemplate(ctx, current, logr.Discard()); err != nil {
+		t.Fatalf("updateFISExperimentTemplate failed: %v", err)
+	}
+	if err := reconciler.Get(ctx, key, current); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	if !meta.IsStatusConditionTrue(current.Status.Conditions, fisv1alpha1.ConditionAccessEntryReady) {
+		t.Error("Expected AccessEntryReady=True once the access entry is created")
+	}
+}
+
+func TestReconcileDeletesRBACOfMissingTemplate(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	template := newTestTemplate("orphan-test")
+	template.Status.TemplateID = ""
+	template.Spec.Targets = append(template.Spec.Targets, fisv1alpha1.TargetSpec{
+		Name: "api-pods", Namespace: "api", LabelSelector: map[string]string{"app": "api"},
+	})
+	reconciler := newTestReconciler(&awsfake.FIS{}, template)
+	ctx := context.Background()
+
+	if _, err := reconciler.createFISExperimentTemplate(ctx, template, logr.Discard()); err != nil {
+		t.Fatalf("createFISExperimentTemplate failed: %v", err)
+	}
+	role := &rbacv1.Role{}
+	if err := reconciler.Get(ctx, types.NamespacedName{Namespace: "api", Name: "fis-orphan-test"}, role); err != nil {
+		t.Fatalf("Expected Role in namespace api: %v", err)
+	}
+	if len(role.OwnerReferences) != 1 || role.OwnerReferences[0].Kind != "ExperimentTemplate" || role.OwnerReferences[0].Name != template.Name {
+		t.Errorf("Expected the Role to be owned by the template, got: %+v", role.OwnerReferences)
+	}
+
+	// The template disappears without its finalizer cleaning up, e.g. after a controller crash
+	current := &fisv1alpha1.ExperimentTemplate{}
+	if err := reconciler.Get(ctx, types.NamespacedName{Name: template.Name}, current); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	current.Finalizers = nil
+	if err := reconciler.Update(ctx, current); err != nil {
+		t.Fatalf("Failed to remove finalizers: %v", err)
+	}
+	if err := reconciler.Delete(ctx, current); err != nil {
+		t.Fatalf("Failed to delete template: %v", err)
+	}
+
+	if _, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: template.Name}}); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	for _, ns := range []string{"default", "api"} {
+		key := types.NamespacedName{Namespace: ns, Name: "fis-orphan-test"}
+		for _, obj := range []client.Object{&corev1.ServiceAccount{}, &rbacv1.Role{}, &rbacv1.RoleBinding{}} {
+			if err := reconciler.Get(ctx, key, obj); !apierrors.IsNotFound(err) {
+				t.Errorf("Expected %T %s to be deleted, got: %v", obj, key, err)
+			}
+		}
+	}
+}
+
+func TestUpdateMovesRBACToNewTargetNamespace(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	template := newTestTemplate("move-test")
+	template.Status.TemplateID = ""
+	template.Spec.Targets[0].Namespace = "a"
+	reconciler := newTestReconciler(&awsfake.FIS{}, template)
+	ctx := context.Background()
+
+	if _, err := reconciler.createFISExperimentTemplate(ctx, template, logr.Discard()); err != nil {
+		t.Fatalf("createFISExperimentTemplate failed: %v", err)
+	}
+
+	current := &fisv1alpha1.ExperimentTemplate{}
+	if err := reconciler.Get(ctx, types.NamespacedName{Name: template.Name}, current); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	if !slices.Equal(current.Status.RBACNamespaces, []string{"a"}) {
+		t.Fatalf("Expected RBAC namespaces [a] after create, got: %v", current.Status.RBACNamespaces)
+	}
+	current.Spec.Targets[0].Namespace = "b"
+	if err := reconciler.Update(ctx, current); err != nil {
+		t.Fatalf("Failed to move target: %v", err)
+	}
+	if _, err := reconciler.updateFISExperimentTemplate(ctx, current, logr.Discard()); err != nil {
+		t.Fatalf("updateFISExperimentTemplate failed: %v", err)
+	}
+
+	for _, obj := range []client.Object{&corev1.ServiceAccount{}, &rbacv1.Role{}, &rbacv1.RoleBinding{}} {
+		if err := reconciler.Get(ctx, types.NamespacedName{Namespace: "a", Name: "fis-move-test"}, obj); !apierrors.IsNotFound(err) {
+			t.Errorf("Expected %T in namespace a to be deleted, got: %v", obj, err)
+		}
+		if err := reconciler.Get(ctx, types.NamespacedName{Namespace: "b", Name: "fis-move-test"}, obj); err != nil {
+			t.Errorf("Expected %T in namespace b: %v", obj, err)
+		}
+	}
+
+	updated := &fisv1alpha1.ExperimentTemplate{}
+	if err := reconciler.Get(ctx, types.NamespacedName{Name: template.Name}, updated); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	if !slices.Equal(updated.Status.RBACNamespaces, []string{"b"}) {
+		t.Errorf("Expected RBAC namespaces [b] after update, got: %v", updated.Status.RBACNamespaces)
+	}
+}
+
+func TestRBACModeCreatesMatchingObjectKinds(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	template := newTestTemplate("mode-test")
+	template.Status.TemplateID = ""
+	template.Spec.Targets = append(template.Spec.Targets, fisv1alpha1.TargetSpec{
+		Name: "api-pods", Namespace: "api", LabelSelector: map[string]string{"app": "api"},
+	})
+	reconciler := newTestReconciler(&awsfake.FIS{}, template)
+	ctx := context.Background()
+	clusterKey := types.NamespacedName{Name: "fis-mode-test"}
+
+	if _, err := reconciler.createFISExperimentTemplate(ctx, template, logr.Discard()); err != nil {
+		t.Fatalf("createFISExperimentTemplate failed: %v", err)
+	}
+	for _, ns := range []string{"default", "api"} {
+		key := types.NamespacedName{Namespace: ns, Name: "fis-mode-test"}
+		for _, obj := range []client.Object{&corev1.ServiceAccount{}, &rbacv1.Role{}, &rbacv1.RoleBinding{}} {
+			if err := reconciler.Get(ctx, key, obj); err != nil {
+				t.Errorf("Namespaced: expected %T %s: %v", obj, key, err)
+			}
+		}
+	}
+	if err := reconciler.Get(ctx, clusterKey, &rbacv1.ClusterRole{}); !apierrors.IsNotFound(err) {
+		t.Errorf("Namespaced: expected no ClusterRole, got: %v", err)
+	}
+
+	current := &fisv1alpha1.ExperimentTemplate{}
+	if err := reconciler.Get(ctx, types.NamespacedName{Name: template.Name}, current); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	current.Spec.RBACMode = fisv1alpha1.RBACModeClusterWide
+	if err := reconciler.Update(ctx, current); err != nil {
+		t.Fatalf("Failed to switch RBAC mode: %v", err)
+	}
+	if _, err := reconciler.updateFISExperimentTemplate(ctx, current, logr.Discard()); err != nil {
+		t.Fatalf("updateFISExperimentTemplate failed: %v", err)
+	}
+
+	for _, ns := range []string{"default", "api"} {
+		key := types.NamespacedName{Namespace: ns, Name: "fis-mode-test"}
+		if err := reconciler.Get(ctx, key, &corev1.ServiceAccount{}); err != nil {
+			t.Errorf("ClusterWide: expected ServiceAccount %s: %v", key, err)
+		}
+		for _, obj := range []client.Object{&rbacv1.Role{}, &rbacv1.RoleBinding{}} {
+			if err := reconciler.Get(ctx, key, obj); !apierrors.IsNotFound(err) {
+				t.Errorf("ClusterWide: expected %T %s to be deleted, got: %v", obj, key, err)
+			}
+		}
+	}
+	if err := reconciler.Get(ctx, clusterKey, &rbacv1.ClusterRole{}); err != nil {
+		t.Errorf("ClusterWide: expected ClusterRole: %v", err)
+	}
+	binding := &rbacv1.ClusterRoleBinding{}
+	if err := reconciler.Get(ctx, clusterKey, binding); err != nil {
+		t.Fatalf("ClusterWide: expected ClusterRoleBinding: %v", err)
+	}
+	if len(binding.Subjects) != 3 {
+		t.Errorf("Expected both ServiceAccounts and the username as subjects, got: %+v", binding.Subjects)
+	}
+}
+
+func TestDriftDetectionReappliesChangedTemplate(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	fisAPI := &awsfake.FIS{
+		GetExperimentTemplateFunc: func(params *fis.GetExperimentTemplateInput) (*fis.GetExperimentTemplateOutput, error) {
+			// Someone edited the description in the console
+			return &fis.GetExperimentTemplateOutput{ExperimentTemplate: &fistypes.ExperimentTemplate{
+				Id:          params.Id,
+				Description: aws.String("edited in the console"),
+			}}, nil
+		},
+	}
+	template := newTestTemplate("drift-test")
+	template.Finalizers = []string{finalizerName}
+	template.Status.TemplateVersion = 1
+	reconciler := newTestReconciler(fisAPI, template)
+	reconciler.DriftCheckInterval = 5 * time.Minute
+	recorder := record.NewFakeRecorder(10)
+	reconciler.Recorder = recorder
+	ctx := context.Background()
+	key := types.NamespacedName{Name: template.Name}
+
+	current := &fisv1alpha1.ExperimentTemplate{}
+	if err := reconciler.Get(ctx, key, current); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	current.Status.ObservedGeneration = current.Generation
+	if err := reconciler.Status().Update(ctx, current); err != nil {
+		t.Fatalf("Failed to update status: %v", err)
+	}
+
+	result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	if err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if len(fisAPI.UpdateExperimentTemplateInputs) != 1 {
+		t.Fatalf("Expected the drifted template to be re-applied, got %d updates", len(fisAPI.UpdateExperimentTemplateInputs))
+	}
+	if got := aws.ToString(fisAPI.UpdateExperimentTemplateInputs[0].Description); got != "test template" {
+		t.Errorf("Expected the spec description to be re-applied, got: %q", got)
+	}
+	if result.RequeueAfter != 5*time.Minute {
+		t.Errorf("Expected the next drift check in 5m, got: %+v", result)
+	}
+
+	var driftEvent bool
+	for len(recorder.Events) > 0 {
+		if event := <-recorder.Events; strings.Contains(event, fisv1alpha1.ReasonDriftDetected) {
+			driftEvent = strings.Contains(event, "edited in the console")
+		}
+	}
+	if !driftEvent {
+		t.Error("Expected a DriftDetected event naming the drifted description")
+	}
+}
+
+func TestRBACNamespaceCentralizesRBAC(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	tests := []struct {
+		name          string
+		rbacNamespace string
+		wantSAs       []string
+		wantRoles     []string
+		wantCluster   bool
+	}{
+		{name: "per target namespace", wantSAs: []string{"api", "default"}, wantRoles: []string{"api", "default"}},
+		{name: "centralized", rbacNamespace: "chaos", wantSAs: []string{"chaos"}, wantCluster: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := newTestTemplate("central-test")
+			template.Status.TemplateID = ""
+			template.Spec.Targets = append(template.Spec.Targets, fisv1alpha1.TargetSpec{
+				Name: "api-pods", Namespace: "api", LabelSelector: map[string]string{"app": "api"},
+			})
+			reconciler := newTestReconciler(&awsfake.FIS{}, template)
+			reconciler.RBACNamespace = tt.rbacNamespace
+			ctx := context.Background()
+
+			if _, err := reconciler.createFISExperimentTemplate(ctx, template, logr.Discard()); err != nil {
+				t.Fatalf("createFISExperimentTemplate failed: %v", err)
+			}
+
+			namespacesOf := func(list client.ObjectList) []string {
+				if err := reconciler.List(ctx, list); err != nil {
+					t.Fatalf("Failed to list %T: %v", list, err)
+				}
+				objs, _ := meta.ExtractList(list)
+				var namespaces []string
+				for _, obj := range objs {
+					namespaces = append(namespaces, obj.(client.Object).GetNamespace())
+				}
+				slices.Sort(namespaces)
+				return namespaces
+			}
+			if got := namespacesOf(&corev1.ServiceAccountList{}); !slices.Equal(got, tt.wantSAs) {
+				t.Errorf("Expected ServiceAccounts in %v, got: %v", tt.wantSAs, got)
+			}
+			if got := namespacesOf(&rbacv1.RoleList{}); !slices.Equal(got, tt.wantRoles) {
+				t.Errorf("Expected Roles in %v, got: %v", tt.wantRoles, got)
+			}
+			if got := namespacesOf(&rbacv1.RoleBindingList{}); !slices.Equal(got, tt.wantRoles) {
+				t.Errorf("Expected RoleBindings in %v, got: %v", tt.wantRoles, got)
+			}
+
+			binding := &rbacv1.ClusterRoleBinding{}
+			err := reconciler.Get(ctx, types.NamespacedName{Name: "fis-central-test"}, binding)
+			if !tt.wantCluster {
+				if !apierrors.IsNotFound(err) {
+					t.Errorf("Expected no ClusterRoleBinding, got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected ClusterRoleBinding: %v", err)
+			}
+			if binding.Subjects[0].Kind != "ServiceAccount" || binding.Subjects[0].Namespace != tt.rbacNamespace {
+				t.Errorf("Expected the ServiceAccount in %s to be bound, got: %+v", tt.rbacNamespace, binding.Subjects)
+			}
+			if err := reconciler.Get(ctx, types.NamespacedName{Name: "fis-central-test"}, &rbacv1.ClusterRole{}); err != nil {
+				t.Errorf("Expected ClusterRole: %v", err)
+			}
+		})
+	}
+}
+
+func TestLastSyncTimeAdvancesOnUpdateAndResync(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	fisAPI := &awsfake.FIS{}
+	// AWS holds whatever was last applied, and a stale template before that
+	fisAPI.GetExperimentTemplateFunc = func(params *fis.GetExperimentTemplateInput) (*fis.GetExperimentTemplateOutput, error) {
+		if n := len(fisAPI.UpdateExperimentTemplateInputs); n > 0 {
+			return &fis.GetExperimentTemplateOutput{ExperimentTemplate: templateFromUpdateInput(fisAPI.UpdateExperimentTemplateInputs[n-1])}, nil
+		}
+		return &fis.GetExperimentTemplateOutput{ExperimentTemplate: &fistypes.ExperimentTemplate{Id: params.Id}}, nil
+	}
+	template := newTestTemplate("sync-time-test")
+	template.Finalizers = []string{finalizerName}
+	reconciler := newTestReconciler(fisAPI, template)
+	reconciler.DriftCheckInterval = 5 * time.Minute
+	ctx := context.Background()
+	key := types.NamespacedName{Name: template.Name}
+
+	stale := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	markStale := func() {
+		t.Helper()
+		current := &fisv1alpha1.ExperimentTemplate{}
+		if err := reconciler.Get(ctx, key, current); err != nil {
+			t.Fatalf("Failed to get template: %v", err)
+		}
+		current.Status.ObservedGeneration = current.Generation
+		current.Status.LastSyncTime = &stale
+		if err := reconciler.Status().Update(ctx, current); err != nil {
+			t.Fatalf("Failed to update status: %v", err)
+		}
+	}
+	assertAdvanced := func(step string) {
+		t.Helper()
+		current := &fisv1alpha1.ExperimentTemplate{}
+		if err := reconciler.Get(ctx, key, current); err != nil {
+			t.Fatalf("Failed to get template: %v", err)
+		}
+		if current.Status.LastSyncTime == nil || !current.Status.LastSyncTime.After(stale.Time) {
+			t.Errorf("%s: expected LastSyncTime to advance past %s, got: %v", step, stale, current.Status.LastSyncTime)
+		}
+	}
+
+	// The first resync re-applies the stale AWS template
+	markStale()
+	if _, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if len(fisAPI.UpdateExperimentTemplateInputs) != 1 {
+		t.Fatalf("Expected 1 update, got: %d", len(fisAPI.UpdateExperimentTemplateInputs))
+	}
+	assertAdvanced("update")
+
+	// A resync without changes still refreshes the sync time
+	markStale()
+	if _, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if len(fisAPI.UpdateExperimentTemplateInputs) != 1 {
+		t.Errorf("Expected no update for an unchanged template, got: %d", len(fisAPI.UpdateExperimentTemplateInputs))
+	}
+	assertAdvanced("resync")
+}
+
+// templateFromUpdateInput returns the AWS FIS template an update input results in, as far as diffs compare it
+func templateFromUpdateInput(input *fis.UpdateExperimentTemplateInput) *fistypes.ExperimentTemplate {
+	template := &fistypes.ExperimentTemplate{
+		Id:          input.Id,
+		Description: input.Description,
+		RoleArn:     input.RoleArn,
+		Targets:     map[string]fistypes.ExperimentTemplateTarget{},
+		Actions:     map[string]fistypes.ExperimentTemplateAction{},
+	}
+	for name, target := range input.Targets {
+		var filters []fistypes.ExperimentTemplateTargetFilter
+		for _, f := range target.Filters {
+			filters = append(filters, fistypes.ExperimentTemplateTargetFilter{Path: f.Path, Values: f.Values})
+		}
+		template.Targets[name] = fistypes.ExperimentTemplateTarget{
+			ResourceType:  target.ResourceType,
+			SelectionMode: target.SelectionMode,
+			Parameters:    target.Parameters,
+			ResourceTags:  target.ResourceTags,
+			ResourceArns:  target.ResourceArns,
+			Filters:       filters,
+		}
+	}
+	for name, action := range input.Actions {
+		template.Actions[name] = fistypes.ExperimentTemplateAction{
+			ActionId:    action.ActionId,
+			Description: action.Description,
+			Parameters:  action.Parameters,
+			Targets:     action.Targets,
+			StartAfter:  action.StartAfter,
+		}
+	}
+	for _, cond := range input.StopConditions {
+		template.StopConditions = append(template.StopConditions, fistypes.ExperimentTemplateStopCondition{Source: cond.Source, Value: cond.Value})
+	}
+	return template
+}
+
+func TestInventoryMetricsFollowCreateAndDelete(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	gaugeValue := func(gauge prometheus.Gauge) float64 {
+		m := &dto.Metric{}
+		if err := gauge.Write(m); err != nil {
+			t.Fatalf("Failed to read gauge: %v", err)
+		}
+		return m.GetGauge().GetValue()
+	}
+
+	template := newTestTemplate("inventory-test")
+	template.Status = fisv1alpha1.ExperimentTemplateStatus{}
+	reconciler := newTestReconciler(&awsfake.FIS{}, template)
+	reconciler.IAMClient = awsfis.NewIAMClientFromAPI(&awsfake.IAM{})
+	reconciler.EKSClient = awsfis.NewEKSClientFromAPI(&awsfake.EKS{})
+	reconciler.ClusterName = "test-cluster"
+	reconciler.Inventory = &metrics.Inventory{}
+	ctx := context.Background()
+	req := ctrl.Request{NamespacedName: types.NamespacedName{Name: template.Name}}
+
+	// The first reconcile adds the finalizer, the second creates the resources
+	for i := 0; i < 2; i++ {
+		if _, err := reconciler.Reconcile(ctx, req); err != nil {
+			t.Fatalf("Reconcile %d failed: %v", i+1, err)
+		}
+	}
+	for _, tt := range []struct {
+		name  string
+		gauge prometheus.Gauge
+		want  float64
+	}{
+		{name: "templates", gauge: metrics.ManagedTemplates, want: 1},
+		{name: "IAM roles", gauge: metrics.ManagedIAMRoles, want: 1},
+		{name: "access entries", gauge: metrics.ManagedAccessEntries, want: 1},
+		{name: "RBAC objects", gauge: metrics.ManagedRBACObjects, want: 3},
+	} {
+		if got := gaugeValue(tt.gauge); got != tt.want {
+			t.Errorf("Expected %v managed %s after create, got: %v", tt.want, tt.name, got)
+		}
+	}
+
+	current := &fisv1alpha1.ExperimentTemplate{}
+	if err := reconciler.Get(ctx, req.NamespacedName, current); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	if err := reconciler.Delete(ctx, current); err != nil {
+		t.Fatalf("Failed to delete template: %v", err)
+	}
+	if _, err := reconciler.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile after delete failed: %v", err)
+	}
+	for _, gauge := range []prometheus.Gauge{metrics.ManagedTemplates, metrics.ManagedIAMRoles, metrics.ManagedAccessEntries, metrics.ManagedRBACObjects} {
+		if got := gaugeValue(gauge); got != 0 {
+			t.Errorf("Expected nothing managed after delete, got: %v", got)
+		}
+	}
+}
+
+func TestStartAfterDefersCreation(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	fisAPI := &awsfake.FIS{}
+	template := newTestTemplate("start-after-test")
+	template.Status.TemplateID = ""
+	template.Finalizers = []string{finalizerName}
+	template.Annotations[startAfterAnnotation] = time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	reconciler := newTestReconciler(fisAPI, template)
+	recorder := record.NewFakeRecorder(10)
+	reconciler.Recorder = recorder
+	ctx := context.Background()
+	key := types.NamespacedName{Name: template.Name}
+
+	result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key})
+	if err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if len(fisAPI.CreateExperimentTemplateInputs) != 0 {
+		t.Fatalf("Expected creation to be deferred, got %d CreateExperimentTemplate calls", len(fisAPI.CreateExperimentTemplateInputs))
+	}
+	if result.RequeueAfter <= 0 || result.RequeueAfter > time.Hour {
+		t.Errorf("Expected a requeue at the start-after time, got: %+v", result)
+	}
+	current := &fisv1alpha1.ExperimentTemplate{}
+	if err := reconciler.Get(ctx, key, current); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	cond := meta.FindStatusCondition(current.Status.Conditions, fisv1alpha1.ConditionProgressing)
+	if current.Status.Phase != "Pending" || cond == nil || cond.Reason != fisv1alpha1.ReasonStartDeferred {
+		t.Errorf("Expected phase Pending with reason %s, got phase %q and condition %+v", fisv1alpha1.ReasonStartDeferred, current.Status.Phase, cond)
+	}
+	if len(recorder.Events) != 1 {
+		t.Errorf("Expected 1 StartDeferred event, got: %d", len(recorder.Events))
+	}
+
+	// Once the grace period has passed the template is created
+	current.Annotations[startAfterAnnotation] = time.Now().Add(-time.Minute).UTC().Format(time.RFC3339)
+	if err := reconciler.Update(ctx, current); err != nil {
+		t.Fatalf("Failed to update template: %v", err)
+	}
+	if _, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: key}); err != nil {
+		t.Fatalf("Reconcile failed: %v", err)
+	}
+	if len(fisAPI.CreateExperimentTemplateInputs) != 1 {
+		t.Errorf("Expected the template to be created after the grace period, got %d CreateExperimentTemplate calls", len(fisAPI.CreateExperimentTemplateInputs))
+	}
+}
+
+func TestStartAfter(t *testing.T) {
+	created := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		value   string
+		want    time.Time
+		wantErr bool
+	}{
+		{name: "unset"},
+		{name: "duration", value: "10m", want: created.Add(10 * time.Minute)},
+		{name: "timestamp", value: "2026-03-01T13:30:00Z", want: time.Date(2026, 3, 1, 13, 30, 0, 0, time.UTC)},
+		{name: "invalid", value: "tomorrow", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := newTestTemplate("start-after")
+			template.CreationTimestamp = metav1.NewTime(created)
+			if tt.value != "" {
+				template.Annotations[startAfterAnnotation] = tt.value
+			}
+
+			got, err := startAfter(template)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expected error=%t, got: %v", tt.wantErr, err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestRecordResolvedConfigAfterCreate(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	// AWS FIS coerced the selection mode and normalized the duration
+	fisAPI := &awsfake.FIS{
+		GetExperimentTemplateFunc: func(params *fis.GetExperimentTemplateInput) (*fis.GetExperimentTemplateOutput, error) {
+			return &fis.GetExperimentTemplateOutput{ExperimentTemplate: &fistypes.ExperimentTemplate{
+				Id: params.Id,
+				Targets: map[string]fistypes.ExperimentTemplateTarget{
+					"nginx-pods": {SelectionMode: aws.String("COUNT(1)")},
+				},
+				Actions: map[string]fistypes.ExperimentTemplateAction{
+					"cpu-stress": {Parameters: map[string]string{"duration": "PT5M"}},
+				},
+				ExperimentOptions: &fistypes.ExperimentTemplateExperimentOptions{
+					EmptyTargetResolutionMode: fistypes.EmptyTargetResolutionModeFail,
+				},
+			}}, nil
+		},
+	}
+
+	tests := []struct {
+		name   string
+		record bool
+		want   *fisv1alpha1.ResolvedTemplateConfig
+	}{
+		{name: "disabled"},
+		{
+			name:   "enabled",
+			record: true,
+			want: &fisv1alpha1.ResolvedTemplateConfig{
+				TargetSelectionModes:      map[string]string{"nginx-pods": "COUNT(1)"},
+				ActionDurations:           map[string]string{"cpu-stress": "PT5M"},
+				EmptyTargetResolutionMode: "fail",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := newTestTemplate("resolved-config-test")
+			template.Status.TemplateID = ""
+			reconciler := newTestReconciler(fisAPI, template)
+			reconciler.RecordResolvedConfig = tt.record
+			ctx := context.Background()
+
+			if _, err := reconciler.createFISExperimentTemplate(ctx, template, logr.Discard()); err != nil {
+				t.Fatalf("createFISExperimentTemplate failed: %v", err)
+			}
+
+			current := &fisv1alpha1.ExperimentTemplate{}
+			if err := reconciler.Get(ctx, types.NamespacedName{Name: template.Name}, current); err != nil {
+				t.Fatalf("Failed to get template: %v", err)
+			}
+			if !equality.Semantic.DeepEqual(current.Status.ResolvedConfig, tt.want) {
+				t.Errorf("Expected resolvedConfig %+v, got: %+v", tt.want, current.Status.ResolvedConfig)
+			}
+		})
+	}
+}
+
+func TestPhaseDuringCreateAndDelete(t *testing.T) {
+	os.Unsetenv("FIS_ROLE_ARN")
+	os.Unsetenv("CLUSTER_IDENTIFIER")
+
+	fisAPI := &awsfake.FIS{}
+	template := newTestTemplate("phase-test")
+	template.Status.TemplateID = ""
+	reconciler := newTestReconciler(fisAPI, template)
+	reconciler.IAMClient = awsfis.NewIAMClientFromAPI(&awsfake.IAM{})
+	ctx := context.Background()
+	key := types.NamespacedName{Name: template.Name}
+
+	// Record the stored phase while each AWS call is in flight
+	storedPhase := func() string {
+		current := &fisv1alpha1.ExperimentTemplate{}
+		if err := reconciler.Get(ctx, key, current); err != nil {
+			t.Fatalf("Failed to get template: %v", err)
+		}
+		return current.Status.Phase
+	}
+	var createPhase, deletePhase string
+	fisAPI.CreateExperimentTemplateFunc = func(*fis.CreateExperimentTemplateInput) (*fis.CreateExperimentTemplateOutput, error) {
+		createPhase = storedPhase()
+		return &fis.CreateExperimentTemplateOutput{ExperimentTemplate: &fistypes.ExperimentTemplate{Id: aws.String("EXTphase")}}, nil
+	}
+	fisAPI.DeleteExperimentTemplateFunc = func(*fis.DeleteExperimentTemplateInput) (*fis.DeleteExperimentTemplateOutput, error) {
+		deletePhase = storedPhase()
+		return &fis.DeleteExperimentTemplateOutput{}, nil
+	}
+
+	if _, err := reconciler.createFISExperimentTemplate(ctx, template, logr.Discard()); err != nil {
+		t.Fatalf("createFISExperimentTemplate failed: %v", err)
+	}
+	if createPhase != "Creating" {
+		t.Errorf("Expected phase Creating during the AWS create, got: %q", createPhase)
+	}
+	if phase := storedPhase(); phase != "Ready" {
+		t.Errorf("Expected phase Ready after create, got: %q", phase)
+	}
+
+	current := &fisv1alpha1.ExperimentTemplate{}
+	if err := reconciler.Get(ctx, key, current); err != nil {
+		t.Fatalf("Failed to get template: %v", err)
+	}
+	if _, err := reconciler.handleDeletion(ctx, current, logr.Discard()); err != nil {
+		t.Fatalf("handleDeletion failed: %v", err)
+	}
+	if deletePhase != "Deleting" {
+		t.Errorf("Expected phase Deleting during the AWS delete, got: %q", deletePhase)
+	}
+}
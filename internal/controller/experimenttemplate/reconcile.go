@@ -20,21 +20,47 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	fisv1alpha1 "fis.dksshddl.dev/fis-controller/api/v1alpha1"
 	awsfis "fis.dksshddl.dev/fis-controller/internal/aws"
+	"fis.dksshddl.dev/fis-controller/internal/metrics"
 	"fis.dksshddl.dev/fis-controller/internal/utils"
+	"fis.dksshddl.dev/fis-controller/internal/validation"
 )
 
 // getRequiredParameters extracts required parameters from environment or annotations
 // If roleArn is not provided, it will be automatically created
 func (r *Reconciler) getRequiredParameters(ctx context.Context, template *fisv1alpha1.ExperimentTemplate) (roleArn, clusterIdentifier string, err error) {
+	return r.resolveParameters(ctx, template, true)
+}
+
+// resolveParameters extracts required parameters like getRequiredParameters
+// Without createRole a missing role ARN is returned empty instead of creating the IAM role
+func (r *Reconciler) resolveParameters(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, createRole bool) (roleArn, clusterIdentifier string, err error) {
+	// A role ARN stored in a Secret takes precedence over every other source
+	if template.Spec.RoleArnFrom != nil {
+		roleArn, err = r.resolveRoleArnFrom(ctx, template.Spec.RoleArnFrom)
+		if err != nil {
+			return "", "", err
+		}
+	}
+
 	// Get FIS Role ARN (optional - will be auto-created if not provided)
-	roleArn = os.Getenv("FIS_ROLE_ARN")
+	if roleArn == "" {
+		roleArn = os.Getenv("FIS_ROLE_ARN")
+	}
 	if roleArn == "" {
 		if val, ok := template.Annotations["fis.dksshddl.dev/role-arn"]; ok {
 			roleArn = val
@@ -46,9 +72,9 @@ func (r *Reconciler) getRequiredParameters(ctx context.Context, template *fisv1a
 		// Check if we already have a role in status
 		if template.Status.RoleArn != "" {
 			roleArn = template.Status.RoleArn
-		} else {
+		} else if createRole {
 			// Create or get existing IAM role (cluster-scoped, no namespace)
-			createdRoleArn, err := awsfis.EnsureIAMRole(ctx, r.IAMClient, "", template.Name, "")
+			createdRoleArn, err := awsfis.EnsureIAMRole(ctx, r.IAMClient, "", template.Name, "", r.rolePolicyOptions(template))
 			if err != nil {
 				return "", "", fmt.Errorf("failed to ensure IAM role: %w", err)
 			}
@@ -77,7 +103,43 @@ func (r *Reconciler) getRequiredParameters(ctx context.Context, template *fisv1a
 	return roleArn, clusterIdentifier, nil
 }
 
-// getTargetNamespaces extracts unique namespaces from targets
+// roleArnPattern matches the IAM role ARNs spec.roleArnFrom may resolve to
+var roleArnPattern = regexp.MustCompile(`^arn:aws[a-z-]*:iam::\d{12}:role/`)
+
+// resolveRoleArnFrom reads the IAM role ARN from the referenced Secret key
+// Only Secrets in RoleArnSecretNamespace are read, and the value is never echoed since it may not be an ARN
+func (r *Reconciler) resolveRoleArnFrom(ctx context.Context, selector *fisv1alpha1.SecretKeySelector) (string, error) {
+	if r.RoleArnSecretNamespace == "" || selector.Namespace != r.RoleArnSecretNamespace {
+		return "", fmt.Errorf("roleArnFrom must reference a Secret in the controller's namespace %q, got namespace %q",
+			r.RoleArnSecretNamespace, selector.Namespace)
+	}
+
+	reader := r.APIReader
+	if reader == nil {
+		reader = r.Client
+	}
+
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: selector.Namespace, Name: selector.Name}
+	if err := reader.Get(ctx, key, secret); err != nil {
+		return "", fmt.Errorf("failed to get role ARN secret %s: %w", key, err)
+	}
+
+	value, ok := secret.Data[selector.Key]
+	if !ok {
+		return "", fmt.Errorf("role ARN secret %s has no key %q", key, selector.Key)
+	}
+	roleArn := strings.TrimSpace(string(value))
+	if roleArn == "" {
+		return "", fmt.Errorf("role ARN secret %s key %q is empty", key, selector.Key)
+	}
+	if !roleArnPattern.MatchString(roleArn) {
+		return "", fmt.Errorf("role ARN secret %s key %q does not hold an IAM role ARN", key, selector.Key)
+	}
+	return roleArn, nil
+}
+
+// getTargetNamespaces extracts unique namespaces from targets, sorted
 func getTargetNamespaces(template *fisv1alpha1.ExperimentTemplate) []string {
 	namespaceSet := make(map[string]bool)
 	for _, target := range template.Spec.Targets {
@@ -89,19 +151,529 @@ func getTargetNamespaces(template *fisv1alpha1.ExperimentTemplate) []string {
 	for ns := range namespaceSet {
 		namespaces = append(namespaces, ns)
 	}
+	sort.Strings(namespaces)
 	return namespaces
 }
 
+// rbacMode returns the RBAC mode of the template, defaulting to Namespaced
+func rbacMode(template *fisv1alpha1.ExperimentTemplate) fisv1alpha1.RBACMode {
+	if template.Spec.RBACMode == "" {
+		return fisv1alpha1.RBACModeNamespaced
+	}
+	return template.Spec.RBACMode
+}
+
+// clusterWideRBAC reports whether the template's RBAC uses a ClusterRole and ClusterRoleBinding,
+// either by its spec or because the controller centralizes RBAC in one namespace
+func (r *Reconciler) clusterWideRBAC(template *fisv1alpha1.ExperimentTemplate) bool {
+	return r.RBACNamespace != "" || rbacMode(template) == fisv1alpha1.RBACModeClusterWide
+}
+
+// serviceAccountRoleArn returns the IRSA role of the template's ServiceAccount, the spec overriding the controller default
+func (r *Reconciler) serviceAccountRoleArn(template *fisv1alpha1.ExperimentTemplate) string {
+	if template.Spec.ServiceAccountRoleArn != "" {
+		return template.Spec.ServiceAccountRoleArn
+	}
+	return r.ServiceAccountRoleArn
+}
+
+// rbacNamespaces returns the namespaces that get the template's ServiceAccount: the controller's
+// RBAC namespace when set, otherwise the target namespaces
+func (r *Reconciler) rbacNamespaces(template *fisv1alpha1.ExperimentTemplate) []string {
+	if r.RBACNamespace != "" {
+		return []string{r.RBACNamespace}
+	}
+	return getTargetNamespaces(template)
+}
+
+// setupRBAC creates the Kubernetes RBAC resources of the template in the given namespaces and returns the
+// ServiceAccount name. Resources of the other mode are removed so switching modes leaves nothing behind
+func (r *Reconciler) setupRBAC(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, namespaces []string, rbacName string) (string, error) {
+	if r.clusterWideRBAC(template) {
+		serviceAccount, err := utils.SetupExperimentTemplateClusterRBAC(ctx, r.Client, namespaces, template.Name, rbacName, getActionTypes(template), r.serviceAccountRoleArn(template), rbacOwner(template))
+		if err != nil {
+			return "", err
+		}
+		for _, ns := range namespaces {
+			if err := utils.DeleteExperimentTemplateRoles(ctx, r.Client, ns, rbacName); err != nil {
+				return "", fmt.Errorf("failed to delete namespaced RBAC in namespace %s: %w", ns, err)
+			}
+		}
+		return serviceAccount, nil
+	}
+
+	var serviceAccount string
+	for _, ns := range namespaces {
+		sa, err := utils.SetupExperimentTemplateRBAC(ctx, r.Client, ns, template.Name, rbacName, getActionTypes(template), r.serviceAccountRoleArn(template), rbacOwner(template))
+		if err != nil {
+			return "", fmt.Errorf("failed to set up RBAC in namespace %s: %w", ns, err)
+		}
+		serviceAccount = sa // Use the same service account name pattern
+	}
+	if err := utils.DeleteExperimentTemplateClusterRBAC(ctx, r.Client, rbacName); err != nil {
+		return "", err
+	}
+	return serviceAccount, nil
+}
+
+// removedNamespaces returns the namespaces RBAC was provisioned in that no longer get RBAC
+func removedNamespaces(template *fisv1alpha1.ExperimentTemplate, namespaces []string) []string {
+	var removed []string
+	for _, ns := range template.Status.RBACNamespaces {
+		if !slices.Contains(namespaces, ns) {
+			removed = append(removed, ns)
+		}
+	}
+	return removed
+}
+
+// rbacOwner makes the template the owner of its RBAC resources, so Kubernetes deletes them with the
+// template even when the finalizer never ran; a cluster-scoped owner may own namespaced objects
+func rbacOwner(template *fisv1alpha1.ExperimentTemplate) *metav1.OwnerReference {
+	return metav1.NewControllerRef(template, fisv1alpha1.GroupVersion.WithKind("ExperimentTemplate"))
+}
+
+// getActionTypes extracts the unique types of the pod actions, sorted
+// Other actions do not run in the cluster, so they need no Kubernetes RBAC
+func getActionTypes(template *fisv1alpha1.ExperimentTemplate) []string {
+	var actionTypes []string
+	for _, action := range template.Spec.Actions {
+		if awsfis.ActionResourceType(action.Type) != fisv1alpha1.ResourceTypeEKSPod {
+			continue
+		}
+		if !slices.Contains(actionTypes, action.Type) {
+			actionTypes = append(actionTypes, action.Type)
+		}
+	}
+	sort.Strings(actionTypes)
+	return actionTypes
+}
+
+// accessPolicies returns the access policies to associate with the template's access entry,
+// scoped to its target namespaces
+func (r *Reconciler) accessPolicies(targetNamespaces []string) []awsfis.AccessPolicyAssociation {
+	if r.AccessPolicyArn == "" {
+		return nil
+	}
+	return []awsfis.AccessPolicyAssociation{{PolicyArn: r.AccessPolicyArn, Namespaces: targetNamespaces}}
+}
+
+// manageAccessEntry reports whether the controller manages the EKS access entry of the template's role
+// This is independent of whether the role was provided or auto-created
+func (r *Reconciler) manageAccessEntry(template *fisv1alpha1.ExperimentTemplate) bool {
+	if r.EKSClient == nil || r.ClusterName == "" {
+		return false
+	}
+	if template.Spec.ManageAccessEntry != nil {
+		return *template.Spec.ManageAccessEntry
+	}
+	return !r.SkipAccessEntries
+}
+
+// rolePolicyOptions returns the policies for the template's auto-created role: the controller-wide
+// managed policies followed by the template's own, with the inline policy scoped to the controller's
+// cluster and the template's log group
+func (r *Reconciler) rolePolicyOptions(template *fisv1alpha1.ExperimentTemplate) awsfis.RolePolicyOptions {
+	var policyArns []string
+	for _, policyArn := range append(slices.Clone(r.RolePolicyArns), template.Spec.RolePolicyArns...) {
+		if !slices.Contains(policyArns, policyArn) {
+			policyArns = append(policyArns, policyArn)
+		}
+	}
+	opts := awsfis.RolePolicyOptions{
+		PolicyArns:          policyArns,
+		SkipInlinePolicy:    r.SkipInlineRolePolicy,
+		ClusterArn:          r.ClusterARN,
+		PermissionsBoundary: r.PermissionsBoundary,
+	}
+	if logConfig := template.Spec.LogConfiguration; logConfig != nil && logConfig.CloudWatchLogsConfiguration != nil {
+		opts.LogGroupArn = logConfig.CloudWatchLogsConfiguration.LogGroupArn
+	}
+	return opts
+}
+
+// rbacName returns the name of the ServiceAccount, Role, RoleBinding and username for a template
+func (r *Reconciler) rbacName(template *fisv1alpha1.ExperimentTemplate) (string, error) {
+	return utils.ExperimentTemplateRBACName(r.ServiceAccountNameTemplate, template.Name)
+}
+
+// forceSyncRequested reports whether the force-sync annotation holds a value that has not been synced yet
+func forceSyncRequested(template *fisv1alpha1.ExperimentTemplate) bool {
+	token := template.Annotations[forceSyncAnnotation]
+	return token != "" && token != template.Status.LastForceSync
+}
+
+// startAfter returns the time from which the AWS template of an ExperimentTemplate may be created
+// The start-after annotation holds an RFC3339 time or a duration counted from the resource's creation
+func startAfter(template *fisv1alpha1.ExperimentTemplate) (time.Time, error) {
+	value := template.Annotations[startAfterAnnotation]
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("annotation %s must be an RFC3339 time or a duration, got %q", startAfterAnnotation, value)
+	}
+	return template.CreationTimestamp.Add(d), nil
+}
+
+// deferCreation reports the template as pending while its start-after time has not passed
+// A malformed annotation also defers creation; fixing it triggers a new reconcile
+func (r *Reconciler) deferCreation(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, log logr.Logger) (ctrl.Result, bool, error) {
+	until, parseErr := startAfter(template)
+	var message string
+	var result ctrl.Result
+	switch {
+	case parseErr != nil:
+		message = fmt.Sprintf("AWS FIS ExperimentTemplate creation deferred: %v", parseErr)
+	case time.Now().Before(until):
+		message = fmt.Sprintf("AWS FIS ExperimentTemplate creation deferred until %s", until.UTC().Format(time.RFC3339))
+		result.RequeueAfter = time.Until(until)
+	default:
+		return ctrl.Result{}, false, nil
+	}
+
+	if template.Status.Message == message {
+		return result, true, nil
+	}
+	template.Status.Phase = "Pending"
+	template.Status.Message = message
+	meta.SetStatusCondition(&template.Status.Conditions, metav1.Condition{
+		Type:               fisv1alpha1.ConditionProgressing,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: template.Generation,
+		Reason:             fisv1alpha1.ReasonStartDeferred,
+		Message:            message,
+	})
+	if err := r.Status().Update(ctx, template); err != nil {
+		log.Error(err, "Failed to update status")
+		return ctrl.Result{}, true, err
+	}
+	eventType := corev1.EventTypeNormal
+	if parseErr != nil {
+		eventType = corev1.EventTypeWarning
+	}
+	r.recordEvent(template, eventType, fisv1alpha1.ReasonStartDeferred, message)
+
+	log.Info("ExperimentTemplate creation deferred", "startAfter", template.Annotations[startAfterAnnotation], "requeueAfter", result.RequeueAfter)
+	return result, true, nil
+}
+
+// diffOnlyRequested reports whether template updates should only be diffed, not applied
+func diffOnlyRequested(template *fisv1alpha1.ExperimentTemplate) bool {
+	return template.Annotations[diffOnlyAnnotation] == "true"
+}
+
+// reportTemplateDiff writes the difference between the AWS template and the spec to status without updating AWS
+func (r *Reconciler) reportTemplateDiff(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, roleArn, clusterIdentifier, serviceAccount string, log logr.Logger) (ctrl.Result, error) {
+	changes, err := r.FISClient.DiffExperimentTemplate(ctx, template, template.Status.TemplateID, roleArn, clusterIdentifier, serviceAccount)
+	if err != nil {
+		log.Error(err, "Failed to diff AWS FIS ExperimentTemplate")
+		return ctrl.Result{}, err
+	}
+
+	log.Info("Diff-only mode, skipping AWS FIS ExperimentTemplate update", "changes", len(changes))
+	template.Status.Message = "Diff only, update skipped: " + awsfis.FormatTemplateDiff(changes)
+	if err := r.Status().Update(ctx, template); err != nil {
+		log.Error(err, "Failed to update status")
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// checkDrift compares the AWS FIS template with the spec and re-applies the spec when they differ
+// It requeues itself every DriftCheckInterval
+func (r *Reconciler) checkDrift(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, log logr.Logger) (ctrl.Result, error) {
+	roleArn, clusterIdentifier, err := r.getRequiredParameters(ctx, template)
+	if err != nil {
+		log.Error(err, "Failed to resolve parameters for drift detection")
+		return ctrl.Result{RequeueAfter: r.DriftCheckInterval}, nil
+	}
+	rbacName, err := r.rbacName(template)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	changes, err := r.FISClient.DiffExperimentTemplate(ctx, template, template.Status.TemplateID, roleArn, clusterIdentifier, rbacName)
+	if err != nil {
+		log.Error(err, "Failed to check AWS FIS ExperimentTemplate for drift", "templateID", template.Status.TemplateID)
+		return ctrl.Result{RequeueAfter: r.DriftCheckInterval}, nil
+	}
+	if len(changes) == 0 {
+		// The template was verified, so refresh the sync time even though nothing changed
+		now := metav1.Now()
+		template.Status.LastSyncTime = &now
+		if err := r.Status().Update(ctx, template); err != nil {
+			log.Error(err, "Failed to update status")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: r.DriftCheckInterval}, nil
+	}
+
+	log.Info("AWS FIS ExperimentTemplate drifted from the spec, re-applying", "templateID", template.Status.TemplateID, "changes", len(changes))
+	r.recordEvent(template, corev1.EventTypeWarning, fisv1alpha1.ReasonDriftDetected,
+		fmt.Sprintf("AWS FIS experiment template %s drifted from the spec: %s", template.Status.TemplateID, awsfis.FormatTemplateDiff(changes)))
+
+	result, err := r.updateFISExperimentTemplate(ctx, template, log)
+	if err != nil || !result.IsZero() {
+		return result, err
+	}
+	return ctrl.Result{RequeueAfter: r.DriftCheckInterval}, nil
+}
+
+// dryRunRequested reports whether the template should only be rendered, either by its spec or the controller flag
+func (r *Reconciler) dryRunRequested(template *fisv1alpha1.ExperimentTemplate) bool {
+	return r.DryRun || template.Spec.DryRun
+}
+
+// renderFISExperimentTemplate validates and converts the template and writes the rendered AWS FIS input to status
+// No IAM role, RBAC resources or AWS FIS template are created
+func (r *Reconciler) renderFISExperimentTemplate(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, log logr.Logger) (ctrl.Result, error) {
+	log.Info("Dry-run mode, rendering AWS FIS ExperimentTemplate without creating it")
+
+	if valid, err := r.validateTemplate(ctx, template, log); !valid {
+		return ctrl.Result{}, err
+	}
+
+	roleArn, clusterIdentifier, err := r.resolveParameters(ctx, template, false)
+	if err != nil {
+		log.Error(err, "Missing required configuration")
+		return ctrl.Result{}, err
+	}
+	switch {
+	case template.Spec.RoleArnFrom != nil:
+		roleArn = dryRunSecretRoleArnPlaceholder
+	case roleArn == "":
+		roleArn = dryRunRoleArnPlaceholder
+	}
+
+	serviceAccount, err := r.rbacName(template)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	rendered, err := r.FISClient.RenderExperimentTemplate(template, roleArn, clusterIdentifier, serviceAccount)
+	if err != nil {
+		log.Error(err, "Failed to render AWS FIS ExperimentTemplate")
+		return r.setValidationFailed(ctx, template, err.Error(), log)
+	}
+
+	clearFailed(template)
+	template.Status.RenderedTemplate = rendered
+	template.Status.Phase = "Pending"
+	template.Status.Message = "Dry run, AWS FIS ExperimentTemplate rendered but not created"
+	for _, condType := range []string{fisv1alpha1.ConditionReady, fisv1alpha1.ConditionProgressing} {
+		meta.SetStatusCondition(&template.Status.Conditions, metav1.Condition{
+			Type:               condType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: template.Generation,
+			Reason:             fisv1alpha1.ReasonDryRun,
+			Message:            template.Status.Message,
+		})
+	}
+	template.Status.ObservedGeneration = template.Generation
+	if err := r.Status().Update(ctx, template); err != nil {
+		log.Error(err, "Failed to update status")
+		return ctrl.Result{}, err
+	}
+	r.recordEvent(template, corev1.EventTypeNormal, fisv1alpha1.ReasonDryRun, "Rendered AWS FIS experiment template into status.renderedTemplate")
+
+	return ctrl.Result{}, nil
+}
+
+// validateTemplate runs the template validator and records a Failed phase when the spec is invalid
+// It returns false when the spec must not be sent to AWS FIS
+func (r *Reconciler) validateTemplate(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, log logr.Logger) (bool, error) {
+	validator := r.Validator
+	if validator == nil {
+		validator = &validation.TemplateValidator{}
+	}
+
+	warnings, errs := validator.Validate(ctx, template)
+	for _, warning := range warnings {
+		log.Info("ExperimentTemplate validation warning", "warning", warning)
+	}
+	if len(errs) == 0 {
+		return true, nil
+	}
+
+	err := errs.ToAggregate()
+	log.Error(err, "ExperimentTemplate spec is invalid")
+	if _, updateErr := r.setValidationFailed(ctx, template, fmt.Sprintf("Invalid ExperimentTemplate spec: %v", err), log); updateErr != nil {
+		return false, updateErr
+	}
+	return false, nil
+}
+
+// setValidationFailed parks the template in Failed until its spec changes
+// Reconcile skips templates whose Failed condition matches the current generation
+func (r *Reconciler) setValidationFailed(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, message string, log logr.Logger) (ctrl.Result, error) {
+	setFailed(template, fisv1alpha1.ReasonValidationFailed, message)
+	if err := r.Status().Update(ctx, template); err != nil {
+		log.Error(err, "Failed to update status")
+		return ctrl.Result{}, err
+	}
+	r.recordEvent(template, corev1.EventTypeWarning, fisv1alpha1.ReasonValidationFailed, message)
+	return ctrl.Result{}, nil
+}
+
+// setFailed moves the template to the Failed phase and marks it as neither ready nor progressing
+func setFailed(template *fisv1alpha1.ExperimentTemplate, reason, message string) {
+	template.Status.Phase = "Failed"
+	template.Status.Message = message
+	meta.SetStatusCondition(&template.Status.Conditions, metav1.Condition{
+		Type:               fisv1alpha1.ConditionFailed,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: template.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+	for _, condType := range []string{fisv1alpha1.ConditionReady, fisv1alpha1.ConditionProgressing} {
+		meta.SetStatusCondition(&template.Status.Conditions, metav1.Condition{
+			Type:               condType,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: template.Generation,
+			Reason:             reason,
+			Message:            message,
+		})
+	}
+}
+
+// setProgressing marks the AWS FIS template as being created or updated
+// A template that was never ready is also marked not ready, an updated one stays ready until the update fails
+func setProgressing(template *fisv1alpha1.ExperimentTemplate, reason, message string) {
+	meta.SetStatusCondition(&template.Status.Conditions, metav1.Condition{
+		Type:               fisv1alpha1.ConditionProgressing,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: template.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+	if meta.FindStatusCondition(template.Status.Conditions, fisv1alpha1.ConditionReady) == nil {
+		meta.SetStatusCondition(&template.Status.Conditions, metav1.Condition{
+			Type:               fisv1alpha1.ConditionReady,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: template.Generation,
+			Reason:             reason,
+			Message:            message,
+		})
+	}
+}
+
+// setReady marks the AWS FIS template as in sync with the spec
+func setReady(template *fisv1alpha1.ExperimentTemplate, message string) {
+	meta.SetStatusCondition(&template.Status.Conditions, metav1.Condition{
+		Type:               fisv1alpha1.ConditionReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: template.Generation,
+		Reason:             fisv1alpha1.ReasonSucceeded,
+		Message:            message,
+	})
+	meta.SetStatusCondition(&template.Status.Conditions, metav1.Condition{
+		Type:               fisv1alpha1.ConditionProgressing,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: template.Generation,
+		Reason:             fisv1alpha1.ReasonSucceeded,
+		Message:            message,
+	})
+}
+
+// setAccessEntryReady records whether the access entry of the template's role exists
+// A failure does not fail the template, so this condition is what surfaces it
+func (r *Reconciler) setAccessEntryReady(template *fisv1alpha1.ExperimentTemplate, err error) {
+	cond := metav1.Condition{
+		Type:               fisv1alpha1.ConditionAccessEntryReady,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: template.Generation,
+		Reason:             fisv1alpha1.ReasonSucceeded,
+		Message:            "EKS access entry exists for the template's role",
+	}
+	if err != nil {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = fisv1alpha1.ReasonAccessEntryFailed
+		cond.Message = fmt.Sprintf("Failed to create EKS access entry, experiments will fail RBAC: %v", err)
+		r.recordEvent(template, corev1.EventTypeWarning, fisv1alpha1.ReasonAccessEntryFailed, cond.Message)
+	}
+	meta.SetStatusCondition(&template.Status.Conditions, cond)
+}
+
+// recordResolvedConfig stores the configuration read back from AWS FIS when enabled
+// A failed read keeps the previous value, and disabling the option clears it
+func (r *Reconciler) recordResolvedConfig(template *fisv1alpha1.ExperimentTemplate, resolved *fisv1alpha1.ResolvedTemplateConfig) {
+	switch {
+	case !r.RecordResolvedConfig:
+		template.Status.ResolvedConfig = nil
+	case resolved != nil:
+		template.Status.ResolvedConfig = resolved
+	}
+}
+
+// setValidated records whether AWS FIS returned the template after the last create or update
+// It is independent of Ready, which reflects what the controller believes is in sync
+func setValidated(template *fisv1alpha1.ExperimentTemplate, err error) {
+	cond := metav1.Condition{
+		Type:               fisv1alpha1.ConditionValidated,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: template.Generation,
+		Reason:             fisv1alpha1.ReasonSucceeded,
+		Message:            "AWS FIS returned the experiment template",
+	}
+	if err != nil {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = fisv1alpha1.ReasonNotRetrievable
+		if awsfis.IsFISValidationError(err) {
+			cond.Reason = fisv1alpha1.ReasonValidationFailed
+		}
+		cond.Message = err.Error()
+	}
+	meta.SetStatusCondition(&template.Status.Conditions, cond)
+}
+
+// validationFailedForGeneration reports whether the current spec generation was already rejected as invalid
+func validationFailedForGeneration(template *fisv1alpha1.ExperimentTemplate) bool {
+	cond := meta.FindStatusCondition(template.Status.Conditions, fisv1alpha1.ConditionFailed)
+	return cond != nil &&
+		cond.Status == metav1.ConditionTrue &&
+		cond.Reason == fisv1alpha1.ReasonValidationFailed &&
+		cond.ObservedGeneration == template.Generation
+}
+
+// clearFailed marks the Failed condition False after a successful sync
+func clearFailed(template *fisv1alpha1.ExperimentTemplate) {
+	if meta.FindStatusCondition(template.Status.Conditions, fisv1alpha1.ConditionFailed) != nil {
+		meta.SetStatusCondition(&template.Status.Conditions, metav1.Condition{
+			Type:               fisv1alpha1.ConditionFailed,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: template.Generation,
+			Reason:             fisv1alpha1.ReasonSucceeded,
+			Message:            "ExperimentTemplate synced with AWS FIS",
+		})
+	}
+}
+
 // createFISExperimentTemplate handles the creation of AWS FIS ExperimentTemplate
 func (r *Reconciler) createFISExperimentTemplate(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, log logr.Logger) (ctrl.Result, error) {
 	log.Info("Creating AWS FIS ExperimentTemplate")
+	setProgressing(template, fisv1alpha1.ReasonCreating, "Creating AWS FIS ExperimentTemplate")
+
+	// Reject invalid specs before creating any AWS or Kubernetes resources
+	if valid, err := r.validateTemplate(ctx, template, log); !valid {
+		return ctrl.Result{}, err
+	}
 
 	// Get required parameters (IAM role will be auto-created if needed)
 	roleArn, clusterIdentifier, err := r.getRequiredParameters(ctx, template)
 	if err != nil {
+		if awsfis.IsAccessDeniedError(err) {
+			return r.setRoleAccessDenied(ctx, template, err, log)
+		}
 		log.Error(err, "Missing required configuration")
 		return ctrl.Result{}, err
 	}
+	clearRoleAccessDenied(template)
 
 	// Get target namespaces from targets
 	targetNamespaces := getTargetNamespaces(template)
@@ -109,32 +681,53 @@ func (r *Reconciler) createFISExperimentTemplate(ctx context.Context, template *
 		return ctrl.Result{}, fmt.Errorf("no target namespaces found in targets")
 	}
 
-	// Create Kubernetes RBAC resources in each target namespace
-	log.Info("Creating Kubernetes RBAC resources for ExperimentTemplate", "namespaces", targetNamespaces)
-	var serviceAccount string
-	for _, ns := range targetNamespaces {
-		sa, err := utils.SetupExperimentTemplateRBAC(ctx, r.Client, ns, template.Name)
-		if err != nil {
-			log.Error(err, "Failed to create Kubernetes RBAC resources", "namespace", ns)
-			return ctrl.Result{}, err
-		}
-		serviceAccount = sa // Use the same service account name pattern
+	rbacName, err := r.rbacName(template)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Create Kubernetes RBAC resources for the target namespaces
+	rbacNamespaces := r.rbacNamespaces(template)
+	log.Info("Creating Kubernetes RBAC resources for ExperimentTemplate", "namespaces", rbacNamespaces, "clusterWide", r.clusterWideRBAC(template))
+	serviceAccount, err := r.setupRBAC(ctx, template, rbacNamespaces, rbacName)
+	if err != nil {
+		log.Error(err, "Failed to create Kubernetes RBAC resources")
+		return ctrl.Result{}, err
 	}
 	log.Info("Successfully created Kubernetes RBAC resources", "serviceAccount", serviceAccount)
 
+	// Show the in-progress create while waiting for AWS FIS
+	template.Status.Phase = "Creating"
+	template.Status.Message = "Creating AWS FIS ExperimentTemplate"
+	if err := r.Status().Update(ctx, template); err != nil {
+		log.Error(err, "Failed to update status")
+		return ctrl.Result{}, err
+	}
+
 	// Create AWS FIS ExperimentTemplate
 	templateID, err := r.FISClient.CreateExperimentTemplate(ctx, template, roleArn, clusterIdentifier, serviceAccount)
 	if err != nil {
 		log.Error(err, "Failed to create AWS FIS ExperimentTemplate")
+		// Keep RBAC resources on throttling, the create will be retried with backoff
+		if awsfis.IsRetryableFISError(err) {
+			return r.setThrottled(ctx, template, err, log)
+		}
 		// Clean up RBAC resources on failure
-		for _, ns := range targetNamespaces {
-			if cleanupErr := utils.DeleteExperimentTemplateRBAC(ctx, r.Client, ns, template.Name); cleanupErr != nil {
+		for _, ns := range rbacNamespaces {
+			if cleanupErr := utils.DeleteExperimentTemplateRBAC(ctx, r.Client, ns, rbacName); cleanupErr != nil {
 				log.Error(cleanupErr, "Failed to clean up RBAC resources after FIS template creation failure", "namespace", ns)
 			}
 		}
+		if cleanupErr := utils.DeleteExperimentTemplateClusterRBAC(ctx, r.Client, rbacName); cleanupErr != nil {
+			log.Error(cleanupErr, "Failed to clean up cluster RBAC resources after FIS template creation failure")
+		}
+		// Permanent validation errors will not succeed on retry, wait for a spec change
+		if awsfis.IsFISValidationError(err) {
+			setValidated(template, err)
+			return r.setValidationFailed(ctx, template, err.Error(), log)
+		}
 		// Update status with error
-		template.Status.Phase = "Failed"
-		template.Status.Message = err.Error()
+		setFailed(template, fisv1alpha1.ReasonReconcileFailed, err.Error())
 		if updateErr := r.Status().Update(ctx, template); updateErr != nil {
 			log.Error(updateErr, "Failed to update status")
 		}
@@ -143,10 +736,31 @@ func (r *Reconciler) createFISExperimentTemplate(ctx context.Context, template *
 
 	log.Info("Successfully created AWS FIS ExperimentTemplate", "templateID", templateID, "roleArn", roleArn, "serviceAccount", serviceAccount)
 
+	// A template is not always readable right after it is created; wait until it is, so later
+	// reads of it do not see a transient not-found. The template exists either way, so keep going
+	awsTemplate, err := r.FISClient.WaitForExperimentTemplate(ctx, templateID)
+	if err != nil {
+		log.Error(err, "AWS FIS ExperimentTemplate is not readable yet after creation", "templateID", templateID)
+	}
+	setValidated(template, err)
+	r.recordResolvedConfig(template, awsfis.ResolvedConfig(awsTemplate))
+
+	// Target accounts are separate FIS resources of the template. If they fail, keep the template ID
+	// so the next reconcile retries them through the update path instead of creating another template
+	if err := r.syncTargetAccountConfigurations(ctx, template, templateID, log); err != nil {
+		template.Status.TemplateID = templateID
+		template.Status.RoleArn = roleArn
+		setFailed(template, fisv1alpha1.ReasonReconcileFailed, err.Error())
+		if updateErr := r.Status().Update(ctx, template); updateErr != nil {
+			log.Error(updateErr, "Failed to update status")
+		}
+		return ctrl.Result{}, err
+	}
+
 	// Create EKS Access Entry for the IAM role
-	// Username format: fis-{templateName} (matches RoleBinding subject)
-	username := fmt.Sprintf("fis-%s", template.Name)
-	if r.EKSClient != nil && r.ClusterName != "" && roleArn != "" {
+	// The username matches the RoleBinding subject
+	username := rbacName
+	if r.manageAccessEntry(template) && roleArn != "" {
 		log.Info("Creating EKS Access Entry for IAM role", "roleArn", roleArn, "clusterName", r.ClusterName, "username", username)
 
 		// If role was auto-created, wait for IAM propagation and retry
@@ -168,10 +782,13 @@ func (r *Reconciler) createFISExperimentTemplate(ctx context.Context, template *
 				}
 
 				if contextCancelled {
+					if accessEntryErr == nil {
+						accessEntryErr = ctx.Err()
+					}
 					break
 				}
 
-				accessEntryErr = awsfis.EnsureAccessEntry(ctx, r.EKSClient, r.ClusterName, roleArn, username)
+				accessEntryErr = awsfis.EnsureAccessEntry(ctx, r.EKSClient, r.ClusterName, roleArn, username, r.accessPolicies(targetNamespaces)...)
 				if accessEntryErr == nil {
 					log.Info("Successfully created EKS Access Entry", "roleArn", roleArn, "clusterName", r.ClusterName, "username", username, "attempt", attempt)
 					break
@@ -183,29 +800,44 @@ func (r *Reconciler) createFISExperimentTemplate(ctx context.Context, template *
 				log.Error(accessEntryErr, "Failed to create EKS Access Entry after retries", "roleArn", roleArn, "clusterName", r.ClusterName)
 				log.Info("Warning: EKS Access Entry creation failed. You may need to create the access entry manually using: aws eks create-access-entry --cluster-name " + r.ClusterName + " --principal-arn " + roleArn + " --username " + username)
 			}
+			r.setAccessEntryReady(template, accessEntryErr)
 		} else {
 			// For user-provided roles, try once without waiting
-			if err := awsfis.EnsureAccessEntry(ctx, r.EKSClient, r.ClusterName, roleArn, username); err != nil {
+			err := awsfis.EnsureAccessEntry(ctx, r.EKSClient, r.ClusterName, roleArn, username, r.accessPolicies(targetNamespaces)...)
+			if err != nil {
 				log.Error(err, "Failed to create EKS Access Entry", "roleArn", roleArn, "clusterName", r.ClusterName)
 				log.Info("Warning: EKS Access Entry creation failed. You may need to create the access entry manually.")
 			} else {
 				log.Info("Successfully created EKS Access Entry", "roleArn", roleArn, "clusterName", r.ClusterName, "username", username)
 			}
+			r.setAccessEntryReady(template, err)
 		}
 	} else {
-		log.Info("Skipping EKS Access Entry creation", "hasEKSClient", r.EKSClient != nil, "hasClusterName", r.ClusterName != "", "hasRoleArn", roleArn != "")
+		log.Info("Skipping EKS Access Entry creation", "manageAccessEntry", r.manageAccessEntry(template), "hasRoleArn", roleArn != "")
+		meta.RemoveStatusCondition(&template.Status.Conditions, fisv1alpha1.ConditionAccessEntryReady)
 	}
 
 	// Update status
+	clearThrottled(template)
+	clearFailed(template)
 	template.Status.TemplateID = templateID
+	template.Status.TemplateVersion = 1
+	template.Status.FilterCount = awsfis.CountFilters(template.Spec.Targets)
 	template.Status.RoleArn = roleArn
+	template.Status.RBACNamespaces = rbacNamespaces
+	now := metav1.Now()
+	template.Status.LastSyncTime = &now
+	template.Status.LastForceSync = template.Annotations[forceSyncAnnotation]
 	template.Status.Phase = "Ready"
 	template.Status.Message = "AWS FIS ExperimentTemplate created successfully"
+	setReady(template, template.Status.Message)
 	template.Status.ObservedGeneration = template.Generation
 	if err := r.Status().Update(ctx, template); err != nil {
 		log.Error(err, "Failed to update status")
 		return ctrl.Result{}, err
 	}
+	r.recordEvent(template, corev1.EventTypeNormal, fisv1alpha1.ReasonCreated,
+		fmt.Sprintf("Created AWS FIS experiment template %s", templateID))
 
 	return ctrl.Result{}, nil
 }
@@ -213,13 +845,23 @@ func (r *Reconciler) createFISExperimentTemplate(ctx context.Context, template *
 // updateFISExperimentTemplate handles the update of AWS FIS ExperimentTemplate
 func (r *Reconciler) updateFISExperimentTemplate(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, log logr.Logger) (ctrl.Result, error) {
 	log.Info("Updating AWS FIS ExperimentTemplate", "templateID", template.Status.TemplateID)
+	setProgressing(template, fisv1alpha1.ReasonUpdating, "Updating AWS FIS ExperimentTemplate")
+
+	// Reject invalid specs before touching the existing AWS template
+	if valid, err := r.validateTemplate(ctx, template, log); !valid {
+		return ctrl.Result{}, err
+	}
 
 	// Get required parameters
 	roleArn, clusterIdentifier, err := r.getRequiredParameters(ctx, template)
 	if err != nil {
+		if awsfis.IsAccessDeniedError(err) {
+			return r.setRoleAccessDenied(ctx, template, err, log)
+		}
 		log.Error(err, "Missing required configuration")
 		return ctrl.Result{}, err
 	}
+	clearRoleAccessDenied(template)
 
 	// Get target namespaces from targets
 	targetNamespaces := getTargetNamespaces(template)
@@ -227,74 +869,258 @@ func (r *Reconciler) updateFISExperimentTemplate(ctx context.Context, template *
 		return ctrl.Result{}, fmt.Errorf("no target namespaces found in targets")
 	}
 
-	// Ensure Kubernetes RBAC resources exist in each target namespace (idempotent)
-	log.Info("Ensuring Kubernetes RBAC resources for ExperimentTemplate", "namespaces", targetNamespaces)
-	var serviceAccount string
-	for _, ns := range targetNamespaces {
-		sa, err := utils.SetupExperimentTemplateRBAC(ctx, r.Client, ns, template.Name)
-		if err != nil {
-			log.Error(err, "Failed to ensure Kubernetes RBAC resources", "namespace", ns)
+	rbacName, err := r.rbacName(template)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Only report what would change, before any RBAC or AWS side effects
+	if diffOnlyRequested(template) {
+		return r.reportTemplateDiff(ctx, template, roleArn, clusterIdentifier, rbacName, log)
+	}
+
+	// Ensure Kubernetes RBAC resources exist for the target namespaces (idempotent)
+	rbacNamespaces := r.rbacNamespaces(template)
+	log.Info("Ensuring Kubernetes RBAC resources for ExperimentTemplate", "namespaces", rbacNamespaces, "clusterWide", r.clusterWideRBAC(template))
+	serviceAccount, err := r.setupRBAC(ctx, template, rbacNamespaces, rbacName)
+	if err != nil {
+		log.Error(err, "Failed to ensure Kubernetes RBAC resources")
+		return ctrl.Result{}, err
+	}
+
+	// Remove RBAC from namespaces that were dropped from the targets
+	for _, ns := range removedNamespaces(template, rbacNamespaces) {
+		log.Info("Deleting Kubernetes RBAC resources from namespace no longer targeted", "namespace", ns)
+		if err := utils.DeleteExperimentTemplateRBAC(ctx, r.Client, ns, rbacName); err != nil {
+			log.Error(err, "Failed to delete Kubernetes RBAC resources", "namespace", ns)
 			return ctrl.Result{}, err
 		}
-		serviceAccount = sa
 	}
 
 	// Update AWS FIS ExperimentTemplate
 	if err := r.FISClient.UpdateExperimentTemplate(ctx, template, template.Status.TemplateID, roleArn, clusterIdentifier, serviceAccount); err != nil {
 		log.Error(err, "Failed to update AWS FIS ExperimentTemplate")
+		if awsfis.IsRetryableFISError(err) {
+			return r.setThrottled(ctx, template, err, log)
+		}
+		// Permanent validation errors will not succeed on retry, wait for a spec change
+		if awsfis.IsFISValidationError(err) {
+			setValidated(template, err)
+			return r.setValidationFailed(ctx, template, err.Error(), log)
+		}
 		// Update status with error
-		template.Status.Phase = "Failed"
-		template.Status.Message = err.Error()
+		setFailed(template, fisv1alpha1.ReasonReconcileFailed, err.Error())
 		if updateErr := r.Status().Update(ctx, template); updateErr != nil {
 			log.Error(updateErr, "Failed to update status")
 		}
 		return ctrl.Result{}, err
 	}
 
-	log.Info("Successfully updated AWS FIS ExperimentTemplate", "templateID", template.Status.TemplateID)
+	log.Info("Successfully updated AWS FIS ExperimentTemplate", "templateID", template.Status.TemplateID, "version", template.Status.TemplateVersion+1)
+
+	// Read the template back so Validated reflects what AWS FIS holds, not only that the update was accepted
+	awsTemplate, err := r.FISClient.GetExperimentTemplate(ctx, template.Status.TemplateID)
+	if err != nil {
+		log.Error(err, "Failed to get AWS FIS ExperimentTemplate after update", "templateID", template.Status.TemplateID)
+	}
+	setValidated(template, err)
+	r.recordResolvedConfig(template, awsfis.ResolvedConfig(awsTemplate))
+
+	if err := r.syncTargetAccountConfigurations(ctx, template, template.Status.TemplateID, log); err != nil {
+		setFailed(template, fisv1alpha1.ReasonReconcileFailed, err.Error())
+		if updateErr := r.Status().Update(ctx, template); updateErr != nil {
+			log.Error(updateErr, "Failed to update status")
+		}
+		return ctrl.Result{}, err
+	}
 
 	// Ensure EKS Access Entry exists for the IAM role
-	username := fmt.Sprintf("fis-%s", template.Name)
-	if r.EKSClient != nil && r.ClusterName != "" && roleArn != "" {
+	username := rbacName
+	if r.manageAccessEntry(template) && roleArn != "" {
 		log.Info("Ensuring EKS Access Entry for IAM role", "roleArn", roleArn, "clusterName", r.ClusterName, "username", username)
 
-		if err := awsfis.EnsureAccessEntry(ctx, r.EKSClient, r.ClusterName, roleArn, username); err != nil {
+		err := awsfis.EnsureAccessEntry(ctx, r.EKSClient, r.ClusterName, roleArn, username, r.accessPolicies(targetNamespaces)...)
+		if err != nil {
 			log.Error(err, "Failed to ensure EKS Access Entry", "roleArn", roleArn, "clusterName", r.ClusterName)
 			// Don't fail the update if access entry creation fails
 			log.Info("Warning: EKS Access Entry creation failed. You may need to create the access entry manually")
 		} else {
 			log.Info("Successfully ensured EKS Access Entry", "roleArn", roleArn, "clusterName", r.ClusterName, "username", username)
 		}
+		r.setAccessEntryReady(template, err)
+	} else {
+		meta.RemoveStatusCondition(&template.Status.Conditions, fisv1alpha1.ConditionAccessEntryReady)
+	}
+
+	// The access entry of a replaced role would otherwise be orphaned
+	if r.manageAccessEntry(template) && template.Status.RoleArn != "" && template.Status.RoleArn != roleArn {
+		r.deleteStaleAccessEntry(ctx, template.Status.RoleArn, log)
+	}
+
+	// An auto-created role replaced by a provided one is no longer referenced by anything
+	if template.Status.RoleArn != "" && template.Status.RoleArn != roleArn {
+		r.deleteReplacedRole(ctx, template, template.Status.RoleArn, log)
 	}
 
 	// Update status
+	clearThrottled(template)
+	clearFailed(template)
+	template.Status.TemplateVersion++
+	template.Status.FilterCount = awsfis.CountFilters(template.Spec.Targets)
 	template.Status.RoleArn = roleArn
+	template.Status.RBACNamespaces = rbacNamespaces
+	now := metav1.Now()
+	template.Status.LastSyncTime = &now
+	template.Status.LastForceSync = template.Annotations[forceSyncAnnotation]
 	template.Status.Phase = "Ready"
 	template.Status.Message = "AWS FIS ExperimentTemplate updated successfully"
+	setReady(template, template.Status.Message)
 	template.Status.ObservedGeneration = template.Generation
 	if err := r.Status().Update(ctx, template); err != nil {
 		log.Error(err, "Failed to update status")
 		return ctrl.Result{}, err
 	}
+	r.recordEvent(template, corev1.EventTypeNormal, fisv1alpha1.ReasonUpdated,
+		fmt.Sprintf("Updated AWS FIS experiment template %s to version %d", template.Status.TemplateID, template.Status.TemplateVersion))
 
 	return ctrl.Result{}, nil
 }
 
+// recordInventory counts the resources the controller manages for the template
+func (r *Reconciler) recordInventory(template *fisv1alpha1.ExperimentTemplate) {
+	if r.Inventory == nil {
+		return
+	}
+
+	var resources metrics.TemplateResources
+	if template.Status.TemplateID != "" {
+		resources.Templates = 1
+		// A ServiceAccount per namespace, plus a Role and RoleBinding per namespace or one cluster-wide pair
+		namespaces := len(r.rbacNamespaces(template))
+		resources.RBACObjects = 3 * namespaces
+		if r.clusterWideRBAC(template) {
+			resources.RBACObjects = namespaces + 2
+		}
+	}
+	if template.Status.RoleArn != "" {
+		if r.IAMClient != nil && strings.HasSuffix(template.Status.RoleArn, "/"+r.IAMClient.RoleNaming.RoleName("", template.Name)) {
+			resources.IAMRoles = 1
+		}
+		if meta.IsStatusConditionTrue(template.Status.Conditions, fisv1alpha1.ConditionAccessEntryReady) {
+			resources.AccessEntries = 1
+		}
+	}
+	r.Inventory.Record(template.Name, resources)
+}
+
+// forgetInventory drops a deleted template from the inventory
+func (r *Reconciler) forgetInventory(name string) {
+	if r.Inventory != nil {
+		r.Inventory.Forget(name)
+	}
+}
+
+// deleteOrphanedRBAC removes RBAC resources left behind by a template that no longer exists, e.g. when
+// the controller stopped between creating them and the finalizer running
+func (r *Reconciler) deleteOrphanedRBAC(ctx context.Context, templateName string, log logr.Logger) error {
+	deleted, err := utils.DeleteOrphanedExperimentTemplateRBAC(ctx, r.Client, templateName)
+	if err != nil {
+		log.Error(err, "Failed to delete orphaned RBAC resources", "template", templateName)
+		return err
+	}
+	if deleted > 0 {
+		log.Info("Deleted orphaned RBAC resources", "template", templateName, "count", deleted)
+	}
+	return nil
+}
+
+// deleteStaleAccessEntry removes the access entry of a role the template no longer uses
+// Failures are logged only, the stale entry does not block the update
+func (r *Reconciler) deleteStaleAccessEntry(ctx context.Context, staleRoleArn string, log logr.Logger) {
+	if !r.CleanupStaleAccessEntries || r.EKSClient == nil || r.ClusterName == "" {
+		return
+	}
+
+	log.Info("Role ARN changed, deleting stale EKS Access Entry", "roleArn", staleRoleArn, "clusterName", r.ClusterName)
+	if err := awsfis.DeleteAccessEntryIfExists(ctx, r.EKSClient, r.ClusterName, staleRoleArn); err != nil {
+		log.Error(err, "Failed to delete stale EKS Access Entry", "roleArn", staleRoleArn)
+		return
+	}
+	log.Info("Successfully deleted stale EKS Access Entry", "roleArn", staleRoleArn)
+}
+
+// deleteReplacedRole removes the auto-created IAM role of a template that switched to a provided role
+// Roles the controller did not create are left alone, failures are logged only
+func (r *Reconciler) deleteReplacedRole(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, replacedRoleArn string, log logr.Logger) {
+	if !r.CleanupReplacedRoles || r.IAMClient == nil || !awsfis.IsAutoCreatedRole(r.IAMClient, replacedRoleArn, "", template.Name) {
+		return
+	}
+
+	log.Info("Switched to a provided role, deleting auto-created IAM role", "roleArn", replacedRoleArn)
+	if err := awsfis.DeleteIAMRole(ctx, r.IAMClient, "", template.Name); err != nil {
+		log.Error(err, "Failed to delete auto-created IAM role", "roleArn", replacedRoleArn)
+		return
+	}
+	log.Info("Successfully deleted auto-created IAM role", "roleArn", replacedRoleArn)
+}
+
+// syncTargetAccountConfigurations makes the template's FIS target account configurations match the spec
+// Templates that never declared any are left alone, so single-account templates make no extra calls
+func (r *Reconciler) syncTargetAccountConfigurations(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, templateID string, log logr.Logger) error {
+	if len(template.Spec.TargetAccountConfigurations) == 0 && !multiAccount(template) {
+		return nil
+	}
+	if err := r.FISClient.SyncTargetAccountConfigurations(ctx, templateID, template.Spec.TargetAccountConfigurations); err != nil {
+		log.Error(err, "Failed to sync target account configurations", "templateID", templateID)
+		return err
+	}
+	log.Info("Synced target account configurations", "templateID", templateID, "accounts", len(template.Spec.TargetAccountConfigurations))
+	return nil
+}
+
+// multiAccount reports whether the template targets resources in multiple accounts
+func multiAccount(template *fisv1alpha1.ExperimentTemplate) bool {
+	opts := template.Spec.ExperimentOptions
+	return opts != nil && opts.AccountTargeting == "multi-account"
+}
+
 // handleDeletion handles the deletion of AWS FIS ExperimentTemplate, IAM Role, and Kubernetes RBAC
 func (r *Reconciler) handleDeletion(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, log logr.Logger) (ctrl.Result, error) {
 	log.Info("Deleting AWS FIS ExperimentTemplate", "templateID", template.Status.TemplateID)
 
+	// Show the in-progress deletion, a failed status write must not block the cleanup
+	if template.Status.Phase != "Deleting" {
+		template.Status.Phase = "Deleting"
+		template.Status.Message = "Deleting AWS FIS ExperimentTemplate"
+		if err := r.Status().Update(ctx, template); err != nil {
+			log.Error(err, "Failed to update status")
+		}
+	}
+
 	// Delete AWS FIS ExperimentTemplate if it exists
 	if template.Status.TemplateID != "" {
-		if err := r.FISClient.DeleteExperimentTemplate(ctx, template.Status.TemplateID); err != nil {
+		if len(template.Spec.TargetAccountConfigurations) > 0 || multiAccount(template) {
+			if err := r.FISClient.DeleteTargetAccountConfigurations(ctx, template.Status.TemplateID); err != nil && !awsfis.IsFISNotFoundError(err) {
+				log.Error(err, "Failed to delete target account configurations")
+				return ctrl.Result{}, err
+			}
+		}
+
+		err := r.FISClient.DeleteExperimentTemplate(ctx, template.Status.TemplateID)
+		switch {
+		case awsfis.IsFISNotFoundError(err):
+			// Already deleted outside the controller, nothing left to clean up in FIS
+			log.Info("AWS FIS ExperimentTemplate is already gone", "templateID", template.Status.TemplateID)
+		case err != nil:
 			log.Error(err, "Failed to delete AWS FIS ExperimentTemplate")
 			return ctrl.Result{}, err
+		default:
+			log.Info("Successfully deleted AWS FIS ExperimentTemplate", "templateID", template.Status.TemplateID)
 		}
-		log.Info("Successfully deleted AWS FIS ExperimentTemplate", "templateID", template.Status.TemplateID)
 	}
 
 	// Delete EKS Access Entry if it exists
-	if r.EKSClient != nil && r.ClusterName != "" && template.Status.RoleArn != "" {
+	if r.manageAccessEntry(template) && template.Status.RoleArn != "" {
 		log.Info("Deleting EKS Access Entry", "roleArn", template.Status.RoleArn, "clusterName", r.ClusterName)
 		if err := awsfis.DeleteAccessEntryIfExists(ctx, r.EKSClient, r.ClusterName, template.Status.RoleArn); err != nil {
 			log.Error(err, "Failed to delete EKS Access Entry")
@@ -318,10 +1144,16 @@ func (r *Reconciler) handleDeletion(ctx context.Context, template *fisv1alpha1.E
 	}
 
 	// Delete Kubernetes RBAC resources from all target namespaces
-	targetNamespaces := getTargetNamespaces(template)
-	log.Info("Deleting Kubernetes RBAC resources for ExperimentTemplate", "namespaces", targetNamespaces)
-	for _, ns := range targetNamespaces {
-		if err := utils.DeleteExperimentTemplateRBAC(ctx, r.Client, ns, template.Name); err != nil {
+	rbacName, err := r.rbacName(template)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	// Include namespaces provisioned earlier whose targets were removed before a successful update
+	rbacNamespaces := r.rbacNamespaces(template)
+	rbacNamespaces = append(rbacNamespaces, removedNamespaces(template, rbacNamespaces)...)
+	log.Info("Deleting Kubernetes RBAC resources for ExperimentTemplate", "namespaces", rbacNamespaces)
+	for _, ns := range rbacNamespaces {
+		if err := utils.DeleteExperimentTemplateRBAC(ctx, r.Client, ns, rbacName); err != nil {
 			log.Error(err, "Failed to delete Kubernetes RBAC resources", "namespace", ns)
 			// Don't fail the deletion if RBAC cleanup fails
 			// Just log the error and continue
@@ -329,6 +1161,93 @@ func (r *Reconciler) handleDeletion(ctx context.Context, template *fisv1alpha1.E
 			log.Info("Successfully deleted Kubernetes RBAC resources", "namespace", ns)
 		}
 	}
+	if err := utils.DeleteExperimentTemplateClusterRBAC(ctx, r.Client, rbacName); err != nil {
+		log.Error(err, "Failed to delete cluster Kubernetes RBAC resources")
+	}
 
 	return ctrl.Result{}, nil
 }
+
+// setThrottled records a throttled AWS FIS call in status and requeues with exponential backoff
+func (r *Reconciler) setThrottled(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, err error, log logr.Logger) (ctrl.Result, error) {
+	template.Status.ConsecutiveThrottles++
+	backoff := awsfis.ThrottleBackoff(template.Status.ConsecutiveThrottles)
+
+	meta.SetStatusCondition(&template.Status.Conditions, metav1.Condition{
+		Type:               fisv1alpha1.ConditionThrottled,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: template.Generation,
+		Reason:             fisv1alpha1.ReasonThrottled,
+		Message:            fmt.Sprintf("AWS FIS call throttled, retrying in %s: %v", backoff, err),
+	})
+	if updateErr := r.Status().Update(ctx, template); updateErr != nil {
+		log.Error(updateErr, "Failed to update status")
+		return ctrl.Result{}, updateErr
+	}
+
+	r.recordEvent(template, corev1.EventTypeWarning, fisv1alpha1.ReasonThrottled,
+		fmt.Sprintf("AWS FIS call throttled, retrying in %s: %v", backoff, err))
+
+	log.Info("AWS FIS call throttled, backing off", "attempts", template.Status.ConsecutiveThrottles, "requeueAfter", backoff)
+	return ctrl.Result{RequeueAfter: backoff}, nil
+}
+
+// setRoleAccessDenied fails a template whose FIS IAM role the controller is not allowed to create,
+// and tells the user how to provide a role instead
+func (r *Reconciler) setRoleAccessDenied(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, err error, log logr.Logger) (ctrl.Result, error) {
+	message := fmt.Sprintf("The controller is not allowed to create IAM role %s for AWS FIS; "+
+		"provide an existing role with spec.roleArnFrom, the fis.dksshddl.dev/role-arn annotation or FIS_ROLE_ARN, "+
+		"or grant the controller the IAM permissions: %v", r.IAMClient.RoleNaming.RoleName("", template.Name), err)
+
+	setFailed(template, fisv1alpha1.ReasonIAMAccessDenied, message)
+	meta.SetStatusCondition(&template.Status.Conditions, metav1.Condition{
+		Type:               fisv1alpha1.ConditionRoleReady,
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: template.Generation,
+		Reason:             fisv1alpha1.ReasonIAMAccessDenied,
+		Message:            message,
+	})
+	if updateErr := r.Status().Update(ctx, template); updateErr != nil {
+		log.Error(updateErr, "Failed to update status")
+		return ctrl.Result{}, updateErr
+	}
+
+	r.recordEvent(template, corev1.EventTypeWarning, fisv1alpha1.ReasonIAMAccessDenied, message)
+
+	log.Info("IAM role creation denied, waiting for a user-provided role", "requeueAfter", roleAccessDeniedRetryInterval)
+	return ctrl.Result{RequeueAfter: roleAccessDeniedRetryInterval}, nil
+}
+
+// clearRoleAccessDenied marks the role as ready once a role has been resolved after an access denied error
+func clearRoleAccessDenied(template *fisv1alpha1.ExperimentTemplate) {
+	if meta.FindStatusCondition(template.Status.Conditions, fisv1alpha1.ConditionRoleReady) != nil {
+		meta.SetStatusCondition(&template.Status.Conditions, metav1.Condition{
+			Type:               fisv1alpha1.ConditionRoleReady,
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: template.Generation,
+			Reason:             fisv1alpha1.ReasonSucceeded,
+			Message:            "IAM role resolved",
+		})
+	}
+}
+
+// recordEvent emits a Kubernetes event for the template when a recorder is configured
+func (r *Reconciler) recordEvent(template *fisv1alpha1.ExperimentTemplate, eventType, reason, message string) {
+	if r.Recorder != nil {
+		r.Recorder.Event(template, eventType, reason, message)
+	}
+}
+
+// clearThrottled resets the throttle backoff after a successful AWS FIS call
+func clearThrottled(template *fisv1alpha1.ExperimentTemplate) {
+	template.Status.ConsecutiveThrottles = 0
+	if meta.FindStatusCondition(template.Status.Conditions, fisv1alpha1.ConditionThrottled) != nil {
+		meta.SetStatusCondition(&template.Status.Conditions, metav1.Condition{
+			Type:               fisv1alpha1.ConditionThrottled,
+			Status:             metav1.ConditionFalse,
+			ObservedGeneration: template.Generation,
+			Reason:             fisv1alpha1.ReasonSucceeded,
+			Message:            "Last AWS FIS call succeeded",
+		})
+	}
+}
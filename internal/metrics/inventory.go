@@ -0,0 +1,106 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics exposes the controller's Prometheus metrics
+package metrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	// ManagedTemplates is the number of AWS FIS experiment templates created by the controller
+	ManagedTemplates = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "fis_managed_templates",
+		Help: "Number of AWS FIS experiment templates managed by the controller",
+	})
+
+	// ManagedIAMRoles is the number of IAM roles auto-created by the controller
+	ManagedIAMRoles = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "fis_managed_iam_roles",
+		Help: "Number of IAM roles auto-created by the controller",
+	})
+
+	// ManagedAccessEntries is the number of EKS access entries managed by the controller
+	ManagedAccessEntries = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "fis_managed_access_entries",
+		Help: "Number of EKS access entries managed by the controller",
+	})
+
+	// ManagedRBACObjects is the number of ServiceAccounts, Roles and RoleBindings created by the controller
+	ManagedRBACObjects = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "fis_managed_rbac_objects",
+		Help: "Number of Kubernetes ServiceAccounts, Roles and RoleBindings managed by the controller",
+	})
+)
+
+func init() {
+	ctrlmetrics.Registry.MustRegister(ManagedTemplates, ManagedIAMRoles, ManagedAccessEntries, ManagedRBACObjects)
+}
+
+// TemplateResources counts the resources the controller manages for one ExperimentTemplate
+type TemplateResources struct {
+	Templates     int
+	IAMRoles      int
+	AccessEntries int
+	RBACObjects   int
+}
+
+// Inventory tracks the resources managed per ExperimentTemplate and keeps the gauges at their sum
+// Recording a template replaces its previous counts, so reconciling the same template twice is safe
+type Inventory struct {
+	mu        sync.Mutex
+	templates map[string]TemplateResources
+}
+
+// Record sets the resources managed for a template
+func (i *Inventory) Record(name string, resources TemplateResources) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if i.templates == nil {
+		i.templates = make(map[string]TemplateResources)
+	}
+	i.templates[name] = resources
+	i.update()
+}
+
+// Forget drops a deleted template from the inventory
+func (i *Inventory) Forget(name string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	delete(i.templates, name)
+	i.update()
+}
+
+// update sets the gauges to the totals of all templates, callers hold mu
+func (i *Inventory) update() {
+	var total TemplateResources
+	for _, resources := range i.templates {
+		total.Templates += resources.Templates
+		total.IAMRoles += resources.IAMRoles
+		total.AccessEntries += resources.AccessEntries
+		total.RBACObjects += resources.RBACObjects
+	}
+	ManagedTemplates.Set(float64(total.Templates))
+	ManagedIAMRoles.Set(float64(total.IAMRoles))
+	ManagedAccessEntries.Set(float64(total.AccessEntries))
+	ManagedRBACObjects.Set(float64(total.RBACObjects))
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+)
+
+// gaugeValue returns the current value of a gauge
+func gaugeValue(t *testing.T, gauge prometheus.Gauge) float64 {
+	t.Helper()
+	m := &dto.Metric{}
+	if err := gauge.Write(m); err != nil {
+		t.Fatalf("Failed to read gauge: %v", err)
+	}
+	return m.GetGauge().GetValue()
+}
+
+func TestInventoryTracksTemplates(t *testing.T) {
+	inventory := &Inventory{}
+
+	inventory.Record("web", TemplateResources{Templates: 1, IAMRoles: 1, AccessEntries: 1, RBACObjects: 6})
+	inventory.Record("api", TemplateResources{Templates: 1, RBACObjects: 3})
+	// Recording a template again replaces its counts instead of adding to them
+	inventory.Record("api", TemplateResources{Templates: 1, RBACObjects: 3})
+
+	want := map[string]float64{"templates": 2, "roles": 1, "access entries": 1, "rbac": 9}
+	got := map[string]float64{
+		"templates":      gaugeValue(t, ManagedTemplates),
+		"roles":          gaugeValue(t, ManagedIAMRoles),
+		"access entries": gaugeValue(t, ManagedAccessEntries),
+		"rbac":           gaugeValue(t, ManagedRBACObjects),
+	}
+	for name, value := range want {
+		if got[name] != value {
+			t.Errorf("Expected %v managed %s, got: %v", value, name, got[name])
+		}
+	}
+
+	inventory.Forget("web")
+	if got := gaugeValue(t, ManagedTemplates); got != 1 {
+		t.Errorf("Expected 1 managed template after forgetting one, got: %v", got)
+	}
+	if got := gaugeValue(t, ManagedIAMRoles); got != 0 {
+		t.Errorf("Expected no managed roles after forgetting web, got: %v", got)
+	}
+	if got := gaugeValue(t, ManagedRBACObjects); got != 3 {
+		t.Errorf("Expected 3 managed RBAC objects after forgetting web, got: %v", got)
+	}
+}
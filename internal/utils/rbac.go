@@ -18,33 +18,93 @@ package utils
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"strings"
+	"text/template"
 
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+)
+
+const (
+	// managedByLabel and managedByValue mark resources created by the controller
+	managedByLabel = "app.kubernetes.io/managed-by"
+	managedByValue = "aws-fis-controller"
+
+	// templateLabel names the ExperimentTemplate a resource was created for
+	templateLabel = "fis.dksshddl.dev/template"
+
+	// IRSARoleArnAnnotation is the ServiceAccount annotation naming the IAM role its pods assume (IRSA)
+	IRSARoleArnAnnotation = "eks.amazonaws.com/role-arn"
 )
 
 const (
 	FISServiceAccountName = "fis-pod-sa"
 	FISRoleName           = "fis-pod-role"
 	FISRoleBindingName    = "fis-pod-rolebinding"
+
+	// DefaultRBACNameTemplate names the per-template ServiceAccount, Role, RoleBinding and RBAC username
+	DefaultRBACNameTemplate = "fis-{{.TemplateName}}"
+
+	// maxRBACNameLength is the Kubernetes object name limit (DNS subdomain)
+	maxRBACNameLength = 253
+
+	// rbacNameHashLength is the number of hex characters appended to truncated names
+	rbacNameHashLength = 8
 )
 
-// SetupFISRBAC creates ServiceAccount, Role, and RoleBinding for FIS pods
-// ref. Configure the Kubernetes service account - https://docs.aws.amazon.com/fis/latest/userguide/eks-pod-actions.html#configure-service-account
-func SetupFISRBAC(ctx context.Context, namespace string) error {
-	setupLog := ctrl.Log.WithName("setup-rbac")
+// ExperimentTemplateRBACName renders the RBAC resource name for an ExperimentTemplate
+// nameTemplate is a Go template with a .TemplateName field; an empty value uses DefaultRBACNameTemplate
+// Names longer than 253 characters are truncated and suffixed with a hash of the full name
+func ExperimentTemplateRBACName(nameTemplate, templateName string) (string, error) {
+	if nameTemplate == "" {
+		nameTemplate = DefaultRBACNameTemplate
+	}
 
-	config := ctrl.GetConfigOrDie()
-	clientset, err := kubernetes.NewForConfig(config)
+	tmpl, err := template.New("rbac-name").Option("missingkey=error").Parse(nameTemplate)
 	if err != nil {
-		return fmt.Errorf("failed to create kubernetes clientset: %w", err)
+		return "", fmt.Errorf("failed to parse service account name template: %w", err)
+	}
+
+	var b strings.Builder
+	if err := tmpl.Execute(&b, struct{ TemplateName string }{TemplateName: templateName}); err != nil {
+		return "", fmt.Errorf("failed to render service account name template: %w", err)
+	}
+
+	name := strings.ToLower(b.String())
+	if name == "" {
+		return "", fmt.Errorf("service account name template %q rendered an empty name", nameTemplate)
 	}
+	return truncateWithHash(name, maxRBACNameLength), nil
+}
+
+// truncateWithHash shortens name to maxLength, replacing the tail with a hash of the full name
+func truncateWithHash(name string, maxLength int) string {
+	if len(name) <= maxLength {
+		return name
+	}
+
+	sum := sha256.Sum256([]byte(name))
+	suffix := hex.EncodeToString(sum[:])[:rbacNameHashLength]
+	prefix := strings.TrimRight(name[:maxLength-rbacNameHashLength-1], "-.")
+	return prefix + "-" + suffix
+}
+
+// SetupFISRBAC creates ServiceAccount, Role, and RoleBinding for FIS pods
+// ref. Configure the Kubernetes service account - https://docs.aws.amazon.com/fis/latest/userguide/eks-pod-actions.html#configure-service-account
+// Existing Roles and RoleBindings are updated to the current rules, so it is safe to call repeatedly
+func SetupFISRBAC(ctx context.Context, clientset kubernetes.Interface, namespace string) error {
+	setupLog := ctrl.Log.WithName("setup-rbac")
 
 	// Create ServiceAccount
 	if err := createServiceAccount(ctx, clientset, namespace); err != nil {
@@ -58,19 +118,19 @@ func SetupFISRBAC(ctx context.Context, namespace string) error {
 		setupLog.Error(err, "failed to create Role")
 		return err
 	}
-	setupLog.Info("Role created or already exists", "name", FISRoleName, "namespace", namespace)
+	setupLog.Info("Role created or up to date", "name", FISRoleName, "namespace", namespace)
 
 	// Create RoleBinding
 	if err := createRoleBinding(ctx, clientset, namespace); err != nil {
 		setupLog.Error(err, "failed to create RoleBinding")
 		return err
 	}
-	setupLog.Info("RoleBinding created or already exists", "name", FISRoleBindingName, "namespace", namespace)
+	setupLog.Info("RoleBinding created or up to date", "name", FISRoleBindingName, "namespace", namespace)
 
 	return nil
 }
 
-func createServiceAccount(ctx context.Context, clientset *kubernetes.Clientset, namespace string) error {
+func createServiceAccount(ctx context.Context, clientset kubernetes.Interface, namespace string) error {
 	sa := &corev1.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      FISServiceAccountName,
@@ -91,7 +151,7 @@ func createServiceAccount(ctx context.Context, clientset *kubernetes.Clientset,
 	return nil
 }
 
-func createRole(ctx context.Context, clientset *kubernetes.Clientset, namespace string) error {
+func createRole(ctx context.Context, clientset kubernetes.Interface, namespace string) error {
 	role := &rbacv1.Role{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      FISRoleName,
@@ -121,15 +181,30 @@ func createRole(ctx context.Context, clientset *kubernetes.Clientset, namespace
 		},
 	}
 
-	_, err := clientset.RbacV1().Roles(namespace).Create(ctx, role, metav1.CreateOptions{})
-	if err != nil && !errors.IsAlreadyExists(err) {
-		return fmt.Errorf("failed to create Role: %w", err)
+	existing, err := clientset.RbacV1().Roles(namespace).Get(ctx, FISRoleName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		if _, err := clientset.RbacV1().Roles(namespace).Create(ctx, role, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create Role: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get Role: %w", err)
+	}
+
+	// Roles created by an older controller are upgraded to the current rules
+	if equality.Semantic.DeepEqual(existing.Rules, role.Rules) {
+		return nil
+	}
+	existing.Rules = role.Rules
+	if _, err := clientset.RbacV1().Roles(namespace).Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update Role: %w", err)
 	}
 
 	return nil
 }
 
-func createRoleBinding(ctx context.Context, clientset *kubernetes.Clientset, namespace string) error {
+func createRoleBinding(ctx context.Context, clientset kubernetes.Interface, namespace string) error {
 	roleBinding := &rbacv1.RoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      FISRoleBindingName,
@@ -154,9 +229,24 @@ func createRoleBinding(ctx context.Context, clientset *kubernetes.Clientset, nam
 		},
 	}
 
-	_, err := clientset.RbacV1().RoleBindings(namespace).Create(ctx, roleBinding, metav1.CreateOptions{})
-	if err != nil && !errors.IsAlreadyExists(err) {
-		return fmt.Errorf("failed to create RoleBinding: %w", err)
+	existing, err := clientset.RbacV1().RoleBindings(namespace).Get(ctx, FISRoleBindingName, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		if _, err := clientset.RbacV1().RoleBindings(namespace).Create(ctx, roleBinding, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create RoleBinding: %w", err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get RoleBinding: %w", err)
+	}
+
+	// The roleRef of a RoleBinding is immutable, so only the subjects are updated
+	if equality.Semantic.DeepEqual(existing.Subjects, roleBinding.Subjects) {
+		return nil
+	}
+	existing.Subjects = roleBinding.Subjects
+	if _, err := clientset.RbacV1().RoleBindings(namespace).Update(ctx, existing, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update RoleBinding: %w", err)
 	}
 
 	return nil
@@ -165,123 +255,215 @@ func createRoleBinding(ctx context.Context, clientset *kubernetes.Clientset, nam
 // SetupExperimentTemplateRBAC creates Kubernetes RBAC resources for an ExperimentTemplate
 // This creates a ServiceAccount, Role, and RoleBinding in the target namespace
 // ref. https://docs.aws.amazon.com/fis/latest/userguide/eks-pod-actions.html#configure-service-account
-func SetupExperimentTemplateRBAC(ctx context.Context, k8sClient client.Client, namespace, templateName string) (string, error) {
-	serviceAccountName := fmt.Sprintf("fis-%s", templateName)
-	username := fmt.Sprintf("fis-%s", templateName)
-
-	// Create ServiceAccount
-	sa := &corev1.ServiceAccount{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      serviceAccountName,
-			Namespace: namespace,
-			Labels: map[string]string{
-				"app.kubernetes.io/managed-by": "aws-fis-controller",
-				"fis.dksshddl.dev/template":    templateName,
-			},
-		},
+// name is used for the ServiceAccount, Role, RoleBinding and the RBAC username, see ExperimentTemplateRBACName
+// The Role only grants what the template's action types need, see ExperimentTemplateRBACRules
+// serviceAccountRoleArn, when set, is the IRSA role of the ServiceAccount, see EnsureExperimentTemplateServiceAccount
+// owner, when set, makes Kubernetes garbage collect the resources together with the ExperimentTemplate
+func SetupExperimentTemplateRBAC(ctx context.Context, k8sClient client.Client, namespace, templateName, name string, actionTypes []string, serviceAccountRoleArn string, owner *metav1.OwnerReference) (string, error) {
+	serviceAccountName := name
+	username := name
+
+	// Create or update ServiceAccount
+	if err := EnsureExperimentTemplateServiceAccount(ctx, k8sClient, namespace, templateName, name, serviceAccountRoleArn, owner); err != nil {
+		return "", err
 	}
 
-	if err := k8sClient.Create(ctx, sa); err != nil {
-		if !errors.IsAlreadyExists(err) {
-			return "", fmt.Errorf("failed to create ServiceAccount: %w", err)
-		}
+	// Create or update the Role with permissions for FIS pod (based on official AWS FIS documentation)
+	// Existing Roles are updated, so rule changes reach them when the actions or the controller change
+	roleName := name
+	role := &rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: roleName, Namespace: namespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, k8sClient, role, func() error {
+		role.Labels = experimentTemplateRBACLabels(templateName)
+		role.OwnerReferences = ownerReferences(owner)
+		role.Rules = ExperimentTemplateRBACRules(actionTypes)
+		return nil
+	}); err != nil {
+		return "", fmt.Errorf("failed to create or update Role: %w", err)
 	}
 
-	// Create Role with permissions for FIS pod (based on official AWS FIS documentation)
-	roleName := fmt.Sprintf("fis-%s", templateName)
-	role := &rbacv1.Role{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      roleName,
-			Namespace: namespace,
-			Labels: map[string]string{
-				"app.kubernetes.io/managed-by": "aws-fis-controller",
-				"fis.dksshddl.dev/template":    templateName,
-			},
-		},
-		Rules: []rbacv1.PolicyRule{
+	// Create or update the RoleBinding (binds both ServiceAccount and dynamic username)
+	roleBindingName := name
+	roleBinding := &rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: roleBindingName, Namespace: namespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, k8sClient, roleBinding, func() error {
+		roleBinding.Labels = experimentTemplateRBACLabels(templateName)
+		roleBinding.OwnerReferences = ownerReferences(owner)
+		roleBinding.Subjects = []rbacv1.Subject{
 			{
-				APIGroups: []string{""},
-				Resources: []string{"configmaps"},
-				Verbs:     []string{"get", "create", "patch", "delete"},
+				Kind:      "ServiceAccount",
+				Name:      serviceAccountName,
+				Namespace: namespace,
 			},
 			{
-				APIGroups: []string{""},
-				Resources: []string{"pods"},
-				Verbs:     []string{"create", "list", "get", "delete", "deletecollection"},
+				APIGroup: "rbac.authorization.k8s.io",
+				Kind:     "User",
+				Name:     username,
 			},
-			{
+		}
+		roleBinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "Role",
+			Name:     roleName,
+		}
+		return nil
+	}); err != nil {
+		return "", fmt.Errorf("failed to create or update RoleBinding: %w", err)
+	}
+
+	return serviceAccountName, nil
+}
+
+// EnsureExperimentTemplateServiceAccount creates or updates the ServiceAccount of an ExperimentTemplate
+// roleArn is set as the IRSA role annotation so FIS pods can assume an IAM role; an empty roleArn removes it
+func EnsureExperimentTemplateServiceAccount(ctx context.Context, k8sClient client.Client, namespace, templateName, name, roleArn string, owner *metav1.OwnerReference) error {
+	sa := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, k8sClient, sa, func() error {
+		sa.Labels = experimentTemplateRBACLabels(templateName)
+		sa.OwnerReferences = ownerReferences(owner)
+		if roleArn == "" {
+			delete(sa.Annotations, IRSARoleArnAnnotation)
+			return nil
+		}
+		if sa.Annotations == nil {
+			sa.Annotations = map[string]string{}
+		}
+		sa.Annotations[IRSARoleArnAnnotation] = roleArn
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to create or update ServiceAccount: %w", err)
+	}
+	return nil
+}
+
+// experimentTemplateRBACLabels returns the labels of the RBAC resources created for an ExperimentTemplate
+func experimentTemplateRBACLabels(templateName string) map[string]string {
+	return map[string]string{
+		managedByLabel: managedByValue,
+		templateLabel:  templateName,
+	}
+}
+
+// ownerReferences returns owner as a list, or nil when there is no owner
+func ownerReferences(owner *metav1.OwnerReference) []metav1.OwnerReference {
+	if owner == nil {
+		return nil
+	}
+	return []metav1.OwnerReference{*owner}
+}
+
+// DeleteOrphanedExperimentTemplateRBAC deletes the RBAC resources of an ExperimentTemplate in every namespace
+// It finds them by label, so it also removes resources left behind when the controller stopped
+// between creating them and recording the template's target namespaces
+func DeleteOrphanedExperimentTemplateRBAC(ctx context.Context, k8sClient client.Client, templateName string) (int, error) {
+	selector := client.MatchingLabels(experimentTemplateRBACLabels(templateName))
+	lists := []client.ObjectList{
+		&rbacv1.ClusterRoleBindingList{}, &rbacv1.ClusterRoleList{},
+		&rbacv1.RoleBindingList{}, &rbacv1.RoleList{}, &corev1.ServiceAccountList{},
+	}
+
+	deleted := 0
+	for _, list := range lists {
+		if err := k8sClient.List(ctx, list, selector); err != nil {
+			return deleted, fmt.Errorf("failed to list RBAC resources of template %s: %w", templateName, err)
+		}
+		objs, err := meta.ExtractList(list)
+		if err != nil {
+			return deleted, fmt.Errorf("failed to read RBAC resources of template %s: %w", templateName, err)
+		}
+		for _, obj := range objs {
+			if err := k8sClient.Delete(ctx, obj.(client.Object)); err != nil && !errors.IsNotFound(err) {
+				return deleted, fmt.Errorf("failed to delete orphaned RBAC resource: %w", err)
+			}
+			deleted++
+		}
+	}
+	return deleted, nil
+}
+
+// ExperimentTemplateRBACRules returns the Role rules the FIS experiment pod needs for the given action types
+// Every action needs the FIS pod and its configmap; ephemeral container actions (stress and network) add
+// pods/ephemeralcontainers and pods/exec, and pod-delete adds deletecollection
+// Unknown action types get every permission, so new FIS actions keep working
+func ExperimentTemplateRBACRules(actionTypes []string) []rbacv1.PolicyRule {
+	var ephemeralContainers, deletePods bool
+	for _, actionType := range actionTypes {
+		switch actionType {
+		case "pod-cpu-stress", "pod-memory-stress", "pod-io-stress",
+			"pod-network-latency", "pod-network-packet-loss", "pod-network-bandwidth":
+			ephemeralContainers = true
+		case "pod-delete":
+			deletePods = true
+		default:
+			ephemeralContainers = true
+			deletePods = true
+		}
+	}
+
+	podVerbs := []string{"create", "list", "get", "delete"}
+	if deletePods {
+		podVerbs = append(podVerbs, "deletecollection")
+	}
+
+	rules := []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{""},
+			Resources: []string{"configmaps"},
+			Verbs:     []string{"get", "create", "patch", "delete"},
+		},
+		{
+			APIGroups: []string{""},
+			Resources: []string{"pods"},
+			Verbs:     podVerbs,
+		},
+	}
+	if ephemeralContainers {
+		rules = append(rules,
+			rbacv1.PolicyRule{
 				APIGroups: []string{""},
 				Resources: []string{"pods/ephemeralcontainers"},
 				Verbs:     []string{"update"},
 			},
-			{
+			rbacv1.PolicyRule{
 				APIGroups: []string{""},
 				Resources: []string{"pods/exec"},
 				Verbs:     []string{"create"},
 			},
-			{
-				APIGroups: []string{"apps"},
-				Resources: []string{"deployments"},
-				Verbs:     []string{"get"},
-			},
-		},
+		)
 	}
+	return append(rules, rbacv1.PolicyRule{
+		APIGroups: []string{"apps"},
+		Resources: []string{"deployments"},
+		Verbs:     []string{"get"},
+	})
+}
 
-	if err := k8sClient.Create(ctx, role); err != nil {
-		if !errors.IsAlreadyExists(err) {
-			return "", fmt.Errorf("failed to create Role: %w", err)
-		}
+// DeleteExperimentTemplateRBAC deletes Kubernetes RBAC resources for an ExperimentTemplate
+func DeleteExperimentTemplateRBAC(ctx context.Context, k8sClient client.Client, namespace, name string) error {
+	if err := DeleteExperimentTemplateRoles(ctx, k8sClient, namespace, name); err != nil {
+		return err
 	}
 
-	// Create RoleBinding (binds both ServiceAccount and dynamic username)
-	roleBindingName := fmt.Sprintf("fis-%s", templateName)
-	roleBinding := &rbacv1.RoleBinding{
+	// Delete ServiceAccount
+	sa := &corev1.ServiceAccount{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      roleBindingName,
+			Name:      name,
 			Namespace: namespace,
-			Labels: map[string]string{
-				"app.kubernetes.io/managed-by": "aws-fis-controller",
-				"fis.dksshddl.dev/template":    templateName,
-			},
-		},
-		Subjects: []rbacv1.Subject{
-			{
-				Kind:      "ServiceAccount",
-				Name:      serviceAccountName,
-				Namespace: namespace,
-			},
-			{
-				APIGroup: "rbac.authorization.k8s.io",
-				Kind:     "User",
-				Name:     username,
-			},
-		},
-		RoleRef: rbacv1.RoleRef{
-			APIGroup: "rbac.authorization.k8s.io",
-			Kind:     "Role",
-			Name:     roleName,
 		},
 	}
-
-	if err := k8sClient.Create(ctx, roleBinding); err != nil {
-		if !errors.IsAlreadyExists(err) {
-			return "", fmt.Errorf("failed to create RoleBinding: %w", err)
+	if err := k8sClient.Delete(ctx, sa); err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete ServiceAccount: %w", err)
 		}
 	}
 
-	return serviceAccountName, nil
+	return nil
 }
 
-// DeleteExperimentTemplateRBAC deletes Kubernetes RBAC resources for an ExperimentTemplate
-func DeleteExperimentTemplateRBAC(ctx context.Context, k8sClient client.Client, namespace, templateName string) error {
-	serviceAccountName := fmt.Sprintf("fis-%s", templateName)
-	roleName := fmt.Sprintf("fis-%s", templateName)
-	roleBindingName := fmt.Sprintf("fis-%s", templateName)
-
+// DeleteExperimentTemplateRoles deletes the Role and RoleBinding of an ExperimentTemplate but keeps its ServiceAccount
+func DeleteExperimentTemplateRoles(ctx context.Context, k8sClient client.Client, namespace, name string) error {
 	// Delete RoleBinding
 	roleBinding := &rbacv1.RoleBinding{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      roleBindingName,
+			Name:      name,
 			Namespace: namespace,
 		},
 	}
@@ -294,7 +476,7 @@ func DeleteExperimentTemplateRBAC(ctx context.Context, k8sClient client.Client,
 	// Delete Role
 	role := &rbacv1.Role{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      roleName,
+			Name:      name,
 			Namespace: namespace,
 		},
 	}
@@ -304,16 +486,66 @@ func DeleteExperimentTemplateRBAC(ctx context.Context, k8sClient client.Client,
 		}
 	}
 
-	// Delete ServiceAccount
-	sa := &corev1.ServiceAccount{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      serviceAccountName,
-			Namespace: namespace,
-		},
+	return nil
+}
+
+// SetupExperimentTemplateClusterRBAC creates cluster-wide Kubernetes RBAC resources for an ExperimentTemplate
+// This creates a single ClusterRole and ClusterRoleBinding instead of a Role and RoleBinding per namespace.
+// FIS still runs its experiment pod under a ServiceAccount in the target namespace, so a ServiceAccount
+// is created in each namespace and bound by the ClusterRoleBinding together with the RBAC username
+// The ClusterRoleBinding subjects follow the namespaces on every call
+func SetupExperimentTemplateClusterRBAC(ctx context.Context, k8sClient client.Client, namespaces []string, templateName, name string, actionTypes []string, serviceAccountRoleArn string, owner *metav1.OwnerReference) (string, error) {
+	subjects := make([]rbacv1.Subject, 0, len(namespaces)+1)
+	for _, namespace := range namespaces {
+		if err := EnsureExperimentTemplateServiceAccount(ctx, k8sClient, namespace, templateName, name, serviceAccountRoleArn, owner); err != nil {
+			return "", fmt.Errorf("namespace %s: %w", namespace, err)
+		}
+		subjects = append(subjects, rbacv1.Subject{Kind: "ServiceAccount", Name: name, Namespace: namespace})
 	}
-	if err := k8sClient.Delete(ctx, sa); err != nil {
+	subjects = append(subjects, rbacv1.Subject{APIGroup: "rbac.authorization.k8s.io", Kind: "User", Name: name})
+
+	clusterRole := &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, k8sClient, clusterRole, func() error {
+		clusterRole.Labels = experimentTemplateRBACLabels(templateName)
+		clusterRole.OwnerReferences = ownerReferences(owner)
+		clusterRole.Rules = ExperimentTemplateRBACRules(actionTypes)
+		return nil
+	}); err != nil {
+		return "", fmt.Errorf("failed to create ClusterRole: %w", err)
+	}
+
+	clusterRoleBinding := &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if _, err := controllerutil.CreateOrUpdate(ctx, k8sClient, clusterRoleBinding, func() error {
+		clusterRoleBinding.Labels = experimentTemplateRBACLabels(templateName)
+		clusterRoleBinding.OwnerReferences = ownerReferences(owner)
+		clusterRoleBinding.Subjects = subjects
+		clusterRoleBinding.RoleRef = rbacv1.RoleRef{
+			APIGroup: "rbac.authorization.k8s.io",
+			Kind:     "ClusterRole",
+			Name:     name,
+		}
+		return nil
+	}); err != nil {
+		return "", fmt.Errorf("failed to create ClusterRoleBinding: %w", err)
+	}
+
+	return name, nil
+}
+
+// DeleteExperimentTemplateClusterRBAC deletes the ClusterRole and ClusterRoleBinding of an ExperimentTemplate
+// The ServiceAccounts in the target namespaces are deleted with DeleteExperimentTemplateRBAC
+func DeleteExperimentTemplateClusterRBAC(ctx context.Context, k8sClient client.Client, name string) error {
+	clusterRoleBinding := &rbacv1.ClusterRoleBinding{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if err := k8sClient.Delete(ctx, clusterRoleBinding); err != nil {
 		if !errors.IsNotFound(err) {
-			return fmt.Errorf("failed to delete ServiceAccount: %w", err)
+			return fmt.Errorf("failed to delete ClusterRoleBinding: %w", err)
+		}
+	}
+
+	clusterRole := &rbacv1.ClusterRole{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	if err := k8sClient.Delete(ctx, clusterRole); err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete ClusterRole: %w", err)
 		}
 	}
 
@@ -0,0 +1,361 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package utils
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestExperimentTemplateRBACName(t *testing.T) {
+	tests := []struct {
+		name         string
+		nameTemplate string
+		templateName string
+		want         string
+		wantErr      bool
+	}{
+		{name: "default", templateName: "cpu-stress", want: "fis-cpu-stress"},
+		{name: "explicit default", nameTemplate: DefaultRBACNameTemplate, templateName: "cpu-stress", want: "fis-cpu-stress"},
+		{name: "custom", nameTemplate: "chaos-{{.TemplateName}}-sa", templateName: "cpu-stress", want: "chaos-cpu-stress-sa"},
+		{name: "unknown field", nameTemplate: "{{.Namespace}}", templateName: "cpu-stress", wantErr: true},
+		{name: "parse error", nameTemplate: "{{.TemplateName", templateName: "cpu-stress", wantErr: true},
+		{name: "empty result", nameTemplate: "{{if false}}x{{end}}", templateName: "cpu-stress", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ExperimentTemplateRBACName(tt.nameTemplate, tt.templateName)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExperimentTemplateRBACName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ExperimentTemplateRBACName() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExperimentTemplateRBACNameLongTemplateName(t *testing.T) {
+	longName := strings.Repeat("a", 300)
+	otherLongName := strings.Repeat("a", 299) + "b"
+
+	name, err := ExperimentTemplateRBACName("", longName)
+	if err != nil {
+		t.Fatalf("ExperimentTemplateRBACName failed: %v", err)
+	}
+	other, err := ExperimentTemplateRBACName("", otherLongName)
+	if err != nil {
+		t.Fatalf("ExperimentTemplateRBACName failed: %v", err)
+	}
+
+	if len(name) != maxRBACNameLength {
+		t.Errorf("Expected name length %d, got: %d", maxRBACNameLength, len(name))
+	}
+	if !strings.HasPrefix(name, "fis-aaaa") {
+		t.Errorf("Expected truncated name to keep its prefix, got: %s", name)
+	}
+	if name == other {
+		t.Errorf("Expected names differing only after the limit to get different hash suffixes, both got: %s", name)
+	}
+
+	again, _ := ExperimentTemplateRBACName("", longName)
+	if again != name {
+		t.Errorf("Expected truncation to be deterministic, got %s and %s", name, again)
+	}
+}
+
+func TestSetupExperimentTemplateRBACUsesName(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	ctx := context.Background()
+
+	templateName := strings.Repeat("long-template-", 20)
+	name, err := ExperimentTemplateRBACName("", templateName)
+	if err != nil {
+		t.Fatalf("ExperimentTemplateRBACName failed: %v", err)
+	}
+
+	sa, err := SetupExperimentTemplateRBAC(ctx, k8sClient, "default", templateName, name, []string{"pod-cpu-stress"}, "", nil)
+	if err != nil {
+		t.Fatalf("SetupExperimentTemplateRBAC failed: %v", err)
+	}
+	if sa != name {
+		t.Errorf("Expected service account %s, got: %s", name, sa)
+	}
+
+	key := types.NamespacedName{Namespace: "default", Name: name}
+	if err := k8sClient.Get(ctx, key, &corev1.ServiceAccount{}); err != nil {
+		t.Errorf("Expected ServiceAccount %s: %v", name, err)
+	}
+	if err := k8sClient.Get(ctx, key, &rbacv1.Role{}); err != nil {
+		t.Errorf("Expected Role %s: %v", name, err)
+	}
+	roleBinding := &rbacv1.RoleBinding{}
+	if err := k8sClient.Get(ctx, key, roleBinding); err != nil {
+		t.Fatalf("Expected RoleBinding %s: %v", name, err)
+	}
+	if roleBinding.RoleRef.Name != name || roleBinding.Subjects[0].Name != name || roleBinding.Subjects[1].Name != name {
+		t.Errorf("Expected RoleBinding to reference %s, got: %+v", name, roleBinding)
+	}
+
+	if err := DeleteExperimentTemplateRBAC(ctx, k8sClient, "default", name); err != nil {
+		t.Fatalf("DeleteExperimentTemplateRBAC failed: %v", err)
+	}
+	if err := k8sClient.Get(ctx, key, &corev1.ServiceAccount{}); err == nil {
+		t.Error("Expected ServiceAccount to be deleted")
+	}
+}
+
+func TestSetupExperimentTemplateRBACDerivesRulesFromActions(t *testing.T) {
+	hasRule := func(rules []rbacv1.PolicyRule, resource, verb string) bool {
+		for _, rule := range rules {
+			if slices.Contains(rule.Resources, resource) && slices.Contains(rule.Verbs, verb) {
+				return true
+			}
+		}
+		return false
+	}
+
+	tests := []struct {
+		name                 string
+		actionTypes          []string
+		wantExec             bool
+		wantDeleteCollection bool
+	}{
+		{name: "pod-delete only", actionTypes: []string{"pod-delete"}, wantDeleteCollection: true},
+		{name: "stress only", actionTypes: []string{"pod-cpu-stress", "pod-network-latency"}, wantExec: true},
+		{name: "stress and delete", actionTypes: []string{"pod-cpu-stress", "pod-delete"}, wantExec: true, wantDeleteCollection: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			_ = clientgoscheme.AddToScheme(scheme)
+			k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+			ctx := context.Background()
+
+			if _, err := SetupExperimentTemplateRBAC(ctx, k8sClient, "default", "web", "fis-web", tt.actionTypes, "", nil); err != nil {
+				t.Fatalf("SetupExperimentTemplateRBAC failed: %v", err)
+			}
+			role := &rbacv1.Role{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "fis-web"}, role); err != nil {
+				t.Fatalf("Expected Role fis-web: %v", err)
+			}
+
+			if got := hasRule(role.Rules, "pods/exec", "create"); got != tt.wantExec {
+				t.Errorf("Expected pods/exec=%v, got rules: %+v", tt.wantExec, role.Rules)
+			}
+			if got := hasRule(role.Rules, "pods/ephemeralcontainers", "update"); got != tt.wantExec {
+				t.Errorf("Expected pods/ephemeralcontainers=%v, got rules: %+v", tt.wantExec, role.Rules)
+			}
+			if got := hasRule(role.Rules, "pods", "deletecollection"); got != tt.wantDeleteCollection {
+				t.Errorf("Expected pods deletecollection=%v, got rules: %+v", tt.wantDeleteCollection, role.Rules)
+			}
+			if !hasRule(role.Rules, "configmaps", "create") || !hasRule(role.Rules, "pods", "create") {
+				t.Errorf("Expected the FIS pod permissions for every action, got rules: %+v", role.Rules)
+			}
+		})
+	}
+}
+
+func TestSetupExperimentTemplateClusterRBAC(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	ctx := context.Background()
+
+	if _, err := SetupExperimentTemplateClusterRBAC(ctx, k8sClient, []string{"a", "b"}, "web", "fis-web", []string{"pod-delete"}, "", nil); err != nil {
+		t.Fatalf("SetupExperimentTemplateClusterRBAC failed: %v", err)
+	}
+	for _, ns := range []string{"a", "b"} {
+		key := types.NamespacedName{Namespace: ns, Name: "fis-web"}
+		if err := k8sClient.Get(ctx, key, &corev1.ServiceAccount{}); err != nil {
+			t.Errorf("Expected ServiceAccount in namespace %s: %v", ns, err)
+		}
+		if err := k8sClient.Get(ctx, key, &rbacv1.Role{}); err == nil {
+			t.Errorf("Expected no Role in namespace %s", ns)
+		}
+	}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: "fis-web"}, &rbacv1.ClusterRole{}); err != nil {
+		t.Errorf("Expected ClusterRole fis-web: %v", err)
+	}
+
+	// The binding follows the namespaces when they change
+	if _, err := SetupExperimentTemplateClusterRBAC(ctx, k8sClient, []string{"b"}, "web", "fis-web", []string{"pod-delete"}, "", nil); err != nil {
+		t.Fatalf("SetupExperimentTemplateClusterRBAC failed: %v", err)
+	}
+	binding := &rbacv1.ClusterRoleBinding{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: "fis-web"}, binding); err != nil {
+		t.Fatalf("Expected ClusterRoleBinding fis-web: %v", err)
+	}
+	want := []rbacv1.Subject{
+		{Kind: "ServiceAccount", Name: "fis-web", Namespace: "b"},
+		{APIGroup: "rbac.authorization.k8s.io", Kind: "User", Name: "fis-web"},
+	}
+	if !slices.Equal(binding.Subjects, want) || binding.RoleRef.Kind != "ClusterRole" || binding.RoleRef.Name != "fis-web" {
+		t.Errorf("Unexpected ClusterRoleBinding: %+v", binding)
+	}
+
+	if err := DeleteExperimentTemplateClusterRBAC(ctx, k8sClient, "fis-web"); err != nil {
+		t.Fatalf("DeleteExperimentTemplateClusterRBAC failed: %v", err)
+	}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Name: "fis-web"}, &rbacv1.ClusterRoleBinding{}); err == nil {
+		t.Error("Expected ClusterRoleBinding to be deleted")
+	}
+}
+
+func TestSetupFISRBACIsIdempotent(t *testing.T) {
+	clientset := k8sfake.NewClientset()
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		if err := SetupFISRBAC(ctx, clientset, "chaos"); err != nil {
+			t.Fatalf("SetupFISRBAC call %d failed: %v", i+1, err)
+		}
+	}
+
+	if _, err := clientset.CoreV1().ServiceAccounts("chaos").Get(ctx, FISServiceAccountName, metav1.GetOptions{}); err != nil {
+		t.Errorf("Expected ServiceAccount %s: %v", FISServiceAccountName, err)
+	}
+	if _, err := clientset.RbacV1().Roles("chaos").Get(ctx, FISRoleName, metav1.GetOptions{}); err != nil {
+		t.Errorf("Expected Role %s: %v", FISRoleName, err)
+	}
+	roleBinding, err := clientset.RbacV1().RoleBindings("chaos").Get(ctx, FISRoleBindingName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Expected RoleBinding %s: %v", FISRoleBindingName, err)
+	}
+	if roleBinding.RoleRef.Name != FISRoleName || roleBinding.Subjects[0].Name != FISServiceAccountName {
+		t.Errorf("Expected RoleBinding to bind %s to %s, got: %+v", FISServiceAccountName, FISRoleName, roleBinding)
+	}
+
+	// The second call must not create duplicates
+	serviceAccounts, err := clientset.CoreV1().ServiceAccounts("chaos").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to list ServiceAccounts: %v", err)
+	}
+	roles, err := clientset.RbacV1().Roles("chaos").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to list Roles: %v", err)
+	}
+	roleBindings, err := clientset.RbacV1().RoleBindings("chaos").List(ctx, metav1.ListOptions{})
+	if err != nil {
+		t.Fatalf("Failed to list RoleBindings: %v", err)
+	}
+	if len(serviceAccounts.Items) != 1 || len(roles.Items) != 1 || len(roleBindings.Items) != 1 {
+		t.Errorf("Expected one of each object, got %d ServiceAccounts, %d Roles, %d RoleBindings",
+			len(serviceAccounts.Items), len(roles.Items), len(roleBindings.Items))
+	}
+}
+
+func TestSetupFISRBACUpdatesStaleRole(t *testing.T) {
+	stale := &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{Name: FISRoleName, Namespace: "chaos"},
+		Rules: []rbacv1.PolicyRule{
+			{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get"}},
+		},
+	}
+	clientset := k8sfake.NewClientset(stale)
+	ctx := context.Background()
+
+	if err := SetupFISRBAC(ctx, clientset, "chaos"); err != nil {
+		t.Fatalf("SetupFISRBAC failed: %v", err)
+	}
+
+	role, err := clientset.RbacV1().Roles("chaos").Get(ctx, FISRoleName, metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("Expected Role %s: %v", FISRoleName, err)
+	}
+	if len(role.Rules) != 3 || !slices.Contains(role.Rules[1].Resources, "pods/log") {
+		t.Errorf("Expected the stale Role to get the current rules, got: %+v", role.Rules)
+	}
+}
+
+func TestSetupExperimentTemplateRBACUpdatesStaleRole(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	ctx := context.Background()
+
+	// A Role created for pod-delete lacks the ephemeral container permissions of stress actions
+	if _, err := SetupExperimentTemplateRBAC(ctx, k8sClient, "default", "web", "fis-web", []string{"pod-delete"}, "", nil); err != nil {
+		t.Fatalf("SetupExperimentTemplateRBAC failed: %v", err)
+	}
+	if _, err := SetupExperimentTemplateRBAC(ctx, k8sClient, "default", "web", "fis-web", []string{"pod-cpu-stress"}, "", nil); err != nil {
+		t.Fatalf("SetupExperimentTemplateRBAC failed: %v", err)
+	}
+
+	role := &rbacv1.Role{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "fis-web"}, role); err != nil {
+		t.Fatalf("Expected Role fis-web: %v", err)
+	}
+	want := ExperimentTemplateRBACRules([]string{"pod-cpu-stress"})
+	if len(role.Rules) != len(want) {
+		t.Fatalf("Expected %d rules, got: %+v", len(want), role.Rules)
+	}
+	hasEphemeralContainers := false
+	for _, rule := range role.Rules {
+		if slices.Contains(rule.Resources, "pods/ephemeralcontainers") {
+			hasEphemeralContainers = true
+		}
+	}
+	if !hasEphemeralContainers {
+		t.Errorf("Expected pods/ephemeralcontainers to reach the existing Role, got: %+v", role.Rules)
+	}
+}
+
+func TestSetupExperimentTemplateRBACAnnotatesServiceAccountRoleArn(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	ctx := context.Background()
+	roleArn := "arn:aws:iam::123456789012:role/fis-pods"
+
+	if _, err := SetupExperimentTemplateRBAC(ctx, k8sClient, "default", "web", "fis-web", []string{"pod-delete"}, roleArn, nil); err != nil {
+		t.Fatalf("SetupExperimentTemplateRBAC failed: %v", err)
+	}
+	sa := &corev1.ServiceAccount{}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "fis-web"}, sa); err != nil {
+		t.Fatalf("Expected ServiceAccount fis-web: %v", err)
+	}
+	if got := sa.Annotations[IRSARoleArnAnnotation]; got != roleArn {
+		t.Errorf("Expected %s annotation %q, got %q", IRSARoleArnAnnotation, roleArn, got)
+	}
+
+	// Clearing the role ARN removes the annotation from the existing ServiceAccount
+	if _, err := SetupExperimentTemplateRBAC(ctx, k8sClient, "default", "web", "fis-web", []string{"pod-delete"}, "", nil); err != nil {
+		t.Fatalf("SetupExperimentTemplateRBAC failed: %v", err)
+	}
+	if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: "default", Name: "fis-web"}, sa); err != nil {
+		t.Fatalf("Expected ServiceAccount fis-web: %v", err)
+	}
+	if _, ok := sa.Annotations[IRSARoleArnAnnotation]; ok {
+		t.Errorf("Expected %s annotation to be removed, got: %v", IRSARoleArnAnnotation, sa.Annotations)
+	}
+}
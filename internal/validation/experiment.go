@@ -0,0 +1,121 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/robfig/cron/v3"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fisv1alpha1 "fis.dksshddl.dev/fis-controller/api/v1alpha1"
+)
+
+// AllowDestructiveScheduleAnnotation set to "true" on an Experiment allows scheduling
+// templates that delete every matching pod
+const AllowDestructiveScheduleAnnotation = "fis.dksshddl.dev/allow-destructive-schedule"
+
+// ExperimentValidator validates Experiment specs against policies that depend on their template
+type ExperimentValidator struct {
+	// Reader is used to look up the referenced ExperimentTemplate; template checks are skipped when nil
+	Reader client.Reader
+}
+
+// ScheduleParser parses Experiment schedules: standard 5-field cron expressions, an optional
+// leading seconds field, CRON_TZ= prefixes and descriptors such as @daily or @every 1h
+var ScheduleParser = cron.NewParser(
+	cron.SecondOptional | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor,
+)
+
+// Validate returns field errors for Experiments with an unparsable schedule or that violate a policy
+// Templates referenced by AWS ID only cannot be inspected and are not checked
+func (v *ExperimentValidator) Validate(ctx context.Context, experiment *fisv1alpha1.Experiment) field.ErrorList {
+	if experiment.Spec.Schedule == "" {
+		return nil
+	}
+
+	schedulePath := field.NewPath("spec", "schedule")
+	if _, err := ScheduleParser.Parse(experiment.Spec.Schedule); err != nil {
+		return field.ErrorList{field.Invalid(schedulePath, experiment.Spec.Schedule, err.Error())}
+	}
+
+	if experiment.Spec.ExperimentTemplate.Name == "" || v.Reader == nil {
+		return nil
+	}
+
+	template := &fisv1alpha1.ExperimentTemplate{}
+	if err := v.Reader.Get(ctx, client.ObjectKey{Name: experiment.Spec.ExperimentTemplate.Name}, template); err != nil {
+		// A missing template is reported when the template ID is resolved
+		return nil
+	}
+
+	return validateScheduledActions(experiment, template, schedulePath)
+}
+
+// ValidateTemplateReference checks that exactly one of experimentTemplate.id and experimentTemplate.name
+// is set and that a named ExperimentTemplate exists
+// The controller does not call it, since it retries until a referenced template is created
+func (v *ExperimentValidator) ValidateTemplateReference(ctx context.Context, experiment *fisv1alpha1.Experiment) field.ErrorList {
+	ref := experiment.Spec.ExperimentTemplate
+	path := field.NewPath("spec", "experimentTemplate")
+
+	switch {
+	case ref.ID == "" && ref.Name == "":
+		return field.ErrorList{field.Required(path, "exactly one of id or name must be set")}
+	case ref.ID != "" && ref.Name != "":
+		return field.ErrorList{field.Invalid(path, ref, "exactly one of id or name must be set, not both")}
+	case ref.Name == "" || v.Reader == nil:
+		return nil
+	}
+
+	err := v.Reader.Get(ctx, client.ObjectKey{Name: ref.Name}, &fisv1alpha1.ExperimentTemplate{})
+	switch {
+	case apierrors.IsNotFound(err):
+		return field.ErrorList{field.NotFound(path.Child("name"), ref.Name)}
+	case err != nil:
+		return field.ErrorList{field.InternalError(path.Child("name"), fmt.Errorf("failed to get ExperimentTemplate %s: %w", ref.Name, err))}
+	}
+	return nil
+}
+
+// validateScheduledActions rejects a schedule when the template deletes every matching pod of a target,
+// unless the Experiment carries AllowDestructiveScheduleAnnotation
+func validateScheduledActions(experiment *fisv1alpha1.Experiment, template *fisv1alpha1.ExperimentTemplate, path *field.Path) field.ErrorList {
+	if experiment.Annotations[AllowDestructiveScheduleAnnotation] == "true" {
+		return nil
+	}
+
+	var errs field.ErrorList
+	for _, action := range template.Spec.Actions {
+		if action.Type != "pod-delete" {
+			continue
+		}
+		target := findTarget(template, action.Target)
+		if target == nil {
+			continue
+		}
+		if mode, _, ok := targetSelection(*target); ok && mode == "ALL" {
+			errs = append(errs, field.Forbidden(path, fmt.Sprintf(
+				"template %s deletes all pods of target %q with action %q and must not run on a schedule; set annotation %s=true to allow it",
+				template.Name, target.Name, action.Name, AllowDestructiveScheduleAnnotation)))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fisv1alpha1 "fis.dksshddl.dev/fis-controller/api/v1alpha1"
+)
+
+// newPodDeleteTemplate returns a template deleting pods of its only target with the given selection mode
+func newPodDeleteTemplate(selectionMode string) *fisv1alpha1.ExperimentTemplate {
+	template := newTemplate()
+	template.Name = "delete-all"
+	template.Spec.Targets[0].SelectionMode = selectionMode
+	if selectionMode == "COUNT" {
+		count := int32(1)
+		template.Spec.Targets[0].Count = &count
+	}
+	template.Spec.Actions[0] = fisv1alpha1.ActionSpec{Name: "delete", Type: "pod-delete", Target: "nginx-pods", Duration: "1m"}
+	return template
+}
+
+func TestValidateScheduledPodDelete(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = fisv1alpha1.AddToScheme(scheme)
+
+	tests := []struct {
+		name          string
+		selectionMode string
+		schedule      string
+		annotations   map[string]string
+		wantErr       bool
+	}{
+		{name: "scheduled delete of all pods", selectionMode: "ALL", schedule: "0 2 * * *", wantErr: true},
+		{name: "scheduled delete with override", selectionMode: "ALL", schedule: "0 2 * * *",
+			annotations: map[string]string{AllowDestructiveScheduleAnnotation: "true"}},
+		{name: "one-time delete of all pods", selectionMode: "ALL"},
+		{name: "scheduled delete of some pods", selectionMode: "COUNT", schedule: "0 2 * * *"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := newPodDeleteTemplate(tt.selectionMode)
+			validator := &ExperimentValidator{Reader: fake.NewClientBuilder().WithScheme(scheme).WithObjects(template).Build()}
+			experiment := &fisv1alpha1.Experiment{
+				ObjectMeta: metav1.ObjectMeta{Name: "nightly-delete", Annotations: tt.annotations},
+				Spec: fisv1alpha1.ExperimentSpec{
+					ExperimentTemplate: fisv1alpha1.ExperimentTemplateRef{Name: template.Name},
+					Schedule:           tt.schedule,
+				},
+			}
+
+			errs := validator.Validate(context.Background(), experiment)
+			if gotErr := len(errs) > 0; gotErr != tt.wantErr {
+				t.Fatalf("Expected error=%t, got: %v", tt.wantErr, errs)
+			}
+			if tt.wantErr && errs[0].Field != "spec.schedule" {
+				t.Errorf("Expected error on spec.schedule, got: %s", errs[0].Field)
+			}
+		})
+	}
+}
@@ -0,0 +1,1038 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	utilvalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fisv1alpha1 "fis.dksshddl.dev/fis-controller/api/v1alpha1"
+	awsfis "fis.dksshddl.dev/fis-controller/internal/aws"
+)
+
+// AWS FIS quotas, see https://docs.aws.amazon.com/fis/latest/userguide/fis-quotas.html
+const (
+	// MaxTargetsPerTemplate is the maximum number of targets in an experiment template
+	MaxTargetsPerTemplate = 5
+
+	// MaxActionsPerTemplate is the maximum number of actions in an experiment template
+	MaxActionsPerTemplate = 20
+
+	// MaxStopConditionsPerTemplate is the maximum number of stop conditions in an experiment template
+	MaxStopConditionsPerTemplate = 5
+
+	// MaxActionDuration is the longest duration of any single action
+	MaxActionDuration = 12 * time.Hour
+)
+
+// Selection thresholds above which a target needs a higher declared blast radius
+const (
+	// MaxLowBlastRadiusCount is the largest COUNT selection allowed for a low blast radius
+	MaxLowBlastRadiusCount = 3
+
+	// MaxLowBlastRadiusPercent is the largest PERCENT selection allowed for a low blast radius
+	MaxLowBlastRadiusPercent = 10
+
+	// MaxMediumBlastRadiusCount is the largest COUNT selection allowed for a medium blast radius
+	MaxMediumBlastRadiusCount = 10
+
+	// MaxMediumBlastRadiusPercent is the largest PERCENT selection allowed for a medium blast radius
+	MaxMediumBlastRadiusPercent = 50
+)
+
+// blastRadiusRank orders the blast radius levels from least to most impact
+var blastRadiusRank = map[fisv1alpha1.BlastRadius]int{
+	fisv1alpha1.BlastRadiusLow:    0,
+	fisv1alpha1.BlastRadiusMedium: 1,
+	fisv1alpha1.BlastRadiusHigh:   2,
+}
+
+// durationBounds are the duration limits of an action type; a zero value means no limit
+type durationBounds struct {
+	min time.Duration
+	max time.Duration
+}
+
+// actionDurationBounds are the per-type duration limits, applied on top of MaxActionDuration
+var actionDurationBounds = map[string]durationBounds{
+	"pod-cpu-stress":          {min: time.Minute},
+	"pod-memory-stress":       {min: time.Minute},
+	"pod-io-stress":           {min: time.Minute},
+	"pod-network-latency":     {min: 10 * time.Second},
+	"pod-network-packet-loss": {min: 10 * time.Second},
+	"pod-network-bandwidth":   {min: 10 * time.Second},
+}
+
+// TemplateValidator validates ExperimentTemplate specs before they are sent to AWS FIS
+type TemplateValidator struct {
+	// StrictReportConfiguration rejects incomplete report configurations instead of warning
+	StrictReportConfiguration bool
+
+	// Reader is used to look up target namespaces and sample target pods; these checks are skipped when nil
+	Reader client.Reader
+
+	// AllowedMissingNamespaces lists target namespaces that may not exist yet, e.g. because they are created alongside the template
+	AllowedMissingNamespaces []string
+
+	// RequireStopConditionNamespaces lists namespaces whose templates must have a CloudWatch alarm stop condition
+	RequireStopConditionNamespaces []string
+
+	// StrictTargetContainers rejects targets whose container is missing from any matching pod
+	StrictTargetContainers bool
+
+	// Region is the AWS region experiments run in; log groups and buckets in other regions are rejected
+	// Region consistency is not checked when empty
+	Region string
+
+	// BucketRegions looks up the region of S3 buckets; buckets are not checked when nil
+	BucketRegions BucketRegionResolver
+}
+
+// BucketRegionResolver looks up the region of an S3 bucket
+type BucketRegionResolver interface {
+	BucketRegion(ctx context.Context, bucket string) (string, error)
+}
+
+// maxReportedPods caps the pod names listed in a strict target container error
+const maxReportedPods = 5
+
+// Validate returns warnings for settings that are accepted but likely wrong,
+// and field errors for settings that AWS FIS would reject or silently ignore
+func (v *TemplateValidator) Validate(ctx context.Context, template *fisv1alpha1.ExperimentTemplate) ([]string, field.ErrorList) {
+	var warnings []string
+	var errs field.ErrorList
+	specPath := field.NewPath("spec")
+
+	errs = append(errs, validateCounts(template, specPath)...)
+	errs = append(errs, validateActionReferences(template, specPath.Child("actions"))...)
+	errs = append(errs, validateActionSequence(template, specPath.Child("actionSequence"))...)
+	errs = append(errs, validateTargetResourceTypes(template, specPath)...)
+	errs = append(errs, validateStopConditionValues(template, specPath.Child("stopConditions"))...)
+	errs = append(errs, validateLabelSelectors(template, specPath.Child("targets"))...)
+	errs = append(errs, validateSelectionFields(template, specPath.Child("targets"))...)
+	errs = append(errs, v.validateTargetNamespaces(ctx, template, specPath.Child("targets"))...)
+	errs = append(errs, v.validateTargetContainers(ctx, template, specPath.Child("targets"))...)
+	errs = append(errs, v.validateStopConditions(template, specPath.Child("stopConditions"))...)
+	errs = append(errs, validateActionDurations(template, specPath.Child("actions"))...)
+	errs = append(errs, validateBlastRadius(template, specPath)...)
+	errs = append(errs, validateNetworkBandwidthActions(template.Spec.Actions, specPath.Child("actions"))...)
+	errs = append(errs, validateRequiredActionParameters(template.Spec.Actions, specPath.Child("actions"))...)
+	errs = append(errs, validateNetworkSources(template.Spec.Actions, specPath.Child("actions"))...)
+	errs = append(errs, validateTargetAccountConfigurations(template, specPath)...)
+	errs = append(errs, validateRolePolicyArns(template.Spec.RolePolicyArns, specPath.Child("rolePolicyArns"))...)
+	errs = append(errs, validateLogConfiguration(template.Spec.LogConfiguration, specPath.Child("logConfiguration"))...)
+	errs = append(errs, validateServiceAccountRoleArn(template.Spec.ServiceAccountRoleArn, specPath.Child("serviceAccountRoleArn"))...)
+
+	warnings = append(warnings, validateActionParameterKeys(template.Spec.Actions, specPath.Child("actions"))...)
+
+	w, e := v.validateIOStressActions(ctx, template, specPath.Child("actions"))
+	warnings = append(warnings, w...)
+	errs = append(errs, e...)
+
+	w, e = v.validateReportConfiguration(template.Spec.ExperimentReportConfiguration, specPath.Child("experimentReportConfiguration"))
+	warnings = append(warnings, w...)
+	errs = append(errs, e...)
+
+	w, e = v.validateRegionConsistency(ctx, template, specPath)
+	warnings = append(warnings, w...)
+	errs = append(errs, e...)
+
+	return warnings, errs
+}
+
+// validateCounts checks the number of targets and actions against the FIS quotas
+func validateCounts(template *fisv1alpha1.ExperimentTemplate, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	if len(template.Spec.Targets) > MaxTargetsPerTemplate {
+		errs = append(errs, field.TooMany(path.Child("targets"), len(template.Spec.Targets), MaxTargetsPerTemplate))
+	}
+	if len(template.Spec.Actions) > MaxActionsPerTemplate {
+		errs = append(errs, field.TooMany(path.Child("actions"), len(template.Spec.Actions), MaxActionsPerTemplate))
+	}
+	if len(template.Spec.StopConditions) > MaxStopConditionsPerTemplate {
+		errs = append(errs, field.TooMany(path.Child("stopConditions"), len(template.Spec.StopConditions), MaxStopConditionsPerTemplate))
+	}
+	return errs
+}
+
+// validateActionReferences checks that every action targets a declared target and that
+// startAfter only names declared actions without forming a cycle
+func validateActionReferences(template *fisv1alpha1.ExperimentTemplate, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	actions := make(map[string]int, len(template.Spec.Actions))
+	for i, action := range template.Spec.Actions {
+		actions[action.Name] = i
+	}
+
+	for i, action := range template.Spec.Actions {
+		if action.Target != "" && findTarget(template, action.Target) == nil {
+			errs = append(errs, field.NotFound(path.Index(i).Child("target"), action.Target))
+		}
+		for j, name := range action.StartAfter {
+			if _, ok := actions[name]; !ok {
+				errs = append(errs, field.NotFound(path.Index(i).Child("startAfter").Index(j), name))
+			}
+		}
+	}
+
+	// Cycles are checked with the ordering added by actionSequence
+	sequenced := template.DeepCopy()
+	sequenced.Spec.Actions = template.Spec.SequencedActions()
+	return append(errs, validateStartAfterCycles(sequenced, actions, path)...)
+}
+
+// validateActionSequence checks that actionSequence only names declared actions
+func validateActionSequence(template *fisv1alpha1.ExperimentTemplate, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for i, name := range template.Spec.ActionSequence {
+		if !slices.ContainsFunc(template.Spec.Actions, func(action fisv1alpha1.ActionSpec) bool { return action.Name == name }) {
+			errs = append(errs, field.NotFound(path.Index(i), name))
+		}
+	}
+	return errs
+}
+
+// validateTargetResourceTypes checks that pod targets have a namespace and label selector, that other
+// targets are selected by ARN or by tags, that only pod targets name a container, and that each action targets
+// the resource type it acts on
+func validateTargetResourceTypes(template *fisv1alpha1.ExperimentTemplate, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	targetsPath := path.Child("targets")
+	for i, target := range template.Spec.Targets {
+		arnsPath := targetsPath.Index(i).Child("resourceArns")
+		for j, resourceArn := range target.ResourceArns {
+			if _, err := arn.Parse(resourceArn); err != nil {
+				errs = append(errs, field.Invalid(arnsPath.Index(j), resourceArn, "must be a valid ARN"))
+			}
+		}
+
+		if isPodTarget(target) {
+			if len(target.ResourceArns) > 0 {
+				errs = append(errs, field.Forbidden(arnsPath, "resourceArns is not supported for aws:eks:pod targets, select pods by namespace and labelSelector"))
+				continue
+			}
+			if target.Namespace == "" {
+				errs = append(errs, field.Required(targetsPath.Index(i).Child("namespace"), "aws:eks:pod targets need a namespace"))
+			}
+			if len(target.LabelSelector) == 0 {
+				errs = append(errs, field.Required(targetsPath.Index(i).Child("labelSelector"), "aws:eks:pod targets need a label selector"))
+			}
+			continue
+		}
+
+		switch {
+		case len(target.ResourceArns) > 0 && len(target.LabelSelector) > 0:
+			errs = append(errs, field.Forbidden(arnsPath, "resourceArns and labelSelector are mutually exclusive"))
+		case len(target.ResourceArns) == 0 && len(target.LabelSelector) == 0:
+			errs = append(errs, field.Required(arnsPath, "non-pod targets need resourceArns or labelSelector tags"))
+		}
+		if target.TargetContainerName != "" {
+			errs = append(errs, field.Forbidden(targetsPath.Index(i).Child("targetContainerName"), "only aws:eks:pod targets select a container"))
+		}
+		if target.Container != "" {
+			errs = append(errs, field.Forbidden(targetsPath.Index(i).Child("container"), "only aws:eks:pod targets select a container"))
+		}
+	}
+
+	for i, action := range template.Spec.Actions {
+		target := findTarget(template, action.Target)
+		if target == nil {
+			continue
+		}
+		want, got := awsfis.ActionResourceType(action.Type), targetResourceType(*target)
+		if want == got {
+			continue
+		}
+		errs = append(errs, field.Invalid(path.Child("actions").Index(i).Child("target"), action.Target,
+			fmt.Sprintf("%s needs an %s target, not %s", action.Type, want, got)))
+	}
+	return errs
+}
+
+// isPodTarget reports whether the target selects pods, which is the default resource type
+func isPodTarget(target fisv1alpha1.TargetSpec) bool {
+	return targetResourceType(target) == fisv1alpha1.ResourceTypeEKSPod
+}
+
+// targetResourceType returns the resource type of the target, aws:eks:pod when unset
+func targetResourceType(target fisv1alpha1.TargetSpec) string {
+	if target.ResourceType == "" {
+		return fisv1alpha1.ResourceTypeEKSPod
+	}
+	return target.ResourceType
+}
+
+// validateStartAfterCycles reports each startAfter entry that closes a cycle of actions
+func validateStartAfterCycles(template *fisv1alpha1.ExperimentTemplate, actions map[string]int, path *field.Path) field.ErrorList {
+	const (
+		unvisited = iota
+		visiting
+		done
+	)
+
+	var errs field.ErrorList
+	state := make([]int, len(template.Spec.Actions))
+	var stack []string
+
+	var visit func(i int)
+	visit = func(i int) {
+		action := template.Spec.Actions[i]
+		state[i] = visiting
+		stack = append(stack, action.Name)
+		for j, name := range action.StartAfter {
+			next, ok := actions[name]
+			if !ok {
+				continue
+			}
+			switch state[next] {
+			case visiting:
+				cycle := append([]string{}, stack[slices.Index(stack, name):]...)
+				cycle = append(cycle, name)
+				errs = append(errs, field.Invalid(path.Index(i).Child("startAfter").Index(j), name,
+					fmt.Sprintf("startAfter forms a cycle: %s", strings.Join(cycle, " -> "))))
+			case unvisited:
+				visit(next)
+			}
+		}
+		stack = stack[:len(stack)-1]
+		state[i] = done
+	}
+
+	for i := range template.Spec.Actions {
+		if state[i] == unvisited {
+			visit(i)
+		}
+	}
+	return errs
+}
+
+// validateStopConditionValues requires an alarm ARN for every cloudwatch-alarm stop condition
+func validateStopConditionValues(template *fisv1alpha1.ExperimentTemplate, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for i, cond := range template.Spec.StopConditions {
+		if cond.Source == "cloudwatch-alarm" && cond.Value == "" {
+			errs = append(errs, field.Required(path.Index(i).Child("value"), "cloudwatch-alarm stop condition needs an alarm ARN"))
+		}
+	}
+	return errs
+}
+
+// validateSelectionFields rejects count and percent on targets that select ALL resources,
+// where the converter would otherwise silently ignore them
+func validateSelectionFields(template *fisv1alpha1.ExperimentTemplate, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for i, target := range template.Spec.Targets {
+		if !strings.EqualFold(target.SelectionMode, "ALL") {
+			continue
+		}
+		if target.Count != nil {
+			errs = append(errs, field.Forbidden(path.Index(i).Child("count"), "count cannot be set when selectionMode is ALL"))
+		}
+		if target.Percent != nil {
+			errs = append(errs, field.Forbidden(path.Index(i).Child("percent"), "percent cannot be set when selectionMode is ALL"))
+		}
+	}
+	return errs
+}
+
+// validateLabelSelectors checks every label selector key and value against the Kubernetes label syntax,
+// since the converter joins them into a single key=value selector string for FIS
+func validateLabelSelectors(template *fisv1alpha1.ExperimentTemplate, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for i, target := range template.Spec.Targets {
+		if !isPodTarget(target) {
+			continue
+		}
+		selectorPath := path.Index(i).Child("labelSelector")
+		keys := make([]string, 0, len(target.LabelSelector))
+		for key := range target.LabelSelector {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			for _, msg := range utilvalidation.IsQualifiedName(key) {
+				errs = append(errs, field.Invalid(selectorPath, key, msg))
+			}
+			value := target.LabelSelector[key]
+			for _, msg := range utilvalidation.IsValidLabelValue(value) {
+				errs = append(errs, field.Invalid(selectorPath.Key(key), value, msg))
+			}
+		}
+	}
+	return errs
+}
+
+// validateTargetNamespaces checks that every target namespace exists, so RBAC setup does not fail mid-reconcile
+// Lookup errors other than NotFound are ignored, the reconcile surfaces them when it creates the RBAC resources
+func (v *TemplateValidator) validateTargetNamespaces(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, path *field.Path) field.ErrorList {
+	if v.Reader == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	missing := make(map[string]bool)
+	for i, target := range template.Spec.Targets {
+		if !isPodTarget(target) || target.Namespace == "" || v.namespaceMayBeMissing(target.Namespace) {
+			continue
+		}
+
+		isMissing, checked := missing[target.Namespace]
+		if !checked {
+			err := v.Reader.Get(ctx, client.ObjectKey{Name: target.Namespace}, &corev1.Namespace{})
+			isMissing = apierrors.IsNotFound(err)
+			missing[target.Namespace] = isMissing
+		}
+		if isMissing {
+			errs = append(errs, field.NotFound(path.Index(i).Child("namespace"), target.Namespace))
+		}
+	}
+	return errs
+}
+
+// validateTargetContainers checks, with StrictTargetContainers, that every pod matching a target
+// has the targeted container, so the fault is injected the same way into each of them
+// Targets without a container name, or without matching pods yet, are not checked
+func (v *TemplateValidator) validateTargetContainers(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, path *field.Path) field.ErrorList {
+	if !v.StrictTargetContainers || v.Reader == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	for i, target := range template.Spec.Targets {
+		containerName, containerPath := target.TargetContainerName, path.Index(i).Child("targetContainerName")
+		if containerName == "" {
+			containerName, containerPath = target.Container, path.Index(i).Child("container")
+		}
+		if containerName == "" || !isPodTarget(target) {
+			continue
+		}
+
+		pods := &corev1.PodList{}
+		if err := v.Reader.List(ctx, pods, client.InNamespace(target.Namespace), client.MatchingLabels(target.LabelSelector)); err != nil {
+			continue
+		}
+
+		var missing []string
+		for _, pod := range pods.Items {
+			if !hasContainer(pod, containerName) {
+				missing = append(missing, pod.Name)
+			}
+		}
+		if len(missing) == 0 {
+			continue
+		}
+
+		sort.Strings(missing)
+		shown := missing
+		if len(shown) > maxReportedPods {
+			shown = shown[:maxReportedPods]
+		}
+		errs = append(errs, field.Invalid(containerPath, containerName,
+			fmt.Sprintf("container is missing from %d of %d matching pods in namespace %s: %s",
+				len(missing), len(pods.Items), target.Namespace, strings.Join(shown, ", "))))
+	}
+	return errs
+}
+
+// hasContainer reports whether the pod has a container with the given name
+func hasContainer(pod corev1.Pod, name string) bool {
+	for _, container := range pod.Spec.Containers {
+		if container.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// namespaceMayBeMissing reports whether the namespace is listed in AllowedMissingNamespaces
+func (v *TemplateValidator) namespaceMayBeMissing(namespace string) bool {
+	for _, ns := range v.AllowedMissingNamespaces {
+		if ns == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// validateActionDurations checks each action duration against MaxActionDuration and its type's bounds
+// Unparsable durations are left to the converter, which rejects them with a clearer message
+func validateActionDurations(template *fisv1alpha1.ExperimentTemplate, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for i, action := range template.Spec.Actions {
+		d, err := time.ParseDuration(action.Duration)
+		if err != nil {
+			continue
+		}
+
+		bounds := actionDurationBounds[action.Type]
+		maxDuration := MaxActionDuration
+		if bounds.max > 0 && bounds.max < maxDuration {
+			maxDuration = bounds.max
+		}
+
+		durationPath := path.Index(i).Child("duration")
+		switch {
+		case d < bounds.min:
+			errs = append(errs, field.Invalid(durationPath, action.Duration,
+				fmt.Sprintf("%s actions must run for at least %s", action.Type, bounds.min)))
+		case d > maxDuration:
+			errs = append(errs, field.Invalid(durationPath, action.Duration,
+				fmt.Sprintf("%s actions must not run for more than %s", action.Type, maxDuration)))
+		}
+	}
+	return errs
+}
+
+// validateBlastRadius checks that the declared blast radius covers the selection of every target
+func validateBlastRadius(template *fisv1alpha1.ExperimentTemplate, path *field.Path) field.ErrorList {
+	declared := template.Spec.BlastRadius
+	declaredRank, ok := blastRadiusRank[declared]
+	if !ok {
+		return nil
+	}
+
+	var errs field.ErrorList
+	for _, target := range template.Spec.Targets {
+		mode, value, ok := targetSelection(target)
+		if !ok {
+			continue
+		}
+		required := requiredBlastRadius(mode, value)
+		if blastRadiusRank[required] > declaredRank {
+			selection := mode
+			if mode != "ALL" {
+				selection = fmt.Sprintf("%s(%d)", mode, value)
+			}
+			errs = append(errs, field.Invalid(path.Child("blastRadius"), declared,
+				fmt.Sprintf("target %q selects %s, which requires blast radius %s", target.Name, selection, required)))
+		}
+	}
+	return errs
+}
+
+// requiredBlastRadius returns the lowest blast radius that covers a selection
+func requiredBlastRadius(mode string, value int) fisv1alpha1.BlastRadius {
+	switch {
+	case mode == "COUNT" && value <= MaxLowBlastRadiusCount,
+		mode == "PERCENT" && value <= MaxLowBlastRadiusPercent:
+		return fisv1alpha1.BlastRadiusLow
+	case mode == "COUNT" && value <= MaxMediumBlastRadiusCount,
+		mode == "PERCENT" && value <= MaxMediumBlastRadiusPercent:
+		return fisv1alpha1.BlastRadiusMedium
+	default:
+		return fisv1alpha1.BlastRadiusHigh
+	}
+}
+
+// targetSelection returns the selection mode (ALL, COUNT or PERCENT) and its value for a target,
+// following the same precedence as the converter; ok is false when the selection cannot be parsed
+func targetSelection(target fisv1alpha1.TargetSpec) (string, int, bool) {
+	switch strings.ToUpper(target.SelectionMode) {
+	case "ALL":
+		return "ALL", 0, true
+	case "COUNT":
+		if target.Count == nil {
+			return "", 0, false
+		}
+		return "COUNT", int(*target.Count), true
+	case "PERCENT":
+		if target.Percent == nil {
+			return "", 0, false
+		}
+		return "PERCENT", int(*target.Percent), true
+	case "":
+		// Fall back to the deprecated Scope below
+	default:
+		return "", 0, false
+	}
+
+	scope := strings.TrimSpace(target.Scope)
+	if scope == "" || strings.EqualFold(scope, "ALL") {
+		return "ALL", 0, true
+	}
+	mode := "COUNT"
+	if strings.HasSuffix(scope, "%") {
+		mode = "PERCENT"
+		scope = strings.TrimSuffix(scope, "%")
+	}
+	n, err := strconv.Atoi(scope)
+	if err != nil {
+		return "", 0, false
+	}
+	return mode, n, true
+}
+
+// validateStopConditions requires a CloudWatch alarm stop condition, and no "none" source,
+// when any target is in a namespace listed in RequireStopConditionNamespaces
+func (v *TemplateValidator) validateStopConditions(template *fisv1alpha1.ExperimentTemplate, path *field.Path) field.ErrorList {
+	namespace := v.requiredStopConditionNamespace(template)
+	if namespace == "" {
+		return nil
+	}
+
+	var errs field.ErrorList
+	hasAlarm := false
+	for i, cond := range template.Spec.StopConditions {
+		switch cond.Source {
+		case "cloudwatch-alarm":
+			if cond.Value == "" {
+				// Reported by validateStopConditionValues
+				continue
+			}
+			hasAlarm = true
+		case "none":
+			errs = append(errs, field.Invalid(path.Index(i).Child("source"), cond.Source,
+				fmt.Sprintf("stop condition source none is not allowed for templates targeting namespace %q", namespace)))
+		}
+	}
+	if !hasAlarm {
+		errs = append(errs, field.Required(path,
+			fmt.Sprintf("a cloudwatch-alarm stop condition is required for templates targeting namespace %q", namespace)))
+	}
+	return errs
+}
+
+// requiredStopConditionNamespace returns the first target namespace that requires stop conditions, or ""
+func (v *TemplateValidator) requiredStopConditionNamespace(template *fisv1alpha1.ExperimentTemplate) string {
+	for _, target := range template.Spec.Targets {
+		for _, ns := range v.RequireStopConditionNamespaces {
+			if target.Namespace == ns {
+				return ns
+			}
+		}
+	}
+	return ""
+}
+
+// validateIOStressActions checks the parameters of pod-io-stress actions and warns
+// when a sampled target container has no writable volume to stress
+func (v *TemplateValidator) validateIOStressActions(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, path *field.Path) ([]string, field.ErrorList) {
+	var warnings []string
+	var errs field.ErrorList
+
+	for i, action := range template.Spec.Actions {
+		if action.Type != "pod-io-stress" {
+			continue
+		}
+		paramsPath := path.Index(i).Child("parameters")
+
+		// A missing percent is reported by validateRequiredActionParameters
+		if percent, ok := action.Parameters["percent"]; ok {
+			if n, err := strconv.Atoi(percent); err != nil || n < 1 || n > 100 {
+				errs = append(errs, field.Invalid(paramsPath.Key("percent"), percent, "must be an integer between 1 and 100"))
+			}
+		}
+
+		if workers, ok := action.Parameters["workers"]; ok {
+			if n, err := strconv.Atoi(workers); err != nil || n < 1 {
+				errs = append(errs, field.Invalid(paramsPath.Key("workers"), workers, "must be a positive integer"))
+			}
+		}
+
+		if target := findTarget(template, action.Target); target != nil {
+			if warning := v.sampleWritableVolume(ctx, *target); warning != "" {
+				warnings = append(warnings, fmt.Sprintf("%s: %s", path.Index(i), warning))
+			}
+		}
+	}
+
+	return warnings, errs
+}
+
+// validateTargetAccountConfigurations checks that multi-account templates declare their target accounts
+// and that only multi-account templates do
+func validateTargetAccountConfigurations(template *fisv1alpha1.ExperimentTemplate, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	configsPath := path.Child("targetAccountConfigurations")
+	configs := template.Spec.TargetAccountConfigurations
+	multiAccount := template.Spec.ExperimentOptions != nil && template.Spec.ExperimentOptions.AccountTargeting == "multi-account"
+
+	switch {
+	case multiAccount && len(configs) == 0:
+		errs = append(errs, field.Required(configsPath, "multi-account experiments need at least one target account configuration"))
+	case !multiAccount && len(configs) > 0:
+		errs = append(errs, field.Forbidden(configsPath, "target account configurations need experimentOptions.accountTargeting multi-account"))
+	}
+
+	for i, config := range configs {
+		if _, err := arn.Parse(config.RoleArn); err != nil {
+			errs = append(errs, field.Invalid(configsPath.Index(i).Child("roleArn"), config.RoleArn, "must be a valid IAM role ARN"))
+		}
+	}
+	return errs
+}
+
+// commonActionParameters are accepted by every EKS pod action
+var commonActionParameters = []string{
+	"duration", "kubernetesServiceAccount", "fisPodContainerImage", "maxErrorsPercent",
+	"fisPodLabels", "fisPodAnnotations", "fisPodSecurityPolicy",
+}
+
+// actionParameters are the parameters specific to each action type
+var actionParameters = map[string][]string{
+	"pod-cpu-stress":          {"percent", "workers"},
+	"pod-memory-stress":       {"percent", "workers"},
+	"pod-io-stress":           {"percent", "workers"},
+	"pod-network-latency":     {"delayMilliseconds", "jitterMilliseconds", "sources", "interface"},
+	"pod-network-packet-loss": {"lossPercent", "sources", "interface"},
+	"pod-network-bandwidth":   {"networkBandwidth", "trafficType", "sources", "interface"},
+	"pod-delete":              {"gracePeriodSeconds"},
+
+	"ec2-stop-instances":            {"completeIfInstancesTerminated"},
+	"ec2-reboot-instances":          {},
+	"ec2-terminate-instances":       {},
+	"nodegroup-terminate-instances": {"instanceTerminationPercentage"},
+	"ecs-stop-task":                 {},
+}
+
+// validateActionParameterKeys warns about parameter keys that are unknown for the action type
+// AWS FIS ignores them, so a mistyped key silently turns the action into a no-op
+func validateActionParameterKeys(actions []fisv1alpha1.ActionSpec, path *field.Path) []string {
+	var warnings []string
+
+	for i, action := range actions {
+		known, ok := actionParameters[action.Type]
+		if !ok {
+			continue
+		}
+
+		keys := make([]string, 0, len(action.Parameters))
+		for key := range action.Parameters {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		common := commonActionParameters
+		if awsfis.ActionResourceType(action.Type) != fisv1alpha1.ResourceTypeEKSPod {
+			common = nil
+		}
+		for _, key := range keys {
+			if slices.Contains(known, key) || slices.Contains(common, key) {
+				continue
+			}
+			warnings = append(warnings, fmt.Sprintf("%s: unknown parameter for %s, AWS FIS ignores it (%s parameters: %s)",
+				path.Index(i).Child("parameters").Key(key), action.Type, action.Type, strings.Join(known, ", ")))
+		}
+	}
+
+	return warnings
+}
+
+// requiredParameterHints explain what a required action parameter sets
+var requiredParameterHints = map[string]string{
+	"percent":           "the percentage of free disk space to use",
+	"delayMilliseconds": "the latency to add in milliseconds",
+	"lossPercent":       "the percentage of packets to drop",
+	"networkBandwidth":  "the bandwidth limit in Mbit/s",
+
+	"instanceTerminationPercentage": "the percentage of node group instances to terminate",
+}
+
+// validateRequiredActionParameters checks that every action sets the parameters its type requires
+func validateRequiredActionParameters(actions []fisv1alpha1.ActionSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	for i, action := range actions {
+		for _, key := range awsfis.MissingActionParameters(action.Type, action.Parameters) {
+			errs = append(errs, field.Required(path.Index(i).Child("parameters").Key(key),
+				fmt.Sprintf("%s needs %s", action.Type, requiredParameterHints[key])))
+		}
+	}
+
+	return errs
+}
+
+// validateNetworkBandwidthActions checks the parameters of pod-network-bandwidth actions
+func validateNetworkBandwidthActions(actions []fisv1alpha1.ActionSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	for i, action := range actions {
+		if action.Type != "pod-network-bandwidth" {
+			continue
+		}
+		paramsPath := path.Index(i).Child("parameters")
+
+		// A missing networkBandwidth is reported by validateRequiredActionParameters
+		if bandwidth, ok := action.Parameters["networkBandwidth"]; ok {
+			if n, err := strconv.Atoi(bandwidth); err != nil || n < 1 {
+				errs = append(errs, field.Invalid(paramsPath.Key("networkBandwidth"), bandwidth, "must be a positive integer"))
+			}
+		}
+
+		if trafficType, ok := action.Parameters["trafficType"]; ok && trafficType != "ingress" && trafficType != "egress" {
+			errs = append(errs, field.NotSupported(paramsPath.Key("trafficType"), trafficType, []string{"ingress", "egress"}))
+		}
+	}
+
+	return errs
+}
+
+// networkSourceKeywords are the AWS services accepted by name in the sources parameter of network actions
+var networkSourceKeywords = []string{"DYNAMODB", "S3"}
+
+// validateNetworkSources checks that the sources parameter of network actions is a comma-separated list of
+// IPv4 addresses, IPv4 CIDR blocks, domain names or AWS service keywords
+func validateNetworkSources(actions []fisv1alpha1.ActionSpec, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+
+	for i, action := range actions {
+		sources, ok := action.Parameters["sources"]
+		if !ok || !slices.Contains(actionParameters[action.Type], "sources") {
+			continue
+		}
+		// Placeholders are resolved from spec.parameters only when the template is sent to AWS FIS
+		if strings.Contains(sources, "${") {
+			continue
+		}
+		sourcesPath := path.Index(i).Child("parameters").Key("sources")
+
+		for _, source := range strings.Split(sources, ",") {
+			source = strings.TrimSpace(source)
+			if msg := validateNetworkSource(source); msg != "" {
+				errs = append(errs, field.Invalid(sourcesPath, source, msg))
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateNetworkSource returns why a single sources entry is invalid, or "" when it is valid
+func validateNetworkSource(source string) string {
+	switch {
+	case source == "":
+		return "must not contain empty entries"
+	case slices.Contains(networkSourceKeywords, source):
+		return ""
+	case strings.Contains(source, "/"):
+		if ip, _, err := net.ParseCIDR(source); err != nil || ip.To4() == nil {
+			return "must be a valid IPv4 CIDR block"
+		}
+		return ""
+	}
+	if ip := net.ParseIP(source); ip != nil {
+		if ip.To4() == nil {
+			return "must be an IPv4 address"
+		}
+		return ""
+	}
+	if msgs := utilvalidation.IsDNS1123Subdomain(strings.ToLower(source)); len(msgs) > 0 {
+		return fmt.Sprintf("must be an IPv4 address, IPv4 CIDR block, domain name or one of %s",
+			strings.Join(networkSourceKeywords, ", "))
+	}
+	return ""
+}
+
+// sampleWritableVolume inspects one pod matching the target and returns a warning
+// when the targeted container has no writable volume mount
+func (v *TemplateValidator) sampleWritableVolume(ctx context.Context, target fisv1alpha1.TargetSpec) string {
+	if v.Reader == nil || !isPodTarget(target) {
+		return ""
+	}
+
+	pods := &corev1.PodList{}
+	if err := v.Reader.List(ctx, pods, client.InNamespace(target.Namespace), client.MatchingLabels(target.LabelSelector), client.Limit(1)); err != nil || len(pods.Items) == 0 {
+		return ""
+	}
+	pod := pods.Items[0]
+
+	containerName := target.TargetContainerName
+	if containerName == "" {
+		containerName = target.Container
+	}
+	for _, container := range pod.Spec.Containers {
+		if containerName != "" && container.Name != containerName {
+			continue
+		}
+		for _, mount := range container.VolumeMounts {
+			if !mount.ReadOnly {
+				return ""
+			}
+		}
+		return fmt.Sprintf("container %q in sampled pod %s/%s has no writable volume mount, pod-io-stress may have no effect",
+			container.Name, pod.Namespace, pod.Name)
+	}
+	return ""
+}
+
+// findTarget returns the target with the given name, or nil
+func findTarget(template *fisv1alpha1.ExperimentTemplate, name string) *fisv1alpha1.TargetSpec {
+	for i := range template.Spec.Targets {
+		if template.Spec.Targets[i].Name == name {
+			return &template.Spec.Targets[i]
+		}
+	}
+	return nil
+}
+
+// validateReportConfiguration checks that a report configuration has something to report on and somewhere to store it
+func (v *TemplateValidator) validateReportConfiguration(cfg *fisv1alpha1.ExperimentReportConfiguration, path *field.Path) ([]string, field.ErrorList) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	var problems []*field.Error
+	if cfg.DataSources == nil || len(cfg.DataSources.CloudWatchDashboards) == 0 {
+		problems = append(problems, field.Required(path.Child("dataSources", "cloudWatchDashboards"),
+			"report configuration has no data sources, the generated report will be empty"))
+	}
+	if cfg.Outputs == nil || cfg.Outputs.S3Configuration == nil {
+		problems = append(problems, field.Required(path.Child("outputs", "s3Configuration"),
+			"report configuration has no outputs, the generated report will not be stored"))
+	}
+
+	if v.StrictReportConfiguration {
+		return nil, problems
+	}
+
+	var warnings []string
+	for _, p := range problems {
+		warnings = append(warnings, p.Error())
+	}
+	return warnings, nil
+}
+
+// validateRolePolicyArns checks that the managed policies for the auto-created role are IAM policy ARNs
+func validateRolePolicyArns(policyArns []string, path *field.Path) field.ErrorList {
+	var errs field.ErrorList
+	for i, policyArn := range policyArns {
+		parsed, err := arn.Parse(policyArn)
+		if err != nil || parsed.Service != "iam" || !strings.HasPrefix(parsed.Resource, "policy/") {
+			errs = append(errs, field.Invalid(path.Index(i), policyArn, "must be a valid IAM policy ARN"))
+		}
+	}
+	return errs
+}
+
+// validateServiceAccountRoleArn checks that the IRSA role of the template's ServiceAccount is an IAM role ARN
+func validateServiceAccountRoleArn(roleArn string, path *field.Path) field.ErrorList {
+	if roleArn == "" {
+		return nil
+	}
+	parsed, err := arn.Parse(roleArn)
+	if err != nil || parsed.Service != "iam" || !strings.HasPrefix(parsed.Resource, "role/") {
+		return field.ErrorList{field.Invalid(path, roleArn, "must be a valid IAM role ARN")}
+	}
+	return nil
+}
+
+// Log destinations of an experiment template, named after their spec fields
+const (
+	logDestinationCloudWatchLogs = "cloudWatchLogsConfiguration"
+	logDestinationS3             = "s3Configuration"
+)
+
+// defaultLogSchemaVersion is the schema version the API server defaults logConfiguration.logSchemaVersion to
+const defaultLogSchemaVersion = 2
+
+// supportedLogSchemaVersions are the log schema versions AWS FIS accepts; both support every destination
+var supportedLogSchemaVersions = []int{1, 2}
+
+// validateLogConfiguration checks that the log schema version is supported by AWS FIS and that at least
+// one destination is configured
+func validateLogConfiguration(logConfig *fisv1alpha1.LogConfiguration, path *field.Path) field.ErrorList {
+	if logConfig == nil {
+		return nil
+	}
+	var errs field.ErrorList
+
+	if logConfig.CloudWatchLogsConfiguration == nil && logConfig.S3Configuration == nil {
+		errs = append(errs, field.Required(path, fmt.Sprintf("at least one of %s or %s is required",
+			logDestinationCloudWatchLogs, logDestinationS3)))
+	}
+
+	version := logConfig.LogSchemaVersion
+	if version == 0 {
+		version = defaultLogSchemaVersion
+	}
+	if !slices.Contains(supportedLogSchemaVersions, version) {
+		versions := make([]string, 0, len(supportedLogSchemaVersions))
+		for _, v := range supportedLogSchemaVersions {
+			versions = append(versions, strconv.Itoa(v))
+		}
+		errs = append(errs, field.NotSupported(path.Child("logSchemaVersion"), version, versions))
+	}
+	return errs
+}
+
+// validateRegionConsistency checks that the log group and the S3 buckets of a template are in the experiment's region
+// All mismatches are reported in a single error; buckets whose region cannot be looked up only produce a warning
+func (v *TemplateValidator) validateRegionConsistency(ctx context.Context, template *fisv1alpha1.ExperimentTemplate, path *field.Path) ([]string, field.ErrorList) {
+	if v.Region == "" {
+		return nil, nil
+	}
+
+	var warnings, mismatches []string
+	buckets := make(map[string]string)
+	if logConfig := template.Spec.LogConfiguration; logConfig != nil {
+		logPath := path.Child("logConfiguration")
+		if cw := logConfig.CloudWatchLogsConfiguration; cw != nil {
+			if parsed, err := arn.Parse(cw.LogGroupArn); err == nil && parsed.Region != "" && parsed.Region != v.Region {
+				mismatches = append(mismatches, fmt.Sprintf("%s is in %s",
+					logPath.Child("cloudWatchLogsConfiguration", "logGroupArn"), parsed.Region))
+			}
+		}
+		if s3 := logConfig.S3Configuration; s3 != nil && s3.BucketName != "" {
+			buckets[logPath.Child("s3Configuration", "bucketName").String()] = s3.BucketName
+		}
+	}
+	if report := template.Spec.ExperimentReportConfiguration; report != nil && report.Outputs != nil {
+		if s3 := report.Outputs.S3Configuration; s3 != nil && s3.BucketName != "" {
+			buckets[path.Child("experimentReportConfiguration", "outputs", "s3Configuration", "bucketName").String()] = s3.BucketName
+		}
+	}
+
+	if v.BucketRegions != nil {
+		bucketPaths := make([]string, 0, len(buckets))
+		for bucketPath := range buckets {
+			bucketPaths = append(bucketPaths, bucketPath)
+		}
+		sort.Strings(bucketPaths)
+
+		for _, bucketPath := range bucketPaths {
+			bucket := buckets[bucketPath]
+			region, err := v.BucketRegions.BucketRegion(ctx, bucket)
+			if err != nil {
+				warnings = append(warnings, fmt.Sprintf("%s: could not look up the region of bucket %s: %v", bucketPath, bucket, err))
+				continue
+			}
+			if region != v.Region {
+				mismatches = append(mismatches, fmt.Sprintf("%s bucket %s is in %s", bucketPath, bucket, region))
+			}
+		}
+	}
+
+	if len(mismatches) == 0 {
+		return warnings, nil
+	}
+	return warnings, field.ErrorList{field.Forbidden(path, fmt.Sprintf("log groups and buckets must be in the experiment region %s: %s",
+		v.Region, strings.Join(mismatches, "; ")))}
+}
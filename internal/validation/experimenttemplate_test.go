@@ -0,0 +1,1026 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fisv1alpha1 "fis.dksshddl.dev/fis-controller/api/v1alpha1"
+)
+
+// newTemplate returns a minimal valid ExperimentTemplate
+func newTemplate() *fisv1alpha1.ExperimentTemplate {
+	return &fisv1alpha1.ExperimentTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-template"},
+		Spec: fisv1alpha1.ExperimentTemplateSpec{
+			Targets: []fisv1alpha1.TargetSpec{
+				{
+					Name:          "nginx-pods",
+					Namespace:     "default",
+					LabelSelector: map[string]string{"app": "nginx"},
+				},
+			},
+			Actions: []fisv1alpha1.ActionSpec{
+				{
+					Name:     "cpu-stress",
+					Type:     "pod-cpu-stress",
+					Duration: "5m",
+					Target:   "nginx-pods",
+				},
+			},
+		},
+	}
+}
+
+func TestValidateMinimalTemplate(t *testing.T) {
+	validator := &TemplateValidator{}
+
+	warnings, errs := validator.Validate(context.Background(), newTemplate())
+	if len(errs) != 0 {
+		t.Errorf("Expected no errors, got: %v", errs)
+	}
+	if len(warnings) != 0 {
+		t.Errorf("Expected no warnings, got: %v", warnings)
+	}
+}
+
+func TestValidateEmptyReportConfiguration(t *testing.T) {
+	template := newTemplate()
+	template.Spec.ExperimentReportConfiguration = &fisv1alpha1.ExperimentReportConfiguration{
+		PreExperimentDuration:  "20m",
+		PostExperimentDuration: "20m",
+	}
+
+	// Default mode only warns
+	warnings, errs := (&TemplateValidator{}).Validate(context.Background(), template)
+	if len(errs) != 0 {
+		t.Errorf("Expected no errors in default mode, got: %v", errs)
+	}
+	if len(warnings) != 2 {
+		t.Errorf("Expected 2 warnings for missing data sources and outputs, got: %v", warnings)
+	}
+
+	// Strict mode rejects
+	warnings, errs = (&TemplateValidator{StrictReportConfiguration: true}).Validate(context.Background(), template)
+	if len(warnings) != 0 {
+		t.Errorf("Expected no warnings in strict mode, got: %v", warnings)
+	}
+	if len(errs) != 2 {
+		t.Fatalf("Expected 2 errors in strict mode, got: %v", errs)
+	}
+	if errs[0].Field != "spec.experimentReportConfiguration.dataSources.cloudWatchDashboards" {
+		t.Errorf("Unexpected field path: %s", errs[0].Field)
+	}
+	if errs[1].Field != "spec.experimentReportConfiguration.outputs.s3Configuration" {
+		t.Errorf("Unexpected field path: %s", errs[1].Field)
+	}
+}
+
+func TestValidateCompleteReportConfiguration(t *testing.T) {
+	template := newTemplate()
+	template.Spec.ExperimentReportConfiguration = &fisv1alpha1.ExperimentReportConfiguration{
+		DataSources: &fisv1alpha1.ReportDataSources{
+			CloudWatchDashboards: []fisv1alpha1.CloudWatchDashboard{
+				{DashboardIdentifier: "arn:aws:cloudwatch::123456789012:dashboard/MyDashboard"},
+			},
+		},
+		Outputs: &fisv1alpha1.ReportOutputs{
+			S3Configuration: &fisv1alpha1.S3Configuration{BucketName: "my-fis-reports"},
+		},
+	}
+
+	warnings, errs := (&TemplateValidator{StrictReportConfiguration: true}).Validate(context.Background(), template)
+	if len(errs) != 0 || len(warnings) != 0 {
+		t.Errorf("Expected complete report configuration to pass, got warnings=%v errors=%v", warnings, errs)
+	}
+}
+
+// withCounts returns a template with the given number of targets and actions
+func withCounts(targets, actions int) *fisv1alpha1.ExperimentTemplate {
+	template := newTemplate()
+	template.Spec.Targets = nil
+	template.Spec.Actions = nil
+	for i := 0; i < targets; i++ {
+		template.Spec.Targets = append(template.Spec.Targets, fisv1alpha1.TargetSpec{
+			Name:          fmt.Sprintf("target-%d", i),
+			Namespace:     "default",
+			LabelSelector: map[string]string{"app": "nginx"},
+		})
+	}
+	for i := 0; i < actions; i++ {
+		template.Spec.Actions = append(template.Spec.Actions, fisv1alpha1.ActionSpec{
+			Name:     fmt.Sprintf("action-%d", i),
+			Type:     "pod-cpu-stress",
+			Duration: "5m",
+			Target:   "target-0",
+		})
+	}
+	return template
+}
+
+func TestValidateCountLimits(t *testing.T) {
+	tests := []struct {
+		name       string
+		targets    int
+		actions    int
+		wantFields []string
+	}{
+		{name: "at limits", targets: MaxTargetsPerTemplate, actions: MaxActionsPerTemplate},
+		{name: "too many targets", targets: MaxTargetsPerTemplate + 1, actions: 1, wantFields: []string{"spec.targets"}},
+		{name: "too many actions", targets: 1, actions: MaxActionsPerTemplate + 1, wantFields: []string{"spec.actions"}},
+		{name: "both over", targets: MaxTargetsPerTemplate + 1, actions: MaxActionsPerTemplate + 1, wantFields: []string{"spec.targets", "spec.actions"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errs := (&TemplateValidator{}).Validate(context.Background(), withCounts(tt.targets, tt.actions))
+			if len(errs) != len(tt.wantFields) {
+				t.Fatalf("Expected %d errors, got: %v", len(tt.wantFields), errs)
+			}
+			for i, want := range tt.wantFields {
+				if errs[i].Field != want {
+					t.Errorf("Expected error on %s, got: %s", want, errs[i].Field)
+				}
+			}
+		})
+	}
+}
+
+// newIOStressTemplate returns a template with a single pod-io-stress action using the given parameters
+func newIOStressTemplate(params map[string]string) *fisv1alpha1.ExperimentTemplate {
+	template := newTemplate()
+	template.Spec.Actions[0].Type = "pod-io-stress"
+	template.Spec.Actions[0].Parameters = params
+	return template
+}
+
+func TestValidateIOStressParameters(t *testing.T) {
+	tests := []struct {
+		name       string
+		params     map[string]string
+		wantFields []string
+	}{
+		{name: "valid", params: map[string]string{"percent": "80", "workers": "2"}},
+		{name: "workers optional", params: map[string]string{"percent": "80"}},
+		{name: "missing percent", params: map[string]string{"workers": "2"}, wantFields: []string{"spec.actions[0].parameters[percent]"}},
+		{name: "no parameters", params: nil, wantFields: []string{"spec.actions[0].parameters[percent]"}},
+		{name: "percent out of range", params: map[string]string{"percent": "150"}, wantFields: []string{"spec.actions[0].parameters[percent]"}},
+		{name: "invalid workers", params: map[string]string{"percent": "80", "workers": "zero"}, wantFields: []string{"spec.actions[0].parameters[workers]"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errs := (&TemplateValidator{}).Validate(context.Background(), newIOStressTemplate(tt.params))
+			if len(errs) != len(tt.wantFields) {
+				t.Fatalf("Expected %d errors, got: %v", len(tt.wantFields), errs)
+			}
+			for i, want := range tt.wantFields {
+				if errs[i].Field != want {
+					t.Errorf("Expected error on %s, got: %s", want, errs[i].Field)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateNetworkBandwidthParameters(t *testing.T) {
+	tests := []struct {
+		name       string
+		params     map[string]string
+		wantFields []string
+	}{
+		{name: "valid", params: map[string]string{"networkBandwidth": "10", "trafficType": "ingress"}},
+		{name: "trafficType optional", params: map[string]string{"networkBandwidth": "10"}},
+		{name: "missing bandwidth", params: map[string]string{"trafficType": "egress"}, wantFields: []string{"spec.actions[0].parameters[networkBandwidth]"}},
+		{name: "invalid bandwidth", params: map[string]string{"networkBandwidth": "0"}, wantFields: []string{"spec.actions[0].parameters[networkBandwidth]"}},
+		{name: "unsupported trafficType", params: map[string]string{"networkBandwidth": "10", "trafficType": "both"}, wantFields: []string{"spec.actions[0].parameters[trafficType]"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := newTemplate()
+			template.Spec.Actions[0].Type = "pod-network-bandwidth"
+			template.Spec.Actions[0].Parameters = tt.params
+
+			_, errs := (&TemplateValidator{}).Validate(context.Background(), template)
+			if len(errs) != len(tt.wantFields) {
+				t.Fatalf("Expected %d errors, got: %v", len(tt.wantFields), errs)
+			}
+			for i, want := range tt.wantFields {
+				if errs[i].Field != want {
+					t.Errorf("Expected error on %s, got: %s", want, errs[i].Field)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateRequiredActionParameters(t *testing.T) {
+	tests := []struct {
+		actionType string
+		params     map[string]string
+		wantFields []string
+	}{
+		{actionType: "pod-cpu-stress"},
+		{actionType: "pod-memory-stress"},
+		{actionType: "pod-io-stress", wantFields: []string{"spec.actions[0].parameters[percent]"}},
+		{actionType: "pod-network-latency", wantFields: []string{"spec.actions[0].parameters[delayMilliseconds]"}},
+		{actionType: "pod-network-latency", params: map[string]string{"delayMilliseconds": "200"}},
+		{actionType: "pod-network-packet-loss", wantFields: []string{"spec.actions[0].parameters[lossPercent]"}},
+		{actionType: "pod-network-packet-loss", params: map[string]string{"lossPercent": "10"}},
+		{actionType: "pod-network-bandwidth", wantFields: []string{"spec.actions[0].parameters[networkBandwidth]"}},
+		{actionType: "pod-delete"},
+	}
+
+	for _, tt := range tests {
+		template := newTemplate()
+		template.Spec.Actions[0].Type = tt.actionType
+		template.Spec.Actions[0].Parameters = tt.params
+
+		_, errs := (&TemplateValidator{}).Validate(context.Background(), template)
+		if len(errs) != len(tt.wantFields) {
+			t.Errorf("%s %v: expected %d errors, got: %v", tt.actionType, tt.params, len(tt.wantFields), errs)
+			continue
+		}
+		for i, want := range tt.wantFields {
+			if errs[i].Field != want || errs[i].Type != field.ErrorTypeRequired {
+				t.Errorf("%s: expected required error on %s, got: %v", tt.actionType, want, errs[i])
+			}
+		}
+	}
+}
+
+func TestValidateNetworkSources(t *testing.T) {
+	tests := []struct {
+		name       string
+		sources    string
+		wantErrors int
+	}{
+		{name: "CIDR blocks", sources: "10.0.0.0/16,192.168.1.0/24"},
+		{name: "IPv4 address", sources: "10.0.0.12"},
+		{name: "hostnames", sources: "api.example.com, Payments.Internal"},
+		{name: "service keywords", sources: "S3,DYNAMODB"},
+		{name: "placeholder", sources: "${sources}"},
+		{name: "invalid CIDR", sources: "10.0.0.0/33", wantErrors: 1},
+		{name: "IPv6 CIDR", sources: "2001:db8::/32", wantErrors: 1},
+		{name: "invalid hostname", sources: "api_example.com", wantErrors: 1},
+		{name: "empty entry", sources: "10.0.0.0/16,,api.example.com", wantErrors: 1},
+		{name: "mixed", sources: "10.0.0.0/16,not a host,-bad.example.com", wantErrors: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := newTemplate()
+			template.Spec.Actions[0].Type = "pod-network-latency"
+			template.Spec.Actions[0].Parameters = map[string]string{"delayMilliseconds": "200", "sources": tt.sources}
+
+			_, errs := (&TemplateValidator{}).Validate(context.Background(), template)
+			if len(errs) != tt.wantErrors {
+				t.Fatalf("Expected %d errors, got: %v", tt.wantErrors, errs)
+			}
+			for _, err := range errs {
+				if err.Field != "spec.actions[0].parameters[sources]" {
+					t.Errorf("Expected error on spec.actions[0].parameters[sources], got: %s", err.Field)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateTargetResourceTypes(t *testing.T) {
+	tests := []struct {
+		name       string
+		mutate     func(*fisv1alpha1.ExperimentTemplate)
+		wantFields []string
+		// wantDetail is expected in the detail of the first error
+		wantDetail string
+	}{
+		{name: "pod target", mutate: func(*fisv1alpha1.ExperimentTemplate) {}},
+		{
+			name: "pod target without namespace or selector",
+			mutate: func(tmpl *fisv1alpha1.ExperimentTemplate) {
+				tmpl.Spec.Targets[0].Namespace = ""
+				tmpl.Spec.Targets[0].LabelSelector = nil
+			},
+			wantFields: []string{"spec.targets[0].namespace", "spec.targets[0].labelSelector"},
+		},
+		{
+			name: "pod action on EC2 target",
+			mutate: func(tmpl *fisv1alpha1.ExperimentTemplate) {
+				tmpl.Spec.Targets[0].ResourceType = fisv1alpha1.ResourceTypeEC2Instance
+				tmpl.Spec.Targets[0].Namespace = ""
+			},
+			wantFields: []string{"spec.actions[0].target"},
+		},
+		{
+			name: "EC2 action on EC2 target",
+			mutate: func(tmpl *fisv1alpha1.ExperimentTemplate) {
+				tmpl.Spec.Targets[0].ResourceType = fisv1alpha1.ResourceTypeEC2Instance
+				tmpl.Spec.Targets[0].Namespace = ""
+				tmpl.Spec.Actions[0].Type = "ec2-stop-instances"
+			},
+		},
+		{
+			name: "EC2 action on pod target",
+			mutate: func(tmpl *fisv1alpha1.ExperimentTemplate) {
+				tmpl.Spec.Actions[0].Type = "ec2-stop-instances"
+			},
+			wantFields: []string{"spec.actions[0].target"},
+		},
+		{
+			name: "node group action on EC2 target",
+			mutate: func(tmpl *fisv1alpha1.ExperimentTemplate) {
+				tmpl.Spec.Targets[0].ResourceType = fisv1alpha1.ResourceTypeEC2Instance
+				tmpl.Spec.Targets[0].Namespace = ""
+				tmpl.Spec.Actions[0].Type = "nodegroup-terminate-instances"
+			},
+			wantFields: []string{"spec.actions[0].target"},
+		},
+		{
+			name: "container on EC2 target",
+			mutate: func(tmpl *fisv1alpha1.ExperimentTemplate) {
+				tmpl.Spec.Targets[0].ResourceType = fisv1alpha1.ResourceTypeEC2Instance
+				tmpl.Spec.Targets[0].TargetContainerName = "nginx"
+				tmpl.Spec.Actions = nil
+			},
+			wantFields: []string{"spec.targets[0].targetContainerName"},
+		},
+		{
+			name: "EC2 target by ARN",
+			mutate: func(tmpl *fisv1alpha1.ExperimentTemplate) {
+				tmpl.Spec.Targets[0] = fisv1alpha1.TargetSpec{
+					Name:         "instances",
+					ResourceType: fisv1alpha1.ResourceTypeEC2Instance,
+					ResourceArns: []string{"arn:aws:ec2:ap-northeast-2:123456789012:instance/i-0123456789abcdef0"},
+				}
+				tmpl.Spec.Actions = nil
+			},
+		},
+		{
+			name: "ARNs and label selector",
+			mutate: func(tmpl *fisv1alpha1.ExperimentTemplate) {
+				tmpl.Spec.Targets[0].ResourceType = fisv1alpha1.ResourceTypeEC2Instance
+				tmpl.Spec.Targets[0].ResourceArns = []string{"arn:aws:ec2:ap-northeast-2:123456789012:instance/i-0123456789abcdef0"}
+				tmpl.Spec.Actions = nil
+			},
+			wantFields: []string{"spec.targets[0].resourceArns"},
+		},
+		{
+			name: "invalid ARN",
+			mutate: func(tmpl *fisv1alpha1.ExperimentTemplate) {
+				tmpl.Spec.Targets[0].ResourceType = fisv1alpha1.ResourceTypeEC2Instance
+				tmpl.Spec.Targets[0].LabelSelector = nil
+				tmpl.Spec.Targets[0].ResourceArns = []string{"i-0123456789abcdef0"}
+				tmpl.Spec.Actions = nil
+			},
+			wantFields: []string{"spec.targets[0].resourceArns[0]"},
+		},
+		{
+			name: "non-pod target without selector",
+			mutate: func(tmpl *fisv1alpha1.ExperimentTemplate) {
+				tmpl.Spec.Targets[0].ResourceType = fisv1alpha1.ResourceTypeEC2Instance
+				tmpl.Spec.Targets[0].LabelSelector = nil
+				tmpl.Spec.Actions = nil
+			},
+			wantFields: []string{"spec.targets[0].resourceArns"},
+		},
+		{
+			name: "ARNs on pod target",
+			mutate: func(tmpl *fisv1alpha1.ExperimentTemplate) {
+				tmpl.Spec.Targets[0].ResourceArns = []string{"arn:aws:ec2:ap-northeast-2:123456789012:instance/i-0123456789abcdef0"}
+			},
+			wantFields: []string{"spec.targets[0].resourceArns"},
+		},
+		{
+			name: "ARNs instead of selector on pod target",
+			mutate: func(tmpl *fisv1alpha1.ExperimentTemplate) {
+				tmpl.Spec.Targets[0].LabelSelector = nil
+				tmpl.Spec.Targets[0].ResourceArns = []string{"arn:aws:ec2:ap-northeast-2:123456789012:instance/i-0123456789abcdef0"}
+			},
+			wantFields: []string{"spec.targets[0].resourceArns"},
+			wantDetail: "resourceArns is not supported for aws:eks:pod targets",
+		},
+		{
+			name: "EC2 action on EC2 target by ARN",
+			mutate: func(tmpl *fisv1alpha1.ExperimentTemplate) {
+				tmpl.Spec.Targets[0] = fisv1alpha1.TargetSpec{
+					Name:         "nginx-pods",
+					ResourceType: fisv1alpha1.ResourceTypeEC2Instance,
+					ResourceArns: []string{"arn:aws:ec2:ap-northeast-2:123456789012:instance/i-0123456789abcdef0"},
+				}
+				tmpl.Spec.Actions[0].Type = "ec2-reboot-instances"
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := newTemplate()
+			tt.mutate(template)
+
+			errs := validateTargetResourceTypes(template, field.NewPath("spec"))
+			if len(errs) != len(tt.wantFields) {
+				t.Fatalf("Expected %d errors, got: %v", len(tt.wantFields), errs)
+			}
+			for i, want := range tt.wantFields {
+				if errs[i].Field != want {
+					t.Errorf("Expected error on %s, got: %s", want, errs[i].Field)
+				}
+			}
+			if tt.wantDetail != "" && !strings.Contains(errs[0].Detail, tt.wantDetail) {
+				t.Errorf("Expected error detail %q, got: %q", tt.wantDetail, errs[0].Detail)
+			}
+		})
+	}
+}
+
+func TestValidateRolePolicyArns(t *testing.T) {
+	template := newTemplate()
+	template.Spec.RolePolicyArns = []string{
+		"arn:aws:iam::aws:policy/AWSFaultInjectionSimulatorEKSAccess",
+		"arn:aws:iam::123456789012:policy/fis-shared",
+		"arn:aws:iam::123456789012:role/fis-role",
+		"fis-shared",
+	}
+
+	_, errs := (&TemplateValidator{}).Validate(context.Background(), template)
+	if len(errs) != 2 {
+		t.Fatalf("Expected 2 errors, got: %v", errs)
+	}
+	for i, want := range []string{"spec.rolePolicyArns[2]", "spec.rolePolicyArns[3]"} {
+		if errs[i].Field != want {
+			t.Errorf("Expected error on %s, got: %s", want, errs[i].Field)
+		}
+	}
+}
+
+func TestValidateServiceAccountRoleArn(t *testing.T) {
+	template := newTemplate()
+	template.Spec.ServiceAccountRoleArn = "arn:aws:iam::123456789012:policy/fis-shared"
+
+	_, errs := (&TemplateValidator{}).Validate(context.Background(), template)
+	if len(errs) != 1 || errs[0].Field != "spec.serviceAccountRoleArn" {
+		t.Fatalf("Expected 1 error on spec.serviceAccountRoleArn, got: %v", errs)
+	}
+
+	template.Spec.ServiceAccountRoleArn = "arn:aws:iam::123456789012:role/fis-pods"
+	if _, errs := (&TemplateValidator{}).Validate(context.Background(), template); len(errs) != 0 {
+		t.Errorf("Expected no errors for a role ARN, got: %v", errs)
+	}
+}
+
+func TestValidateLogConfiguration(t *testing.T) {
+	cloudWatch := &fisv1alpha1.CloudWatchLogsConfiguration{LogGroupArn: "arn:aws:logs:ap-northeast-2:123456789012:log-group:fis"}
+	s3 := &fisv1alpha1.S3Configuration{BucketName: "fis-logs"}
+
+	tests := []struct {
+		name       string
+		config     fisv1alpha1.LogConfiguration
+		wantFields []string
+	}{
+		{name: "version 1 with CloudWatch Logs", config: fisv1alpha1.LogConfiguration{LogSchemaVersion: 1, CloudWatchLogsConfiguration: cloudWatch}},
+		{name: "version 1 with S3", config: fisv1alpha1.LogConfiguration{LogSchemaVersion: 1, S3Configuration: s3}},
+		{name: "version 2 with both destinations", config: fisv1alpha1.LogConfiguration{LogSchemaVersion: 2, CloudWatchLogsConfiguration: cloudWatch, S3Configuration: s3}},
+		{name: "defaulted version with S3", config: fisv1alpha1.LogConfiguration{S3Configuration: s3}},
+		{name: "unsupported version", config: fisv1alpha1.LogConfiguration{LogSchemaVersion: 3, S3Configuration: s3}, wantFields: []string{"spec.logConfiguration.logSchemaVersion"}},
+		{name: "no destination", config: fisv1alpha1.LogConfiguration{LogSchemaVersion: 2}, wantFields: []string{"spec.logConfiguration"}},
+		{
+			name:       "unsupported version without destination",
+			config:     fisv1alpha1.LogConfiguration{LogSchemaVersion: 3},
+			wantFields: []string{"spec.logConfiguration", "spec.logConfiguration.logSchemaVersion"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := newTemplate()
+			template.Spec.LogConfiguration = &tt.config
+
+			_, errs := (&TemplateValidator{}).Validate(context.Background(), template)
+			if len(errs) != len(tt.wantFields) {
+				t.Fatalf("Expected %d errors, got: %v", len(tt.wantFields), errs)
+			}
+			for i, want := range tt.wantFields {
+				if errs[i].Field != want {
+					t.Errorf("Expected error on %s, got: %s", want, errs[i].Field)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateTargetAccountConfigurations(t *testing.T) {
+	multiAccount := &fisv1alpha1.ExperimentOptions{AccountTargeting: "multi-account"}
+	config := fisv1alpha1.TargetAccountConfiguration{AccountID: "111111111111", RoleArn: "arn:aws:iam::111111111111:role/fis-target"}
+
+	tests := []struct {
+		name       string
+		options    *fisv1alpha1.ExperimentOptions
+		configs    []fisv1alpha1.TargetAccountConfiguration
+		wantFields []string
+	}{
+		{name: "single account"},
+		{name: "multi-account with config", options: multiAccount, configs: []fisv1alpha1.TargetAccountConfiguration{config}},
+		{name: "multi-account without config", options: multiAccount, wantFields: []string{"spec.targetAccountConfigurations"}},
+		{name: "config without multi-account", configs: []fisv1alpha1.TargetAccountConfiguration{config}, wantFields: []string{"spec.targetAccountConfigurations"}},
+		{
+			name:       "invalid role ARN",
+			options:    multiAccount,
+			configs:    []fisv1alpha1.TargetAccountConfiguration{{AccountID: "111111111111", RoleArn: "fis-target-role-name"}},
+			wantFields: []string{"spec.targetAccountConfigurations[0].roleArn"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := newTemplate()
+			template.Spec.ExperimentOptions = tt.options
+			template.Spec.TargetAccountConfigurations = tt.configs
+
+			_, errs := (&TemplateValidator{}).Validate(context.Background(), template)
+			if len(errs) != len(tt.wantFields) {
+				t.Fatalf("Expected %d errors, got: %v", len(tt.wantFields), errs)
+			}
+			for i, want := range tt.wantFields {
+				if errs[i].Field != want {
+					t.Errorf("Expected error on %s, got: %s", want, errs[i].Field)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateIOStressSampledVolume(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	newPod := func(mounts ...corev1.VolumeMount) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "nginx-0", Namespace: "default", Labels: map[string]string{"app": "nginx"}},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "nginx", Image: "nginx", VolumeMounts: mounts}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		pod          *corev1.Pod
+		wantWarnings int
+	}{
+		{name: "no pods", wantWarnings: 0},
+		{name: "writable volume", pod: newPod(corev1.VolumeMount{Name: "data", MountPath: "/data"}), wantWarnings: 0},
+		{name: "read-only volume", pod: newPod(corev1.VolumeMount{Name: "config", MountPath: "/etc/nginx", ReadOnly: true}), wantWarnings: 1},
+		{name: "no volumes", pod: newPod(), wantWarnings: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}})
+			if tt.pod != nil {
+				builder = builder.WithObjects(tt.pod)
+			}
+			validator := &TemplateValidator{Reader: builder.Build()}
+
+			warnings, errs := validator.Validate(context.Background(), newIOStressTemplate(map[string]string{"percent": "80"}))
+			if len(errs) != 0 {
+				t.Errorf("Expected no errors, got: %v", errs)
+			}
+			if len(warnings) != tt.wantWarnings {
+				t.Errorf("Expected %d warnings, got: %v", tt.wantWarnings, warnings)
+			}
+		})
+	}
+}
+
+func TestValidateRequiredStopConditions(t *testing.T) {
+	validator := &TemplateValidator{RequireStopConditionNamespaces: []string{"production"}}
+	alarmArn := "arn:aws:cloudwatch:ap-northeast-2:123456789012:alarm:high-error-rate"
+
+	tests := []struct {
+		name           string
+		namespace      string
+		stopConditions []fisv1alpha1.StopCondition
+		wantErrs       int
+	}{
+		{name: "production without stop condition", namespace: "production", wantErrs: 1},
+		{name: "production with alarm", namespace: "production",
+			stopConditions: []fisv1alpha1.StopCondition{{Source: "cloudwatch-alarm", Value: alarmArn}}},
+		{name: "production with none only", namespace: "production",
+			stopConditions: []fisv1alpha1.StopCondition{{Source: "none"}}, wantErrs: 2},
+		{name: "production with alarm and none", namespace: "production",
+			stopConditions: []fisv1alpha1.StopCondition{{Source: "cloudwatch-alarm", Value: alarmArn}, {Source: "none"}}, wantErrs: 1},
+		{name: "other namespace without stop condition", namespace: "staging"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := newTemplate()
+			template.Spec.Targets[0].Namespace = tt.namespace
+			template.Spec.StopConditions = tt.stopConditions
+
+			_, errs := validator.Validate(context.Background(), template)
+			if len(errs) != tt.wantErrs {
+				t.Errorf("Expected %d errors, got: %v", tt.wantErrs, errs)
+			}
+		})
+	}
+}
+
+func TestValidateTooManyStopConditions(t *testing.T) {
+	template := newTemplate()
+	for i := 0; i <= MaxStopConditionsPerTemplate; i++ {
+		template.Spec.StopConditions = append(template.Spec.StopConditions, fisv1alpha1.StopCondition{
+			Source: "cloudwatch-alarm",
+			Value:  fmt.Sprintf("arn:aws:cloudwatch:ap-northeast-2:123456789012:alarm:alarm-%d", i),
+		})
+	}
+
+	_, errs := (&TemplateValidator{}).Validate(context.Background(), template)
+	if len(errs) != 1 || errs[0].Field != "spec.stopConditions" {
+		t.Errorf("Expected a single stopConditions error, got: %v", errs)
+	}
+}
+
+func TestValidateActionDurationBounds(t *testing.T) {
+	tests := []struct {
+		actionType string
+		duration   string
+		params     map[string]string
+		wantErr    bool
+	}{
+		{actionType: "pod-network-latency", duration: "5s", params: map[string]string{"delayMilliseconds": "200"}, wantErr: true},
+		{actionType: "pod-network-latency", duration: "10s", params: map[string]string{"delayMilliseconds": "200"}},
+		{actionType: "pod-network-packet-loss", duration: "9s", params: map[string]string{"lossPercent": "10"}, wantErr: true},
+		{actionType: "pod-cpu-stress", duration: "30s", wantErr: true},
+		{actionType: "pod-cpu-stress", duration: "12h"},
+		{actionType: "pod-cpu-stress", duration: "12h1m", wantErr: true},
+		{actionType: "pod-memory-stress", duration: "13h", wantErr: true},
+		{actionType: "pod-delete", duration: "1s"},
+		{actionType: "pod-delete", duration: "24h", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		template := newTemplate()
+		template.Spec.Actions[0].Type = tt.actionType
+		template.Spec.Actions[0].Duration = tt.duration
+		template.Spec.Actions[0].Parameters = tt.params
+
+		_, errs := (&TemplateValidator{}).Validate(context.Background(), template)
+		if gotErr := len(errs) > 0; gotErr != tt.wantErr {
+			t.Errorf("%s with duration %s: expected error=%t, got: %v", tt.actionType, tt.duration, tt.wantErr, errs)
+		}
+	}
+}
+
+func TestValidateTargetNamespaces(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	tests := []struct {
+		name       string
+		namespace  string
+		allowed    []string
+		wantErrors int
+	}{
+		{name: "existing namespace", namespace: "default", wantErrors: 0},
+		{name: "missing namespace", namespace: "payments", wantErrors: 1},
+		{name: "missing namespace allowed", namespace: "payments", allowed: []string{"payments"}, wantErrors: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}}).
+				Build()
+			validator := &TemplateValidator{Reader: reader, AllowedMissingNamespaces: tt.allowed}
+
+			template := newTemplate()
+			template.Spec.Targets[0].Namespace = tt.namespace
+			_, errs := validator.Validate(context.Background(), template)
+			if len(errs) != tt.wantErrors {
+				t.Fatalf("Expected %d errors, got: %v", tt.wantErrors, errs)
+			}
+			if tt.wantErrors > 0 && errs[0].Field != "spec.targets[0].namespace" {
+				t.Errorf("Expected error on spec.targets[0].namespace, got: %s", errs[0].Field)
+			}
+		})
+	}
+}
+
+func TestValidateBlastRadius(t *testing.T) {
+	count := func(n int32) *int32 { return &n }
+
+	tests := []struct {
+		name     string
+		declared fisv1alpha1.BlastRadius
+		target   fisv1alpha1.TargetSpec
+		wantErr  bool
+	}{
+		{name: "undeclared is not checked", target: fisv1alpha1.TargetSpec{Scope: "ALL"}},
+		{name: "low with few pods", declared: fisv1alpha1.BlastRadiusLow, target: fisv1alpha1.TargetSpec{SelectionMode: "COUNT", Count: count(3)}},
+		{name: "low with too many pods", declared: fisv1alpha1.BlastRadiusLow, target: fisv1alpha1.TargetSpec{SelectionMode: "COUNT", Count: count(4)}, wantErr: true},
+		{name: "low with ALL", declared: fisv1alpha1.BlastRadiusLow, target: fisv1alpha1.TargetSpec{Scope: "ALL"}, wantErr: true},
+		{name: "medium with half the pods", declared: fisv1alpha1.BlastRadiusMedium, target: fisv1alpha1.TargetSpec{SelectionMode: "PERCENT", Percent: count(50)}},
+		{name: "medium with more than half the pods", declared: fisv1alpha1.BlastRadiusMedium, target: fisv1alpha1.TargetSpec{SelectionMode: "PERCENT", Percent: count(51)}, wantErr: true},
+		{name: "medium with deprecated percent scope", declared: fisv1alpha1.BlastRadiusMedium, target: fisv1alpha1.TargetSpec{Scope: "75%"}, wantErr: true},
+		{name: "high with ALL", declared: fisv1alpha1.BlastRadiusHigh, target: fisv1alpha1.TargetSpec{SelectionMode: "ALL"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := newTemplate()
+			template.Spec.BlastRadius = tt.declared
+			target := tt.target
+			target.Name = "nginx-pods"
+			target.Namespace = "default"
+			target.LabelSelector = map[string]string{"app": "nginx"}
+			template.Spec.Targets[0] = target
+
+			_, errs := (&TemplateValidator{}).Validate(context.Background(), template)
+			if gotErr := len(errs) > 0; gotErr != tt.wantErr {
+				t.Fatalf("Expected error=%t, got: %v", tt.wantErr, errs)
+			}
+			if tt.wantErr && errs[0].Field != "spec.blastRadius" {
+				t.Errorf("Expected error on spec.blastRadius, got: %s", errs[0].Field)
+			}
+		})
+	}
+}
+
+func TestValidateLabelSelectors(t *testing.T) {
+	tests := []struct {
+		name      string
+		selector  map[string]string
+		wantField string
+	}{
+		{name: "prefixed key", selector: map[string]string{"app.kubernetes.io/name": "nginx"}},
+		{name: "empty value", selector: map[string]string{"canary": ""}},
+		{name: "key with space", selector: map[string]string{"my app": "nginx"}, wantField: "spec.targets[0].labelSelector"},
+		{name: "key with equals", selector: map[string]string{"app=web": "nginx"}, wantField: "spec.targets[0].labelSelector"},
+		{name: "value with equals", selector: map[string]string{"app": "nginx=web"}, wantField: "spec.targets[0].labelSelector[app]"},
+		{name: "value with space", selector: map[string]string{"app": "my nginx"}, wantField: "spec.targets[0].labelSelector[app]"},
+		{name: "value too long", selector: map[string]string{"app": strings.Repeat("a", 64)}, wantField: "spec.targets[0].labelSelector[app]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := newTemplate()
+			template.Spec.Targets[0].LabelSelector = tt.selector
+
+			_, errs := (&TemplateValidator{}).Validate(context.Background(), template)
+			if tt.wantField == "" {
+				if len(errs) != 0 {
+					t.Fatalf("Expected no errors, got: %v", errs)
+				}
+				return
+			}
+			if len(errs) == 0 || errs[0].Field != tt.wantField {
+				t.Errorf("Expected an error on %s, got: %v", tt.wantField, errs)
+			}
+		})
+	}
+}
+
+func TestValidateSelectionFieldsWithModeAll(t *testing.T) {
+	count, percent := int32(3), int32(50)
+	tests := []struct {
+		name       string
+		mode       string
+		count      *int32
+		percent    *int32
+		wantFields []string
+	}{
+		{name: "ALL alone", mode: "ALL"},
+		{name: "ALL with count", mode: "ALL", count: &count, wantFields: []string{"spec.targets[0].count"}},
+		{name: "ALL with percent", mode: "ALL", percent: &percent, wantFields: []string{"spec.targets[0].percent"}},
+		{name: "ALL with both", mode: "ALL", count: &count, percent: &percent, wantFields: []string{"spec.targets[0].count", "spec.targets[0].percent"}},
+		{name: "COUNT with count", mode: "COUNT", count: &count},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := newTemplate()
+			template.Spec.BlastRadius = fisv1alpha1.BlastRadiusHigh
+			template.Spec.Targets[0].SelectionMode = tt.mode
+			template.Spec.Targets[0].Count = tt.count
+			template.Spec.Targets[0].Percent = tt.percent
+
+			_, errs := (&TemplateValidator{}).Validate(context.Background(), template)
+			var fields []string
+			for _, err := range errs {
+				fields = append(fields, err.Field)
+			}
+			if !slices.Equal(fields, tt.wantFields) {
+				t.Errorf("Expected errors on %v, got: %v", tt.wantFields, errs)
+			}
+		})
+	}
+}
+
+func TestValidateStrictTargetContainers(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	newPod := func(name string, containers ...string) *corev1.Pod {
+		pod := &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{"app": "nginx"}},
+		}
+		for _, c := range containers {
+			pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{Name: c, Image: c})
+		}
+		return pod
+	}
+
+	tests := []struct {
+		name    string
+		strict  bool
+		pods    []*corev1.Pod
+		wantErr string
+	}{
+		{
+			name:   "uniform pods",
+			strict: true,
+			pods:   []*corev1.Pod{newPod("nginx-0", "nginx", "envoy"), newPod("nginx-1", "nginx", "envoy")},
+		},
+		{
+			name:    "non-uniform pods",
+			strict:  true,
+			pods:    []*corev1.Pod{newPod("nginx-0", "nginx", "envoy"), newPod("nginx-1", "nginx"), newPod("nginx-2", "nginx")},
+			wantErr: "missing from 2 of 3 matching pods in namespace default: nginx-1, nginx-2",
+		},
+		{
+			name: "non-uniform pods without strict mode",
+			pods: []*corev1.Pod{newPod("nginx-0", "nginx", "envoy"), newPod("nginx-1", "nginx")},
+		},
+		{name: "no matching pods", strict: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			builder := fake.NewClientBuilder().WithScheme(scheme).
+				WithObjects(&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}})
+			for _, pod := range tt.pods {
+				builder = builder.WithObjects(pod)
+			}
+			validator := &TemplateValidator{Reader: builder.Build(), StrictTargetContainers: tt.strict}
+			template := newTemplate()
+			template.Spec.Targets[0].TargetContainerName = "envoy"
+
+			_, errs := validator.Validate(context.Background(), template)
+			if tt.wantErr == "" {
+				if len(errs) != 0 {
+					t.Fatalf("Expected no errors, got: %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Field != "spec.targets[0].targetContainerName" || !strings.Contains(errs[0].Detail, tt.wantErr) {
+				t.Errorf("Expected an error on spec.targets[0].targetContainerName containing %q, got: %v", tt.wantErr, errs)
+			}
+		})
+	}
+}
+
+// fakeBucketRegions resolves bucket regions from a map
+type fakeBucketRegions map[string]string
+
+func (f fakeBucketRegions) BucketRegion(_ context.Context, bucket string) (string, error) {
+	region, ok := f[bucket]
+	if !ok {
+		return "", fmt.Errorf("bucket %s not found", bucket)
+	}
+	return region, nil
+}
+
+func newRegionTemplate() *fisv1alpha1.ExperimentTemplate {
+	template := newTemplate()
+	template.Spec.LogConfiguration = &fisv1alpha1.LogConfiguration{
+		LogSchemaVersion: 2,
+		CloudWatchLogsConfiguration: &fisv1alpha1.CloudWatchLogsConfiguration{
+			LogGroupArn: "arn:aws:logs:us-east-1:123456789012:log-group:fis",
+		},
+		S3Configuration: &fisv1alpha1.S3Configuration{BucketName: "fis-logs"},
+	}
+	template.Spec.ExperimentReportConfiguration = &fisv1alpha1.ExperimentReportConfiguration{
+		Outputs: &fisv1alpha1.ReportOutputs{
+			S3Configuration: &fisv1alpha1.S3Configuration{BucketName: "fis-reports"},
+		},
+	}
+	return template
+}
+
+func TestValidateRegionConsistency(t *testing.T) {
+	validator := &TemplateValidator{
+		Region:        "ap-northeast-2",
+		BucketRegions: fakeBucketRegions{"fis-logs": "ap-northeast-2", "fis-reports": "eu-west-1"},
+	}
+
+	_, errs := validator.Validate(context.Background(), newRegionTemplate())
+	if len(errs) != 1 {
+		t.Fatalf("Expected a single aggregated error, got: %v", errs)
+	}
+	if errs[0].Field != "spec" {
+		t.Errorf("Expected error on spec, got: %s", errs[0].Field)
+	}
+	for _, want := range []string{
+		"spec.logConfiguration.cloudWatchLogsConfiguration.logGroupArn is in us-east-1",
+		"spec.experimentReportConfiguration.outputs.s3Configuration.bucketName bucket fis-reports is in eu-west-1",
+	} {
+		if !strings.Contains(errs[0].Detail, want) {
+			t.Errorf("Expected %q in error, got: %s", want, errs[0].Detail)
+		}
+	}
+	if strings.Contains(errs[0].Detail, "fis-logs") {
+		t.Errorf("Expected bucket in the experiment region to pass, got: %s", errs[0].Detail)
+	}
+}
+
+func TestValidateRegionConsistencyMatchingRegion(t *testing.T) {
+	validator := &TemplateValidator{
+		Region:        "us-east-1",
+		BucketRegions: fakeBucketRegions{"fis-logs": "us-east-1"},
+	}
+
+	warnings, errs := validator.Validate(context.Background(), newRegionTemplate())
+	if len(errs) != 0 {
+		t.Fatalf("Expected no errors, got: %v", errs)
+	}
+	if !slices.ContainsFunc(warnings, func(w string) bool { return strings.Contains(w, "bucket fis-reports") }) {
+		t.Errorf("Expected a warning for the unresolvable bucket, got: %v", warnings)
+	}
+
+	if _, errs := (&TemplateValidator{}).Validate(context.Background(), newRegionTemplate()); len(errs) != 0 {
+		t.Errorf("Expected no region checks without a configured region, got: %v", errs)
+	}
+}
+
+func TestValidateActionSequence(t *testing.T) {
+	template := newTemplate()
+	template.Spec.Actions = append(template.Spec.Actions,
+		fisv1alpha1.ActionSpec{Name: "memory-stress", Type: "pod-memory-stress", Duration: "5m", Target: "nginx-pods"})
+	template.Spec.ActionSequence = []string{"cpu-stress", "memory-stress"}
+
+	if _, errs := (&TemplateValidator{}).Validate(context.Background(), template); len(errs) != 0 {
+		t.Fatalf("Expected no errors, got: %v", errs)
+	}
+
+	template.Spec.ActionSequence = []string{"cpu-stress", "disk-fill"}
+	_, errs := (&TemplateValidator{}).Validate(context.Background(), template)
+	if len(errs) != 1 || errs[0].Field != "spec.actionSequence[1]" {
+		t.Fatalf("Expected an error on spec.actionSequence[1], got: %v", errs)
+	}
+
+	// The sequence runs cpu-stress before memory-stress, which already has to run first
+	template.Spec.Actions[0].StartAfter = []string{"memory-stress"}
+	template.Spec.ActionSequence = []string{"cpu-stress", "memory-stress"}
+	_, errs = (&TemplateValidator{}).Validate(context.Background(), template)
+	if len(errs) != 1 || !strings.Contains(errs[0].Detail, "cycle") {
+		t.Fatalf("Expected a cycle error, got: %v", errs)
+	}
+}
+
+func TestValidateActionParameterKeys(t *testing.T) {
+	template := newTemplate()
+	template.Spec.Actions[0].Parameters = map[string]string{
+		"percentage":           "80",
+		"workers":              "2",
+		"fisPodContainerImage": "public.ecr.aws/aws-fis/aws-fis-pod:latest",
+	}
+
+	warnings, errs := (&TemplateValidator{}).Validate(context.Background(), template)
+	if len(errs) != 0 {
+		t.Fatalf("Expected unknown parameters to only warn, got errors: %v", errs)
+	}
+	if len(warnings) != 1 {
+		t.Fatalf("Expected 1 warning, got: %v", warnings)
+	}
+	if !strings.Contains(warnings[0], "spec.actions[0].parameters[percentage]") || !strings.Contains(warnings[0], "percent, workers") {
+		t.Errorf("Expected a warning naming the typo and the known parameters, got: %s", warnings[0])
+	}
+}
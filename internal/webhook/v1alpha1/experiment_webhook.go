@@ -0,0 +1,98 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	fisv1alpha1 "fis.dksshddl.dev/fis-controller/api/v1alpha1"
+	"fis.dksshddl.dev/fis-controller/internal/validation"
+)
+
+var experimentlog = logf.Log.WithName("experiment-resource")
+
+// SetupExperimentWebhookWithManager registers the Experiment validating webhook in the manager
+func SetupExperimentWebhookWithManager(mgr ctrl.Manager, validator *validation.ExperimentValidator) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&fisv1alpha1.Experiment{}).
+		WithValidator(&ExperimentCustomValidator{Validator: validator}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/validate-fis-fis-dksshddl-dev-v1alpha1-experiment,mutating=false,failurePolicy=fail,sideEffects=None,groups=fis.fis.dksshddl.dev,resources=experiments,verbs=create;update,versions=v1alpha1,name=vexperiment-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// ExperimentCustomValidator rejects Experiments whose template reference or schedule cannot work
+type ExperimentCustomValidator struct {
+	Validator *validation.ExperimentValidator
+}
+
+var _ webhook.CustomValidator = &ExperimentCustomValidator{}
+
+// ValidateCreate validates a new Experiment
+func (v *ExperimentCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	experiment, ok := obj.(*fisv1alpha1.Experiment)
+	if !ok {
+		return nil, fmt.Errorf("expected an Experiment object but got %T", obj)
+	}
+	experimentlog.V(1).Info("Validation for Experiment upon creation", "name", experiment.GetName())
+
+	return nil, v.validate(ctx, experiment)
+}
+
+// ValidateUpdate validates an updated Experiment
+// Experiments being deleted are not validated, so their finalizer can always be removed
+func (v *ExperimentCustomValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	experiment, ok := newObj.(*fisv1alpha1.Experiment)
+	if !ok {
+		return nil, fmt.Errorf("expected an Experiment object for the newObj but got %T", newObj)
+	}
+	if !experiment.DeletionTimestamp.IsZero() {
+		return nil, nil
+	}
+	experimentlog.V(1).Info("Validation for Experiment upon update", "name", experiment.GetName())
+
+	return nil, v.validate(ctx, experiment)
+}
+
+// ValidateDelete allows every deletion
+func (v *ExperimentCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate checks the template reference before the policies that depend on the template
+func (v *ExperimentCustomValidator) validate(ctx context.Context, experiment *fisv1alpha1.Experiment) error {
+	validator := v.Validator
+	if validator == nil {
+		validator = &validation.ExperimentValidator{}
+	}
+
+	errs := validator.ValidateTemplateReference(ctx, experiment)
+	if len(errs) == 0 {
+		errs = validator.Validate(ctx, experiment)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(fisv1alpha1.GroupVersion.WithKind("Experiment").GroupKind(), experiment.Name, errs)
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fisv1alpha1 "fis.dksshddl.dev/fis-controller/api/v1alpha1"
+	"fis.dksshddl.dev/fis-controller/internal/validation"
+)
+
+func TestExperimentValidateCreate(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = fisv1alpha1.AddToScheme(scheme)
+	reader := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newTemplate()).Build()
+	validator := &ExperimentCustomValidator{Validator: &validation.ExperimentValidator{Reader: reader}}
+
+	tests := []struct {
+		name      string
+		ref       fisv1alpha1.ExperimentTemplateRef
+		schedule  string
+		wantField string
+	}{
+		{name: "template by name", ref: fisv1alpha1.ExperimentTemplateRef{Name: "webhook-test"}, schedule: "0 2 * * *"},
+		{name: "template by id", ref: fisv1alpha1.ExperimentTemplateRef{ID: "EXT1234567890abcdef"}},
+		{
+			name:      "both id and name",
+			ref:       fisv1alpha1.ExperimentTemplateRef{ID: "EXT1234567890abcdef", Name: "webhook-test"},
+			wantField: "spec.experimentTemplate",
+		},
+		{name: "neither id nor name", wantField: "spec.experimentTemplate"},
+		{
+			name:      "unknown template name",
+			ref:       fisv1alpha1.ExperimentTemplateRef{Name: "missing"},
+			wantField: "spec.experimentTemplate.name",
+		},
+		{
+			name:      "malformed schedule",
+			ref:       fisv1alpha1.ExperimentTemplateRef{ID: "EXT1234567890abcdef"},
+			schedule:  "0 25 * * *",
+			wantField: "spec.schedule",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			experiment := &fisv1alpha1.Experiment{
+				ObjectMeta: metav1.ObjectMeta{Name: "webhook-test"},
+				Spec:       fisv1alpha1.ExperimentSpec{ExperimentTemplate: tt.ref, Schedule: tt.schedule},
+			}
+
+			_, err := validator.ValidateCreate(context.Background(), experiment)
+			if tt.wantField == "" {
+				if err != nil {
+					t.Fatalf("Expected experiment to be accepted, got: %v", err)
+				}
+				return
+			}
+			if !apierrors.IsInvalid(err) {
+				t.Fatalf("Expected an Invalid error, got: %v", err)
+			}
+			causes := err.(*apierrors.StatusError).ErrStatus.Details.Causes
+			if len(causes) != 1 || causes[0].Field != tt.wantField {
+				t.Errorf("Expected one error on %s, got: %v", tt.wantField, causes)
+			}
+		})
+	}
+}
+
+func TestExperimentValidateUpdateSkipsDeletion(t *testing.T) {
+	validator := &ExperimentCustomValidator{}
+	experiment := &fisv1alpha1.Experiment{ObjectMeta: metav1.ObjectMeta{Name: "webhook-test"}}
+
+	if _, err := validator.ValidateUpdate(context.Background(), experiment, experiment); err == nil || !strings.Contains(err.Error(), "spec.experimentTemplate") {
+		t.Fatalf("Expected an update without a template reference to be rejected, got: %v", err)
+	}
+
+	now := metav1.Now()
+	experiment.DeletionTimestamp = &now
+	if _, err := validator.ValidateUpdate(context.Background(), experiment, experiment); err != nil {
+		t.Errorf("Expected updates of an experiment being deleted to be accepted, got: %v", err)
+	}
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1alpha1 contains the admission webhooks for the fis v1alpha1 API
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	fisv1alpha1 "fis.dksshddl.dev/fis-controller/api/v1alpha1"
+	"fis.dksshddl.dev/fis-controller/internal/validation"
+)
+
+var experimenttemplatelog = logf.Log.WithName("experimenttemplate-resource")
+
+// SetupExperimentTemplateWebhookWithManager registers the ExperimentTemplate defaulting and validating webhooks in the manager
+// The validating webhook applies the same validator as the controller, so invalid templates are rejected before they are stored
+func SetupExperimentTemplateWebhookWithManager(mgr ctrl.Manager, validator *validation.TemplateValidator) error {
+	return ctrl.NewWebhookManagedBy(mgr).For(&fisv1alpha1.ExperimentTemplate{}).
+		WithDefaulter(&ExperimentTemplateCustomDefaulter{}).
+		WithValidator(&ExperimentTemplateCustomValidator{Validator: validator}).
+		Complete()
+}
+
+// +kubebuilder:webhook:path=/mutate-fis-fis-dksshddl-dev-v1alpha1-experimenttemplate,mutating=true,failurePolicy=fail,sideEffects=None,groups=fis.fis.dksshddl.dev,resources=experimenttemplates,verbs=create;update,versions=v1alpha1,name=mexperimenttemplate-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// ExperimentTemplateCustomDefaulter migrates deprecated ExperimentTemplate fields to their canonical names
+type ExperimentTemplateCustomDefaulter struct{}
+
+var _ webhook.CustomDefaulter = &ExperimentTemplateCustomDefaulter{}
+
+// Default moves the deprecated target container alias into TargetContainerName
+// When both are set, TargetContainerName wins, matching the converter
+func (d *ExperimentTemplateCustomDefaulter) Default(_ context.Context, obj runtime.Object) error {
+	template, ok := obj.(*fisv1alpha1.ExperimentTemplate)
+	if !ok {
+		return fmt.Errorf("expected an ExperimentTemplate object but got %T", obj)
+	}
+	if !template.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	experimenttemplatelog.V(1).Info("Defaulting for ExperimentTemplate", "name", template.GetName())
+
+	for i := range template.Spec.Targets {
+		target := &template.Spec.Targets[i]
+		if target.Container == "" {
+			continue
+		}
+		if target.TargetContainerName == "" {
+			target.TargetContainerName = target.Container
+		}
+		target.Container = ""
+	}
+	return nil
+}
+
+// +kubebuilder:webhook:path=/validate-fis-fis-dksshddl-dev-v1alpha1-experimenttemplate,mutating=false,failurePolicy=fail,sideEffects=None,groups=fis.fis.dksshddl.dev,resources=experimenttemplates,verbs=create;update,versions=v1alpha1,name=vexperimenttemplate-v1alpha1.kb.io,admissionReviewVersions=v1
+
+// ExperimentTemplateCustomValidator rejects ExperimentTemplates that AWS FIS or the controller's policies would reject
+type ExperimentTemplateCustomValidator struct {
+	Validator *validation.TemplateValidator
+}
+
+var _ webhook.CustomValidator = &ExperimentTemplateCustomValidator{}
+
+// ValidateCreate validates a new ExperimentTemplate
+func (v *ExperimentTemplateCustomValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	template, ok := obj.(*fisv1alpha1.ExperimentTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected an ExperimentTemplate object but got %T", obj)
+	}
+	experimenttemplatelog.V(1).Info("Validation for ExperimentTemplate upon creation", "name", template.GetName())
+
+	return v.validate(ctx, template)
+}
+
+// ValidateUpdate validates an updated ExperimentTemplate
+// Templates being deleted are not validated, so their finalizer can always be removed
+func (v *ExperimentTemplateCustomValidator) ValidateUpdate(ctx context.Context, _, newObj runtime.Object) (admission.Warnings, error) {
+	template, ok := newObj.(*fisv1alpha1.ExperimentTemplate)
+	if !ok {
+		return nil, fmt.Errorf("expected an ExperimentTemplate object for the newObj but got %T", newObj)
+	}
+	if !template.DeletionTimestamp.IsZero() {
+		return nil, nil
+	}
+	experimenttemplatelog.V(1).Info("Validation for ExperimentTemplate upon update", "name", template.GetName())
+
+	return v.validate(ctx, template)
+}
+
+// ValidateDelete allows every deletion
+func (v *ExperimentTemplateCustomValidator) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate runs the template validator and turns its field errors into an Invalid status error
+func (v *ExperimentTemplateCustomValidator) validate(ctx context.Context, template *fisv1alpha1.ExperimentTemplate) (admission.Warnings, error) {
+	validator := v.Validator
+	if validator == nil {
+		validator = &validation.TemplateValidator{}
+	}
+
+	warnings, errs := validator.Validate(ctx, template)
+	if len(errs) == 0 {
+		return warnings, nil
+	}
+	return warnings, apierrors.NewInvalid(fisv1alpha1.GroupVersion.WithKind("ExperimentTemplate").GroupKind(), template.Name, errs)
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2026.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fisv1alpha1 "fis.dksshddl.dev/fis-controller/api/v1alpha1"
+	"fis.dksshddl.dev/fis-controller/internal/validation"
+)
+
+func newTemplate() *fisv1alpha1.ExperimentTemplate {
+	return &fisv1alpha1.ExperimentTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: "webhook-test"},
+		Spec: fisv1alpha1.ExperimentTemplateSpec{
+			Description: "webhook test",
+			Targets: []fisv1alpha1.TargetSpec{
+				{Name: "nginx-pods", Namespace: "default", LabelSelector: map[string]string{"app": "nginx"}},
+			},
+			Actions: []fisv1alpha1.ActionSpec{
+				{Name: "cpu-stress", Type: "pod-cpu-stress", Duration: "5m", Target: "nginx-pods"},
+				{Name: "memory-stress", Type: "pod-memory-stress", Duration: "5m", Target: "nginx-pods", StartAfter: []string{"cpu-stress"}},
+			},
+			StopConditions: []fisv1alpha1.StopCondition{
+				{Source: "cloudwatch-alarm", Value: "arn:aws:cloudwatch:ap-northeast-2:123456789012:alarm:high-error-rate"},
+			},
+		},
+	}
+}
+
+func TestExperimentTemplateValidateCreate(t *testing.T) {
+	tests := []struct {
+		name      string
+		mutate    func(*fisv1alpha1.ExperimentTemplate)
+		wantField string
+	}{
+		{
+			name:   "valid",
+			mutate: func(*fisv1alpha1.ExperimentTemplate) {},
+		},
+		{
+			name:      "unknown action target",
+			mutate:    func(tmpl *fisv1alpha1.ExperimentTemplate) { tmpl.Spec.Actions[0].Target = "api-pods" },
+			wantField: "spec.actions[0].target",
+		},
+		{
+			name:      "unknown startAfter action",
+			mutate:    func(tmpl *fisv1alpha1.ExperimentTemplate) { tmpl.Spec.Actions[1].StartAfter = []string{"disk-fill"} },
+			wantField: "spec.actions[1].startAfter[0]",
+		},
+		{
+			name:      "cloudwatch alarm without value",
+			mutate:    func(tmpl *fisv1alpha1.ExperimentTemplate) { tmpl.Spec.StopConditions[0].Value = "" },
+			wantField: "spec.stopConditions[0].value",
+		},
+		{
+			name: "invalid label key",
+			mutate: func(tmpl *fisv1alpha1.ExperimentTemplate) {
+				tmpl.Spec.Targets[0].LabelSelector = map[string]string{"app name": "nginx"}
+			},
+			wantField: "spec.targets[0].labelSelector",
+		},
+		{
+			name: "invalid label value",
+			mutate: func(tmpl *fisv1alpha1.ExperimentTemplate) {
+				tmpl.Spec.Targets[0].LabelSelector = map[string]string{"app": "nginx=web"}
+			},
+			wantField: "spec.targets[0].labelSelector[app]",
+		},
+		{
+			name:      "self-referencing startAfter",
+			mutate:    func(tmpl *fisv1alpha1.ExperimentTemplate) { tmpl.Spec.Actions[0].StartAfter = []string{"cpu-stress"} },
+			wantField: "spec.actions[0].startAfter[0]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := newTemplate()
+			tt.mutate(template)
+			validator := &ExperimentTemplateCustomValidator{Validator: &validation.TemplateValidator{}}
+
+			_, err := validator.ValidateCreate(context.Background(), template)
+			if tt.wantField == "" {
+				if err != nil {
+					t.Fatalf("Expected template to be accepted, got: %v", err)
+				}
+				return
+			}
+			if !apierrors.IsInvalid(err) {
+				t.Fatalf("Expected an Invalid error, got: %v", err)
+			}
+			if !strings.Contains(err.Error(), tt.wantField) {
+				t.Errorf("Expected error on %s, got: %v", tt.wantField, err)
+			}
+		})
+	}
+}
+
+func TestExperimentTemplateStartAfterGraph(t *testing.T) {
+	validator := &ExperimentTemplateCustomValidator{}
+
+	// A diamond is acyclic even though two actions share a dependency
+	template := newTemplate()
+	template.Spec.Actions = []fisv1alpha1.ActionSpec{
+		{Name: "a", Type: "pod-cpu-stress", Duration: "5m", Target: "nginx-pods"},
+		{Name: "b", Type: "pod-cpu-stress", Duration: "5m", Target: "nginx-pods", StartAfter: []string{"a"}},
+		{Name: "c", Type: "pod-cpu-stress", Duration: "5m", Target: "nginx-pods", StartAfter: []string{"a"}},
+		{Name: "d", Type: "pod-cpu-stress", Duration: "5m", Target: "nginx-pods", StartAfter: []string{"b", "c"}},
+	}
+	if _, err := validator.ValidateCreate(context.Background(), template); err != nil {
+		t.Fatalf("Expected acyclic startAfter graph to be accepted, got: %v", err)
+	}
+
+	// Making a wait for d closes one cycle through b and one through c
+	template.Spec.Actions[0].StartAfter = []string{"d"}
+	_, err := validator.ValidateCreate(context.Background(), template)
+	if !apierrors.IsInvalid(err) {
+		t.Fatalf("Expected an Invalid error for a cyclic graph, got: %v", err)
+	}
+	statusErr := err.(*apierrors.StatusError)
+	causes := statusErr.ErrStatus.Details.Causes
+	want := []string{"a -> d -> b -> a", "a -> d -> c -> a"}
+	if len(causes) != len(want) {
+		t.Fatalf("Expected %d cycle errors, got: %v", len(want), causes)
+	}
+	for i := range want {
+		if !strings.Contains(causes[i].Message, want[i]) {
+			t.Errorf("Expected cycle %s in error %d, got: %s", want[i], i, causes[i].Message)
+		}
+	}
+}
+
+func TestExperimentTemplateValidateUpdateSkipsDeletion(t *testing.T) {
+	validator := &ExperimentTemplateCustomValidator{}
+	template := newTemplate()
+	template.Spec.Actions[0].Target = "api-pods"
+
+	if _, err := validator.ValidateUpdate(context.Background(), template, template); err == nil {
+		t.Fatal("Expected an invalid update to be rejected")
+	}
+
+	now := metav1.Now()
+	template.DeletionTimestamp = &now
+	if _, err := validator.ValidateUpdate(context.Background(), template, template); err != nil {
+		t.Errorf("Expected updates of a template being deleted to be accepted, got: %v", err)
+	}
+}
+
+func TestExperimentTemplateDefaultMigratesContainerAlias(t *testing.T) {
+	tests := []struct {
+		name          string
+		canonical     string
+		alias         string
+		wantContainer string
+	}{
+		{name: "alias only", alias: "nginx", wantContainer: "nginx"},
+		{name: "canonical wins", canonical: "envoy", alias: "nginx", wantContainer: "envoy"},
+		{name: "canonical only", canonical: "envoy", wantContainer: "envoy"},
+		{name: "neither"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			template := newTemplate()
+			template.Spec.Targets[0].TargetContainerName = tt.canonical
+			template.Spec.Targets[0].Container = tt.alias
+
+			if err := (&ExperimentTemplateCustomDefaulter{}).Default(context.Background(), template); err != nil {
+				t.Fatalf("Default failed: %v", err)
+			}
+			target := template.Spec.Targets[0]
+			if target.TargetContainerName != tt.wantContainer {
+				t.Errorf("Expected targetContainerName %q, got %q", tt.wantContainer, target.TargetContainerName)
+			}
+			if target.Container != "" {
+				t.Errorf("Expected the deprecated container field to be cleared, got %q", target.Container)
+			}
+		})
+	}
+}